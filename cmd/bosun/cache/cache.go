@@ -2,43 +2,143 @@ package cache // import "github.com/leapar/bosun/cmd/bosun/cache"
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/groupcache/lru"
 	"github.com/golang/groupcache/singleflight"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/opentsdb"
 )
 
+func init() {
+	metadata.AddMetricMeta("bosun.cache.hits", metadata.Counter, metadata.Count,
+		"Number of lookups in the named cache that were answered from the cache.")
+	metadata.AddMetricMeta("bosun.cache.misses", metadata.Counter, metadata.Count,
+		"Number of lookups in the named cache that had to fetch the value.")
+}
+
 type Cache struct {
 	g singleflight.Group
 
+	// Name identifies the cache in self metrics. Hits and misses are only
+	// reported for named caches.
+	Name string
+
 	sync.Mutex
-	lru *lru.Cache
+	lru        *lru.Cache
+	maxEntries int
+	hits       int64
+	misses     int64
+}
+
+type entry struct {
+	value   interface{}
+	expires time.Time // zero for values that never expire
+}
+
+// Stats is a point in time summary of the state of a cache.
+type Stats struct {
+	Name       string
+	Entries    int
+	MaxEntries int
+	Hits       int64
+	Misses     int64
 }
 
 func New(MaxEntries int) *Cache {
 	return &Cache{
-		lru: lru.New(MaxEntries),
+		lru:        lru.New(MaxEntries),
+		maxEntries: MaxEntries,
 	}
 }
 
+// NewNamed is like New, but reports hits and misses as bosun.cache.* self
+// metrics tagged with name.
+func NewNamed(name string, MaxEntries int) *Cache {
+	c := New(MaxEntries)
+	c.Name = name
+	return c
+}
+
 func (c *Cache) Get(key string, getFn func() (interface{}, error)) (interface{}, error) {
+	return c.GetWithTTL(key, 0, getFn)
+}
+
+// GetWithTTL is like Get, but the value fetched by getFn expires ttl after it
+// is fetched. A ttl of zero means the value never expires.
+func (c *Cache) GetWithTTL(key string, ttl time.Duration, getFn func() (interface{}, error)) (interface{}, error) {
 	if c == nil {
 		return getFn()
 	}
 	c.Lock()
 	result, ok := c.lru.Get(key)
+	if ok {
+		if e := result.(*entry); !e.expires.IsZero() && !time.Now().Before(e.expires) {
+			c.lru.Remove(key)
+			ok = false
+		}
+	}
+	c.count(ok)
 	c.Unlock()
 	if ok {
-		return result, nil
+		return result.(*entry).value, nil
 	}
 	// our lock only serves to protect the lru.
 	// we can (and should!) do singleflight requests concurently
 	return c.g.Do(key, func() (interface{}, error) {
 		v, err := getFn()
 		if err == nil {
+			e := &entry{value: v}
+			if ttl > 0 {
+				e.expires = time.Now().Add(ttl)
+			}
 			c.Lock()
-			c.lru.Add(key, v)
+			c.lru.Add(key, e)
 			c.Unlock()
 		}
 		return v, err
 	})
 }
+
+// count records a hit or miss. It must be called with the lock held.
+func (c *Cache) count(hit bool) {
+	metric := "cache.misses"
+	if hit {
+		c.hits++
+		metric = "cache.hits"
+	} else {
+		c.misses++
+	}
+	if c.Name != "" {
+		collect.Add(metric, opentsdb.TagSet{"cache": c.Name}, 1)
+	}
+}
+
+// Flush removes all entries from the cache and returns how many there were.
+func (c *Cache) Flush() int {
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	n := c.lru.Len()
+	c.lru = lru.New(c.maxEntries)
+	return n
+}
+
+// Stats returns the current size and hit counts of the cache.
+func (c *Cache) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+	c.Lock()
+	defer c.Unlock()
+	return Stats{
+		Name:       c.Name,
+		Entries:    c.lru.Len(),
+		MaxEntries: c.maxEntries,
+		Hits:       c.hits,
+		Misses:     c.misses,
+	}
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	c := New(2)
+	calls := 0
+	get := func(key string, ttl time.Duration) interface{} {
+		v, err := c.GetWithTTL(key, ttl, func() (interface{}, error) {
+			calls++
+			return fmt.Sprint(key, calls), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	if v := get("a", 0); v != "a1" {
+		t.Errorf("got %v, expected a1", v)
+	}
+	if v := get("a", 0); v != "a1" {
+		t.Errorf("got %v, expected cached a1", v)
+	}
+	if v := get("b", time.Nanosecond); v != "b2" {
+		t.Errorf("got %v, expected b2", v)
+	}
+	time.Sleep(time.Millisecond)
+	if v := get("b", time.Hour); v != "b3" {
+		t.Errorf("got %v, expected expired b to be fetched again", v)
+	}
+	if v := get("b", time.Hour); v != "b3" {
+		t.Errorf("got %v, expected cached b3", v)
+	}
+	s := c.Stats()
+	if s.Entries != 2 || s.Hits != 2 || s.Misses != 3 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+	if n := c.Flush(); n != 2 {
+		t.Errorf("flushed %d entries, expected 2", n)
+	}
+	if v := get("a", 0); v != "a4" {
+		t.Errorf("got %v, expected flushed a to be fetched again", v)
+	}
+}
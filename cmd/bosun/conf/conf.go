@@ -63,6 +63,27 @@ type SystemConfProvider interface {
 	ReloadEnabled() bool
 	GetCommandHookPath() string
 
+	GetQueryCacheMaxEntries() int
+	GetQueryCacheTTLs() expr.QueryCacheTTLs
+
+	GetReportDigestTo() []string
+	GetReportDigestTime() (time.Weekday, int)
+	GetReportDigestTop() int
+
+	GetInboundConf() map[string]InboundConf
+
+	GetTopologyConf() TopologyConf
+
+	GetAuditFile() string
+	GetAuditMaxEntries() int
+
+	GetGitConf() GitConf
+
+	GetInventoryConf() (interval, retention time.Duration)
+	GetHealthCheckConf() HealthCheckConf
+	GetSearchPruneConf() (retention, interval time.Duration, rate int)
+	GetAlertMetricsConf() AlertMetricsConf
+
 	SetTSDBHost(tsdbHost string)
 	GetTSDBHost() string
 
@@ -99,6 +120,9 @@ func ValidateSystemConf(sc SystemConfProvider) error {
 	if sc.GetHTTPSListen() != "" && (sc.GetTLSCertFile() == "" || sc.GetTLSKeyFile() == "") {
 		return fmt.Errorf("must specify TLSCertFile and TLSKeyFile if HTTPSListen is specified")
 	}
+	if len(sc.GetReportDigestTo()) > 0 && !hasSMTPHost {
+		return fmt.Errorf("the report digest requires that SMTP Host and EmailFrom be set")
+	}
 	return nil
 }
 
@@ -122,6 +146,15 @@ type RuleConfProvider interface {
 
 	GetLookup(string) *Lookup
 
+	GetDashboards() map[string]*Dashboard
+	GetDashboard(string) *Dashboard
+
+	GetReports() map[string]*Report
+	GetReport(string) *Report
+
+	GetSLOs() map[string]*SLO
+	GetSLO(string) *SLO
+
 	AlertSquelched(*Alert) func(opentsdb.TagSet) bool
 	Squelched(*Alert, opentsdb.TagSet) bool
 	Expand(string, map[string]string, bool) string
@@ -132,10 +165,14 @@ type RuleConfProvider interface {
 // Save methods will trigger the reload that has been passed to the rule configuration
 type RuleConfWriter interface {
 	BulkEdit(BulkEditRequest) error
-	GetRawText() string
+	BulkEditChanges(BulkEditRequest) (alerts []string, other bool, err error)
+	GetFiles() []string
+	GetRawText(file string) (string, error)
 	GetHash() string
-	SaveRawText(rawConf, diff, user, message string, args ...string) error
-	RawDiff(rawConf string) (string, error)
+	SaveRawText(file, rawConf, diff, user, message string, args ...string) error
+	CheckRawText(file, rawConf, diff string) error
+	RawDiff(file, rawConf string) (string, error)
+	ChangedAlerts(file, rawConf string) (alerts []string, other bool, err error)
 	SetReload(reload func() error)
 	SetSaveHook(SaveHook)
 }
@@ -328,6 +365,90 @@ type Entry struct {
 	Name string
 }
 
+// Holidays is a named table of dates, used by the isHoliday expression
+// function and by schedules. Dates are calendar days in the table's
+// time zone.
+type Holidays struct {
+	Text     string
+	Name     string
+	Location *time.Location    `json:"-"`
+	Dates    map[string]string // date (2006-01-02) -> description
+	Locator  `json:"-"`
+}
+
+// HolidayDateFormat is the layout of the dates in a Holidays table.
+const HolidayDateFormat = "2006-01-02"
+
+// Contains returns true if t falls on one of the holidays in the table.
+func (h *Holidays) Contains(t time.Time) bool {
+	_, ok := h.Dates[t.In(h.Location).Format(HolidayDateFormat)]
+	return ok
+}
+
+// Escalation is a policy that notifies successive levels of notifications
+// while an incident goes unacknowledged. The first level is notified along
+// with the alert's own notifications, and each later level Timeout after the
+// level before it. Incidents of alerts using the policy are assigned to Team
+// if they have no team yet.
+type Escalation struct {
+	Text    string
+	Name    string
+	Team    string
+	Timeout time.Duration
+	Levels  [][]*Notification `json:"-"`
+	Locator `json:"-"`
+}
+
+// Level returns the notifications of level i, starting at 1, or nil if
+// there is no such level.
+func (e *Escalation) Level(i int) []*Notification {
+	if e == nil || i < 1 || i > len(e.Levels) {
+		return nil
+	}
+	return e.Levels[i-1]
+}
+
+// A Schedule is used to return values based on the time an expression is
+// evaluated at. It is the time based equivalent of a Lookup: entries are
+// tried in order and the first one that matches the current time and has
+// the requested key wins.
+type Schedule struct {
+	Text     string
+	Name     string
+	Location *time.Location `json:"-"`
+	Holidays *Holidays      `json:"-"`
+	Entries  []*ScheduleEntry
+	Locator  `json:"-"`
+}
+
+// ScheduleEntry is an entry in a Schedule. A nil Window matches at any time
+// of the week. If Holiday is not nil the entry only matches when whether the
+// day is a holiday equals *Holiday.
+type ScheduleEntry struct {
+	Name    string
+	Window  *expr.TimeWindow
+	Holiday *bool
+	Values  map[string]string
+}
+
+// Get returns the value of key from the first entry of the schedule that
+// matches t.
+func (s *Schedule) Get(key string, t time.Time) (value string, ok bool) {
+	t = t.In(s.Location)
+	for _, e := range s.Entries {
+		if e.Window != nil && !e.Window.Contains(t) {
+			continue
+		}
+		if e.Holiday != nil && (s.Holidays == nil || s.Holidays.Contains(t) != *e.Holiday) {
+			continue
+		}
+		if value, ok = e.Values[key]; ok {
+			return
+		}
+	}
+	return "", false
+}
+
 // Macro provides the ability to reuse partial sections of
 // alert definition text. Macros can contain other macros
 type Macro struct {
@@ -355,14 +476,18 @@ type Alert struct {
 	Squelch          Squelches  `json:"-"`
 	CritNotification *Notifications
 	WarnNotification *Notifications
+	Escalation       *Escalation `json:",omitempty"`
 	Unknown          time.Duration
 	MaxLogFrequency  time.Duration
 	IgnoreUnknown    bool
 	UnknownsNormal   bool
 	UnjoinedOK       bool `json:",omitempty"`
+	NoMetrics        bool `json:",omitempty"`
 	Log              bool
 	RunEvery         int
 	ReturnType       models.FuncType
+	Heartbeat        *Heartbeat `json:",omitempty"`
+	SLO              *SLO       `json:",omitempty"`
 
 	TemplateName string   `json:"-"`
 	RawSquelch   []string `json:"-"`
@@ -370,6 +495,39 @@ type Alert struct {
 	Locator `json:"-"`
 }
 
+// Heartbeat is the liveness check of an alert defined by a heartbeat section.
+// The alert is critical for each group of Tags whose Metric has not been seen
+// for longer than Threshold. Groups not seen for longer than Forget are taken
+// to be decommissioned: their incidents are closed and they are no longer
+// checked.
+type Heartbeat struct {
+	Metric    string
+	Tags      opentsdb.TagSet
+	Threshold time.Duration
+	Forget    time.Duration `json:",omitempty"`
+}
+
+// Dashboard is a dashboard defined by a dashboard section.
+type Dashboard struct {
+	Text string
+	models.Dashboard
+	Locator `json:"-"`
+}
+
+// Report is a template rendered and sent to its notifications on the cron
+// schedule of a report section, in Location.
+type Report struct {
+	Text string
+	Vars
+	Name          string
+	Cron          *Cron
+	Location      *time.Location `json:"-"`
+	Template      *Template      `json:"-"`
+	TemplateName  string
+	Notifications *Notifications
+	Locator       `json:"-"`
+}
+
 // A Locator stores the information about the location of the rule in the underlying
 // rule store
 type Locator interface{}
@@ -378,19 +536,20 @@ type Locator interface{}
 type BulkEditRequest []EditRequest
 
 // EditRequest is a proposed edit to the config file for sections. The Name is the name of section,
-// Type can be "alert", "template", "notification", "lookup", or "macro". The Text should be the full
+// Type can be "alert", "heartbeat", "template", "notification", "escalation", "lookup", "macro", "holidays", "schedule", "dashboard", "report" or "slo". The Text should be the full
 // text of the definition, including the declaration and brackets (i.e. "alert foo { .. }"). If Delete
 // is true then the section will be deleted. In order to rename something, specify the old name in the
-// Name field but have the Text definition contain the new name.
+// Name field but have the Text definition contain the new name. An edit changes the file the
+// section is defined in. File is the file a new section is added to, or the first file if empty.
 type EditRequest struct {
 	Name   string
 	Type   string
 	Text   string
 	Delete bool
+	File   string
 }
 
-// SaveHook is a function that is passed files as a string (currently the only implementation
-// has a single file, so there is no convention for the format of multiple files yet), a user
+// SaveHook is a function that is passed the file that was saved as a string, a user
 // a message and vargs. A SaveHook is called when using bosun to save the config. A save is reverted
 // when the SaveHook returns an error.
 type SaveHook func(files, user, message string, args ...string) error
@@ -427,6 +586,28 @@ func MakeSaveCommandHook(cmdName string) (f SaveHook, err error) {
 	return
 }
 
+// ChainSaveHooks returns a SaveHook that calls each of hooks that is not nil
+// in order, and stops at the first error. It returns nil if all are nil.
+func ChainSaveHooks(hooks ...SaveHook) SaveHook {
+	var chain []SaveHook
+	for _, h := range hooks {
+		if h != nil {
+			chain = append(chain, h)
+		}
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+	return func(files, user, message string, args ...string) error {
+		for _, h := range chain {
+			if err := h(files, user, message, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // GenHash generates a unique hash of a string. It is used so we can compare
 // edited text configuration to running text configuration and see if it has
 // changed
@@ -0,0 +1,42 @@
+package conf
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCron(t *testing.T) {
+	// 2017-01-02 is a Monday.
+	from := time.Date(2017, 1, 2, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		cron string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2017, 1, 2, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2017, 1, 2, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * mon", time.Date(2017, 1, 9, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2017, 1, 3, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2017, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2017, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"30 6 15 mar *", time.Date(2017, 3, 15, 6, 30, 0, 0, time.UTC)},
+		// Either the day of the month or of the week.
+		{"0 0 5 * sat", time.Date(2017, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2017, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		c, err := ParseCron(test.cron)
+		if err != nil {
+			t.Errorf("%s: %v", test.cron, err)
+			continue
+		}
+		if next := c.Next(from); !next.Equal(test.next) {
+			t.Errorf("%s: got %v, want %v", test.cron, next, test.next)
+		}
+	}
+	for _, bad := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+		if _, err := ParseCron(bad); err == nil {
+			t.Errorf("expected error parsing %q", bad)
+		}
+	}
+}
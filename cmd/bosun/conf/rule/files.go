@@ -0,0 +1,269 @@
+package rule
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
+)
+
+// File is a file of the rule configuration. Each section belongs to the
+// file it is defined in, and changes to the section only change that file.
+type File struct {
+	Name    string
+	RawText string
+	tree    *parse.Tree
+}
+
+// RuleFiles returns the files of the rule configuration at path, in the
+// order they are loaded. path is a file, a directory of which the files
+// ending in .conf are used, or a glob. Files are ordered by name.
+func RuleFiles(path string) ([]string, error) {
+	if strings.ContainsAny(path, "*?[") {
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, m := range matches {
+			if fi, err := os.Stat(m); err == nil && !fi.IsDir() {
+				names = append(names, m)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no rule files match %s", path)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, fi := range infos {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), ".conf") {
+			names = append(names, filepath.Join(path, fi.Name()))
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no .conf files in %s", path)
+	}
+	return names, nil
+}
+
+// ruleDir returns the directory of the rule configuration at path.
+func ruleDir(path string) string {
+	if !strings.ContainsAny(path, "*?[") {
+		if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+			return path
+		}
+	}
+	return filepath.Dir(path)
+}
+
+// ParseFiles loads the rule configuration at path, as described by
+// RuleFiles. The text of a file named in override is used instead of
+// reading the file.
+func ParseFiles(path string, backends conf.EnabledBackends, sysVars map[string]string, override map[string]string) (*Conf, error) {
+	roots, err := RuleFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	return loadConf(path, roots, backends, sysVars, override)
+}
+
+func loadConf(name string, roots []string, backends conf.EnabledBackends, sysVars map[string]string, override map[string]string) (c *Conf, err error) {
+	defer errRecover(&err)
+	c = newConf(name, backends, sysVars, "")
+	c.roots = roots
+	c.override = override
+	c.load()
+	return
+}
+
+// addFile reads and parses the file name.
+func (c *Conf) addFile(name string) *File {
+	if c.fileNamed(name) != nil {
+		c.errorf("%s is included more than once", name)
+	}
+	text, ok := c.override[name]
+	if !ok {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			c.error(err)
+		}
+		text = string(b)
+	}
+	tree, err := parse.Parse(name, text)
+	if err != nil {
+		c.error(err)
+	}
+	f := &File{Name: name, RawText: text, tree: tree}
+	if len(c.Files) == 0 {
+		c.RawText = text
+	}
+	c.Files = append(c.Files, f)
+	var walk func(n parse.Node)
+	walk = func(n parse.Node) {
+		c.nodeFiles[n] = f
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n != nil {
+				for _, n := range n.Nodes {
+					walk(n)
+				}
+			}
+		case *parse.PairNode:
+			if n != nil {
+				walk(n.Key)
+				walk(n.Val)
+			}
+		case *parse.SectionNode:
+			if n != nil {
+				walk(n.SectionType)
+				walk(n.Name)
+				walk(n.Nodes)
+			}
+		}
+	}
+	walk(tree.Root)
+	return f
+}
+
+// loadFile loads the globals and includes of f and defers its sections.
+func (c *Conf) loadFile(f *File, saw map[string]bool) {
+	for _, n := range f.tree.Root.Nodes {
+		c.try(func() {
+			c.file = f
+			c.at(n)
+			switch n := n.(type) {
+			case *parse.PairNode:
+				if n.Key.Text == "include" {
+					c.include(n, saw)
+					return
+				}
+				c.seen(n.Key.Text, saw)
+				c.loadGlobal(n)
+			case *parse.SectionNode:
+				c.loadSection(n)
+			default:
+				c.errorf("unexpected parse node %s", n)
+			}
+		})
+	}
+}
+
+// include loads the files of an include directive, which is a file,
+// directory or glob relative to the file of the directive, in place of the
+// directive.
+func (c *Conf) include(p *parse.PairNode, saw map[string]bool) {
+	path := c.Expand(p.Val.Text, nil, false)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(c.file.Name), path)
+	}
+	names, err := RuleFiles(path)
+	if err != nil {
+		c.errorf("include: %v", err)
+	}
+	for _, name := range names {
+		c.loadFile(c.addFile(name), saw)
+	}
+}
+
+// fileOf returns the file node n was parsed from.
+func (c *Conf) fileOf(n parse.Node) *File {
+	if f, ok := c.nodeFiles[n]; ok {
+		return f
+	}
+	if c.file != nil {
+		return c.file
+	}
+	return c.Files[0]
+}
+
+func (c *Conf) fileNamed(name string) *File {
+	for _, f := range c.Files {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// fileName returns the name of file, which is the first file if it is
+// empty.
+func (c *Conf) fileName(file string) (string, error) {
+	if file == "" {
+		return c.Files[0].Name, nil
+	}
+	if c.fileNamed(file) == nil {
+		return "", fmt.Errorf("unknown rule file: %s", file)
+	}
+	return file, nil
+}
+
+// rootNodes returns the top level nodes of every file.
+func (c *Conf) rootNodes() []parse.Node {
+	var nodes []parse.Node
+	for _, f := range c.Files {
+		nodes = append(nodes, f.tree.Root.Nodes...)
+	}
+	return nodes
+}
+
+// withFile returns the configuration with the text of the file name
+// replaced by text. Other files keep their text.
+func (c *Conf) withFile(name, text string) (*Conf, error) {
+	override := make(map[string]string, len(c.Files))
+	for _, f := range c.Files {
+		override[f.Name] = f.RawText
+	}
+	override[name] = text
+	return loadConf(c.Name, c.roots, c.backends, c.sysVars, override)
+}
+
+// GetFiles returns the names of the files of the configuration in the order
+// they were read.
+func (c *Conf) GetFiles() []string {
+	names := make([]string, len(c.Files))
+	for i, f := range c.Files {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// GetRawText returns the text of file, or of the first file if file is
+// empty.
+func (c *Conf) GetRawText(file string) (string, error) {
+	name, err := c.fileName(file)
+	if err != nil {
+		return "", err
+	}
+	return c.fileNamed(name).RawText, nil
+}
+
+func (c *Conf) genHash() {
+	if len(c.Files) <= 1 {
+		c.Hash = conf.GenHash(c.RawText)
+		return
+	}
+	var b bytes.Buffer
+	for _, f := range c.Files {
+		fmt.Fprintf(&b, "%s\x00%s\x00", f.Name, f.RawText)
+	}
+	c.Hash = conf.GenHash(b.String())
+}
@@ -0,0 +1,139 @@
+package rule
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+func TestRuleFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rulefiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"b.conf": `
+			include = teams/*.inc
+			alert b {
+				template = t
+				crit = avg(q("avg:m", "1h", "")) > $threshold
+			}
+		`,
+		"a.conf": `
+			$threshold = 1
+			template t {
+				subject = a
+			}
+		`,
+		"teams/db.inc": `
+			alert db {
+				template = t
+				crit = avg(q("avg:db", "1h", "")) > $threshold
+			}
+		`,
+		"notes.txt": "not a rule file",
+	}
+	if err := os.Mkdir(filepath.Join(dir, "teams"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, text := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a, b, db := filepath.Join(dir, "a.conf"), filepath.Join(dir, "b.conf"), filepath.Join(dir, "teams", "db.inc")
+	for _, path := range []string{dir, filepath.Join(dir, "*.conf")} {
+		names, err := RuleFiles(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(names, []string{a, b}) {
+			t.Errorf("%s: bad files: %v", path, names)
+		}
+	}
+
+	backends := conf.EnabledBackends{OpenTSDB: true}
+	c, err := ParseFile(dir, backends, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetReload(func() error { return nil })
+	if !reflect.DeepEqual(c.GetFiles(), []string{a, b, db}) {
+		t.Errorf("bad files: %v", c.GetFiles())
+	}
+	if l := c.Alerts["db"].Locator.(Location); l.File != db {
+		t.Errorf("bad location of db: %v", l)
+	}
+	if text, _ := c.GetRawText(""); text != files["a.conf"] {
+		t.Errorf("bad text of first file: %q", text)
+	}
+
+	// Errors are at the file of the problem.
+	_, err = ParseFiles(dir, backends, nil, map[string]string{db: "alert db {\n\tcrit = 1\n\tcrit = 2\n}\n"})
+	if err == nil || !strings.Contains(err.Error(), db+":3:") {
+		t.Errorf("expected error in %s, got %v", db, err)
+	}
+	_, err = ParseFiles(dir, backends, nil, map[string]string{a: files["a.conf"] + "include = b.conf\n"})
+	if err == nil || !strings.Contains(err.Error(), "included more than once") {
+		t.Errorf("expected include error, got %v", err)
+	}
+
+	// Saves and edits only change the file of the change.
+	stat := func() map[string]string {
+		m := make(map[string]string)
+		for _, name := range []string{a, b, db} {
+			text, err := ioutil.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m[name] = string(text)
+		}
+		return m
+	}
+	newB := strings.Replace(files["b.conf"], "$threshold", "2", 1)
+	diff, err := c.RawDiff(b, newB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SaveRawText(b, newB, diff, "alice", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{a: files["a.conf"], b: newB, db: files["teams/db.inc"]}
+	if got := stat(); !reflect.DeepEqual(got, want) {
+		t.Errorf("bad files after save: %v", got)
+	}
+	if err := c.SaveRawText(filepath.Join(dir, "notes.txt"), "", "", "alice", ""); err == nil {
+		t.Error("expected error saving a file that is not a rule file")
+	}
+
+	c, err = ParseFile(dir, backends, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetReload(func() error { return nil })
+	newDB := "alert db {\n\ttemplate = t\n\tcrit = avg(q(\"avg:db\", \"1h\", \"\")) > 3\n}"
+	newAlert := "alert new {\n\ttemplate = t\n\tcrit = avg(q(\"avg:n\", \"1h\", \"\")) > 4\n}"
+	err = c.BulkEdit(conf.BulkEditRequest{
+		{Type: "alert", Name: "db", Text: newDB},
+		{Type: "alert", Name: "new", Text: newAlert, File: b},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := stat()
+	if got[a] != want[a] {
+		t.Errorf("bulk edit changed %s: %q", a, got[a])
+	}
+	if !strings.Contains(got[db], `"avg:db", "1h", "")) > 3`) || strings.Contains(got[db], "alert new") {
+		t.Errorf("bad %s: %q", db, got[db])
+	}
+	if !strings.HasSuffix(got[b], "\n"+newAlert+"\n") {
+		t.Errorf("bad %s: %q", b, got[b])
+	}
+}
@@ -0,0 +1,449 @@
+package rule
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/slog"
+)
+
+// GitRepo stores the rule files in a git repository by committing them on
+// every save, and stores proposed changes to them as branches until they
+// are approved. It runs the git command.
+type GitRepo struct {
+	sync.Mutex
+	dir    string   // top level of the work tree
+	paths  []string // paths of the rule files in the work tree
+	remote string
+	branch string
+
+	// Review is whether changes must be proposed and approved rather than
+	// saved.
+	Review bool
+}
+
+// Revision is a commit of the rule file.
+type Revision struct {
+	Rev     string
+	Author  string
+	Time    time.Time
+	Message string
+}
+
+// Proposal is a proposed change to a rule file. Rev is the commit of the
+// change on the branch of the proposal, Base the commit it was proposed
+// against, and File the path of the changed file in the work tree.
+type Proposal struct {
+	Id string
+	Revision
+	Base string
+	File string
+}
+
+const proposalRefs = "refs/heads/proposals/"
+
+var gitRevRE = regexp.MustCompile(`^[0-9a-fA-F]{4,40}$`)
+
+// OpenGitRepo opens the git repository the rule configuration at path is
+// in, creating it in the directory of the configuration if there is none.
+// files are the files of the configuration. Changes to them that are not
+// committed, such as edits made outside of bosun, are committed.
+func OpenGitRepo(path string, files []string, c conf.GitConf) (*GitRepo, error) {
+	dir, err := filepath.Abs(ruleDir(path))
+	if err != nil {
+		return nil, err
+	}
+	g := &GitRepo{
+		dir:    dir,
+		remote: c.Remote,
+		branch: c.Branch,
+		Review: c.Review,
+	}
+	if g.branch == "" {
+		g.branch = "master"
+	}
+	top, err := g.git(nil, "rev-parse", "--show-toplevel")
+	if err != nil {
+		if _, err := g.git(nil, "init", "-q"); err != nil {
+			return nil, err
+		}
+		if top, err = g.git(nil, "rev-parse", "--show-toplevel"); err != nil {
+			return nil, err
+		}
+	}
+	g.dir = strings.TrimSpace(top)
+	if path != ruleDir(path) {
+		files = append([]string{path}, files...)
+	} else {
+		// Files added to a directory are rule files.
+		files = append([]string{dir}, files...)
+	}
+	for _, name := range files {
+		if strings.ContainsAny(name, "*?[") {
+			name = ruleDir(name)
+		}
+		p, err := g.rel(name)
+		if err != nil {
+			return nil, err
+		}
+		g.addPath(p)
+	}
+	g.Lock()
+	defer g.Unlock()
+	committed, err := g.commit(g.paths, "bosun", "Commit changes to the rule files made outside of bosun")
+	if err != nil {
+		return nil, err
+	}
+	if committed {
+		g.push("HEAD:refs/heads/" + g.branch)
+	}
+	return g, nil
+}
+
+// rel returns the path of the file name in the work tree.
+func (g *GitRepo) rel(name string) (string, error) {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return "", err
+	}
+	// The top level is reported with symlinks resolved.
+	dir, err := filepath.EvalSymlinks(filepath.Dir(abs))
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(g.dir, filepath.Join(dir, filepath.Base(abs)))
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is not in the git repository at %s", name, g.dir)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (g *GitRepo) addPath(p string) {
+	for _, seen := range g.paths {
+		if seen == p || seen == "." || strings.HasPrefix(p, seen+"/") {
+			return
+		}
+	}
+	g.paths = append(g.paths, p)
+}
+
+// SaveHook returns a save hook that commits the saved rule file with the
+// user as author and pushes it to the remote, if any. A failed commit fails
+// the save, but a failed push only logs a warning, as the next push carries
+// the commit.
+func (g *GitRepo) SaveHook() conf.SaveHook {
+	return func(file, user, message string, args ...string) error {
+		p, err := g.rel(file)
+		if err != nil {
+			return err
+		}
+		g.Lock()
+		defer g.Unlock()
+		g.addPath(p)
+		committed, err := g.commit([]string{p}, user, message)
+		if err != nil {
+			g.git(nil, "reset", "-q", "--", p)
+			return err
+		}
+		if committed {
+			g.push("HEAD:refs/heads/" + g.branch)
+		}
+		return nil
+	}
+}
+
+// commit commits the changes to paths, and returns whether there were any.
+func (g *GitRepo) commit(paths []string, user, message string) (bool, error) {
+	args := append([]string{"status", "--porcelain", "--"}, paths...)
+	status, err := g.git(nil, args...)
+	if err != nil || strings.TrimSpace(status) == "" {
+		return false, err
+	}
+	if message == "" {
+		message = "Update " + strings.Join(paths, ", ")
+	}
+	args = append([]string{"add", "-A", "--"}, paths...)
+	if _, err := g.git(nil, args...); err != nil {
+		return false, err
+	}
+	args = append([]string{"commit", "-q", "--no-gpg-sign", "-m", message, "--"}, paths...)
+	if _, err := g.git(ident(user), args...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (g *GitRepo) push(refspec string) {
+	if g.remote == "" {
+		return
+	}
+	if _, err := g.git(nil, "push", "-q", g.remote, refspec); err != nil {
+		slog.Warningf("rule git repository: %v", err)
+	}
+}
+
+// History returns up to limit commits of the rule files, newest first.
+func (g *GitRepo) History(limit int) ([]Revision, error) {
+	g.Lock()
+	defer g.Unlock()
+	args := append([]string{"log", "-n", strconv.Itoa(limit), "--format=%H%x00%an%x00%at%x00%B%x1e", "--"}, g.paths...)
+	out, err := g.git(nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	revs := []Revision{}
+	for _, rec := range strings.Split(out, "\x1e") {
+		f := strings.SplitN(strings.TrimSpace(rec), "\x00", 4)
+		if len(f) != 4 {
+			continue
+		}
+		revs = append(revs, parseRevision(f))
+	}
+	return revs, nil
+}
+
+func parseRevision(f []string) Revision {
+	sec, _ := strconv.ParseInt(f[2], 10, 64)
+	return Revision{
+		Rev:     f[0],
+		Author:  f[1],
+		Time:    time.Unix(sec, 0).UTC(),
+		Message: strings.TrimSpace(f[3]),
+	}
+}
+
+// Show returns the rule file at the commit rev.
+func (g *GitRepo) Show(rev, file string) (string, error) {
+	if !gitRevRE.MatchString(rev) {
+		return "", fmt.Errorf("bad revision: %q", rev)
+	}
+	p, err := g.rel(file)
+	if err != nil {
+		return "", err
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.show(rev, p)
+}
+
+func (g *GitRepo) show(rev, p string) (string, error) {
+	return g.git(nil, "show", rev+":"+p)
+}
+
+// Propose stores rawConf as a change of the rule file proposed by user
+// against the current commit, without changing the file.
+func (g *GitRepo) Propose(file, rawConf, user, message string) (*Proposal, error) {
+	p, err := g.rel(file)
+	if err != nil {
+		return nil, err
+	}
+	g.Lock()
+	defer g.Unlock()
+	if message == "" {
+		message = "Update " + p
+	}
+	blob, err := g.gitInput(nil, rawConf, "hash-object", "-w", "--stdin")
+	if err != nil {
+		return nil, err
+	}
+	// Build the tree in a separate index, to leave the work tree alone.
+	tmp, err := ioutil.TempDir("", "bosun-git")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	index := []string{"GIT_INDEX_FILE=" + filepath.Join(tmp, "index")}
+	if _, err := g.git(index, "read-tree", "HEAD"); err != nil {
+		return nil, err
+	}
+	if _, err := g.git(index, "update-index", "--add", "--cacheinfo", "100644", strings.TrimSpace(blob), p); err != nil {
+		return nil, err
+	}
+	tree, err := g.git(index, "write-tree")
+	if err != nil {
+		return nil, err
+	}
+	base, err := g.git(nil, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	rev, err := g.git(ident(user), "commit-tree", "--no-gpg-sign", strings.TrimSpace(tree), "-p", strings.TrimSpace(base), "-m", message)
+	if err != nil {
+		return nil, err
+	}
+	rev = strings.TrimSpace(rev)
+	id := rev[:8]
+	if _, err := g.git(nil, "update-ref", proposalRefs+id, rev); err != nil {
+		return nil, err
+	}
+	g.push(proposalRefs + id)
+	return g.proposal(id)
+}
+
+// Proposals returns the changes that are waiting for approval.
+func (g *GitRepo) Proposals() ([]*Proposal, error) {
+	g.Lock()
+	defer g.Unlock()
+	out, err := g.git(nil, "for-each-ref", "--format=%(refname)", proposalRefs)
+	if err != nil {
+		return nil, err
+	}
+	proposals := []*Proposal{}
+	for _, ref := range strings.Fields(out) {
+		p, err := g.proposal(strings.TrimPrefix(ref, proposalRefs))
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, p)
+	}
+	return proposals, nil
+}
+
+// Proposal returns the proposal id.
+func (g *GitRepo) Proposal(id string) (*Proposal, error) {
+	if !gitRevRE.MatchString(id) {
+		return nil, fmt.Errorf("bad proposal: %q", id)
+	}
+	g.Lock()
+	defer g.Unlock()
+	return g.proposal(id)
+}
+
+func (g *GitRepo) proposal(id string) (*Proposal, error) {
+	out, err := g.git(nil, "log", "-1", "--format=%H%x00%an%x00%at%x00%B%x00%P", proposalRefs+id, "--")
+	if err != nil {
+		return nil, fmt.Errorf("no proposal %s", id)
+	}
+	f := strings.SplitN(strings.TrimSpace(out), "\x00", 5)
+	if len(f) != 5 {
+		return nil, fmt.Errorf("bad proposal %s", id)
+	}
+	p := &Proposal{
+		Id:       id,
+		Revision: parseRevision(f[:4]),
+		Base:     f[4],
+	}
+	files, err := g.git(nil, "diff-tree", "--no-commit-id", "--name-only", "-r", p.Base, p.Rev)
+	if err != nil {
+		return nil, err
+	}
+	if files := strings.Fields(files); len(files) > 0 {
+		p.File = files[0]
+	}
+	return p, nil
+}
+
+// ProposalText returns the rule file of a proposal.
+func (g *GitRepo) ProposalText(p *Proposal) (string, error) {
+	g.Lock()
+	defer g.Unlock()
+	return g.show(p.Rev, p.File)
+}
+
+// ProposalFile returns the name of the file of c that p changes.
+func (g *GitRepo) ProposalFile(c conf.RuleConfWriter, p *Proposal) (string, error) {
+	for _, name := range c.GetFiles() {
+		if rel, err := g.rel(name); err == nil && rel == p.File {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("proposal %s changes %s, which is not a rule file", p.Id, p.File)
+}
+
+// Approve saves the change of proposal id to c as approved by user, who
+// must not be its author. The commit has the author of the proposal, and
+// notes the approver. The rule file must not have been committed since the
+// change was proposed.
+func (g *GitRepo) Approve(c conf.RuleConfWriter, id, user string) error {
+	p, err := g.Proposal(id)
+	if err != nil {
+		return err
+	}
+	if p.Author == user {
+		return fmt.Errorf("proposal %s must be approved by another user than %s", id, user)
+	}
+	file, err := g.ProposalFile(c, p)
+	if err != nil {
+		return err
+	}
+	g.Lock()
+	head, err := g.git(nil, "rev-parse", "HEAD")
+	if err == nil && strings.TrimSpace(head) != p.Base {
+		err = fmt.Errorf("the rule file changed since proposal %s, which must be proposed again", id)
+	}
+	var text string
+	if err == nil {
+		text, err = g.show(p.Rev, p.File)
+	}
+	g.Unlock()
+	if err != nil {
+		return err
+	}
+	diff, err := c.RawDiff(file, text)
+	if err != nil {
+		return err
+	}
+	if err := c.SaveRawText(file, text, diff, p.Author, p.Message+"\n\nApproved-by: "+user); err != nil {
+		return err
+	}
+	return g.Reject(id)
+}
+
+// Reject removes proposal id.
+func (g *GitRepo) Reject(id string) error {
+	if !gitRevRE.MatchString(id) {
+		return fmt.Errorf("bad proposal: %q", id)
+	}
+	g.Lock()
+	defer g.Unlock()
+	if _, err := g.git(nil, "update-ref", "-d", proposalRefs+id); err != nil {
+		return err
+	}
+	g.push(":" + proposalRefs + id)
+	return nil
+}
+
+// ident returns the environment of a commit authored by user and
+// committed by bosun.
+func ident(user string) []string {
+	if user == "" {
+		user = "bosun"
+	}
+	return []string{
+		"GIT_AUTHOR_NAME=" + user,
+		"GIT_AUTHOR_EMAIL=" + user,
+		"GIT_COMMITTER_NAME=bosun",
+		"GIT_COMMITTER_EMAIL=bosun",
+	}
+}
+
+func (g *GitRepo) git(env []string, args ...string) (string, error) {
+	return g.gitInput(env, "", args...)
+}
+
+func (g *GitRepo) gitInput(env []string, input string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,149 @@
+package rule
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+func TestGitRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	dir, err := ioutil.TempDir("", "rulegit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	remote := filepath.Join(dir, "remote.git")
+	if out, err := exec.Command("git", "init", "-q", "--bare", remote).CombinedOutput(); err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "rules"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "rules", "bosun.conf")
+	v1 := gitTestConf(1)
+	if err := ioutil.WriteFile(file, []byte(v1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	g, err := OpenGitRepo(file, []string{file}, conf.GitConf{Enabled: true, Remote: remote})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c *Conf
+	reloads := -1
+	var reload func() error
+	reload = func() error {
+		reloads++
+		newConf, err := ParseFile(file, conf.EnabledBackends{OpenTSDB: true}, nil)
+		if err != nil {
+			return err
+		}
+		newConf.SetSaveHook(g.SaveHook())
+		newConf.SetReload(reload)
+		c = newConf
+		return nil
+	}
+	if err := reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	v2 := gitTestConf(2)
+	diff, err := c.RawDiff("", v2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SaveRawText("", v2, diff, "alice", "raise a"); err != nil {
+		t.Fatal(err)
+	}
+	revs, err := g.History(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 2 || revs[0].Author != "alice" || revs[0].Message != "raise a" || revs[1].Author != "bosun" {
+		t.Fatalf("bad history: %+v", revs)
+	}
+	if text, err := g.Show(revs[1].Rev, file); err != nil || text != v1 {
+		t.Errorf("bad text of %s: %q, %v", revs[1].Rev, text, err)
+	}
+	out, err := exec.Command("git", "--git-dir", remote, "log", "-1", "--format=%an %s", "master").CombinedOutput()
+	if err != nil || strings.TrimSpace(string(out)) != "alice raise a" {
+		t.Errorf("bad remote: %s, %v", out, err)
+	}
+
+	// Saving an unchanged file commits nothing.
+	if err := c.SaveRawText("", v2, "", "alice", "again"); err != nil {
+		t.Fatal(err)
+	}
+	if revs, _ := g.History(10); len(revs) != 2 {
+		t.Errorf("expected no new commit, got %+v", revs)
+	}
+
+	v3 := gitTestConf(3)
+	p, err := g.Propose(file, v3, "bob", "raise a more")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(file); string(b) != v2 {
+		t.Errorf("proposal changed the file: %q", b)
+	}
+	if p.Author != "bob" || p.Base != revs[0].Rev || p.File != "bosun.conf" {
+		t.Errorf("bad proposal: %+v", p)
+	}
+	ps, err := g.Proposals()
+	if err != nil || len(ps) != 1 || ps[0].Id != p.Id {
+		t.Fatalf("bad proposals: %+v, %v", ps, err)
+	}
+	if err := g.Approve(c, p.Id, "bob"); err == nil {
+		t.Error("expected author to be unable to approve")
+	}
+	if err := g.Approve(c, p.Id, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(file); string(b) != v3 {
+		t.Errorf("approval did not change the file: %q", b)
+	}
+	revs, err = g.History(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 3 || revs[0].Author != "bob" || revs[0].Message != "raise a more\n\nApproved-by: alice" {
+		t.Errorf("bad history: %+v", revs)
+	}
+	if ps, _ := g.Proposals(); len(ps) != 0 {
+		t.Errorf("expected approved proposal to be removed: %+v", ps)
+	}
+	if reloads != 3 {
+		t.Errorf("expected 3 reloads, got %d", reloads)
+	}
+
+	// A proposal against an older commit can't be approved.
+	p, err = g.Propose(file, v1, "bob", "lower a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, _ = c.RawDiff("", v2)
+	if err := c.SaveRawText("", v2, diff, "alice", "lower a some"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Approve(c, p.Id, "alice"); err == nil || !strings.Contains(err.Error(), "proposed again") {
+		t.Errorf("expected stale proposal error, got %v", err)
+	}
+	if err := g.Reject(p.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Proposal(p.Id); err == nil {
+		t.Error("expected rejected proposal to be removed")
+	}
+}
+
+func gitTestConf(crit int) string {
+	return fmt.Sprintf("alert a {\n\tcrit = avg(q(\"avg:m\", \"1h\", \"\")) > %d\n}\n", crit)
+}
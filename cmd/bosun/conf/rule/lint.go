@@ -0,0 +1,566 @@
+package rule
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	eparse "github.com/leapar/bosun/cmd/bosun/expr/parse"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+// Diagnostic severities.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Diagnostic is a problem found in a rule file by Lint.
+type Diagnostic struct {
+	Severity string
+	// Check names the check that found a warning, such as "unused-template".
+	Check   string `json:",omitempty"`
+	File    string `json:",omitempty"` // file of the problem, if the configuration has several
+	Line    int    // starting at 1, or 0 if the problem is not at a line
+	Column  int    // byte offset within the line, starting at 0
+	Section string `json:",omitempty"` // type and name of the enclosing section, such as "alert os.cpu"
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	s := fmt.Sprintf("%d:%d: %s: %s", d.Line, d.Column, d.Severity, d.Message)
+	if d.File != "" {
+		s = d.File + ":" + s
+	}
+	return s
+}
+
+// lintError is the panic value of errorf while linting.
+type lintError struct {
+	node parse.Node
+	msg  string
+}
+
+func (e *lintError) Error() string {
+	return e.msg
+}
+
+// Lint loads the rule configuration in text and returns every error and
+// warning found in it, ordered by position. Unlike NewConf, it does not stop
+// at the first error: a section that fails to load is reported and loading
+// continues with the next section. metricSeen, if not nil, reports whether a
+// metric has been seen by search, and is used to find queries on metrics that
+// don't exist.
+func Lint(name string, backends conf.EnabledBackends, sysVars map[string]string, text string, metricSeen func(metric string) bool) []Diagnostic {
+	return lint(name, []string{name}, backends, sysVars, map[string]string{name: text}, metricSeen)
+}
+
+// LintFiles is like Lint for the rule configuration at path, as described by
+// RuleFiles. The text of a file named in override is used instead of reading
+// the file.
+func LintFiles(path string, backends conf.EnabledBackends, sysVars map[string]string, override map[string]string, metricSeen func(metric string) bool) []Diagnostic {
+	roots, err := RuleFiles(path)
+	if err != nil {
+		return []Diagnostic{{Severity: SeverityError, Message: err.Error()}}
+	}
+	return lint(path, roots, backends, sysVars, override, metricSeen)
+}
+
+func lint(name string, roots []string, backends conf.EnabledBackends, sysVars map[string]string, override map[string]string, metricSeen func(metric string) bool) []Diagnostic {
+	c := newConf(name, backends, sysVars, "")
+	c.roots = roots
+	c.override = override
+	c.linting = true
+	c.diagnostics = []Diagnostic{}
+	for _, root := range roots {
+		text, ok := override[root]
+		if !ok {
+			b, err := ioutil.ReadFile(root)
+			if err != nil {
+				return []Diagnostic{{Severity: SeverityError, File: root, Message: err.Error()}}
+			}
+			text = string(b)
+		}
+		if _, err := parse.Parse(root, text); err != nil {
+			d := Diagnostic{
+				Severity: SeverityError,
+				Message:  err.Error(),
+			}
+			if err, ok := err.(*parse.Error); ok {
+				d.Line = err.Line
+				d.Message = err.Msg
+			}
+			if len(roots) > 1 {
+				d.File = root
+			}
+			return []Diagnostic{d}
+		}
+	}
+	c.load()
+	c.lint(metricSeen)
+	order := make(map[string]int, len(c.Files))
+	for i, f := range c.Files {
+		order[f.Name] = i
+	}
+	if len(c.Files) == 1 {
+		for i := range c.diagnostics {
+			c.diagnostics[i].File = ""
+		}
+	}
+	sort.SliceStable(c.diagnostics, func(i, j int) bool {
+		a, b := c.diagnostics[i], c.diagnostics[j]
+		if a.File != b.File {
+			return order[a.File] < order[b.File]
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return c.diagnostics
+}
+
+// try runs f, which loads part of the configuration, and reports whether it
+// succeeded. When linting, an error from f is recorded and loading
+// continues. Otherwise the error terminates processing as usual.
+func (c *Conf) try(f func()) (ok bool) {
+	if !c.linting {
+		f()
+		return true
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			err, isLintError := e.(*lintError)
+			if !isLintError {
+				if _, isRuntime := e.(runtime.Error); isRuntime {
+					panic(e)
+				}
+				cause, isError := e.(error)
+				if !isError {
+					panic(e)
+				}
+				err = &lintError{node: c.node, msg: cause.Error()}
+			}
+			c.addDiagnostic(SeverityError, "", err.node, err.msg)
+			ok = false
+		}
+	}()
+	f()
+	return true
+}
+
+// warnf records a warning at node n when linting.
+func (c *Conf) warnf(n parse.Node, check, format string, args ...interface{}) {
+	if c.linting {
+		c.addDiagnostic(SeverityWarning, check, n, fmt.Sprintf(format, args...))
+	}
+}
+
+func (c *Conf) addDiagnostic(severity, check string, n parse.Node, msg string) {
+	d := Diagnostic{
+		Severity: severity,
+		Check:    check,
+		Message:  msg,
+	}
+	if n != nil {
+		f := c.fileOf(n)
+		d.File = f.Name
+		d.Line, d.Column = f.tree.LineColumn(n)
+		pos := int(n.Position())
+		for _, s := range f.tree.Root.Nodes {
+			s, ok := s.(*parse.SectionNode)
+			if !ok {
+				continue
+			}
+			l := c.newSectionLocator(s)
+			if pos >= getLocationStart(l) && pos < getLocationEnd(l) {
+				d.Section = s.SectionType.Text + " " + s.Name.Text
+				break
+			}
+		}
+	}
+	for _, seen := range c.diagnostics {
+		if seen == d {
+			return
+		}
+	}
+	c.diagnostics = append(c.diagnostics, d)
+}
+
+// placeholder defines an empty section in place of s, which failed to load
+// while linting, so that references to it are not reported as well.
+func (c *Conf) placeholder(s *parse.SectionNode) {
+	name := s.Name.Text
+	switch s.SectionType.Text {
+	case "template":
+		if _, ok := c.Templates[name]; !ok {
+			c.Templates[name] = &conf.Template{Name: name, Vars: make(map[string]string)}
+		}
+	case "notification":
+		if _, ok := c.Notifications[name]; !ok {
+			c.Notifications[name] = &conf.Notification{Name: name, Vars: make(map[string]string)}
+		}
+	case "macro":
+		if _, ok := c.Macros[name]; !ok {
+			c.Macros[name] = &conf.Macro{Name: name, Pairs: []nodePair{}}
+		}
+	case "lookup":
+		if _, ok := c.Lookups[name]; !ok {
+			c.Lookups[name] = &conf.Lookup{Name: name}
+		}
+	case "holidays":
+		if _, ok := c.Holidays[name]; !ok {
+			c.Holidays[name] = &conf.Holidays{Name: name, Dates: make(map[string]string)}
+		}
+	case "schedule":
+		if _, ok := c.Schedules[name]; !ok {
+			c.Schedules[name] = &conf.Schedule{Name: name}
+		}
+	case "escalation":
+		if _, ok := c.Escalations[name]; !ok {
+			c.Escalations[name] = &conf.Escalation{Name: name}
+		}
+	}
+}
+
+// sectionNode returns the node of the section with the given type and name.
+func (c *Conf) sectionNode(sectionType, name string) parse.Node {
+	for _, n := range c.rootNodes() {
+		if s, ok := n.(*parse.SectionNode); ok && s.SectionType.Text == sectionType && s.Name.Text == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// alertNode returns the node of the alert, heartbeat or slo section of a.
+func (c *Conf) alertNode(a *conf.Alert) parse.Node {
+	if a.Heartbeat != nil {
+		return c.sectionNode("heartbeat", a.Name)
+	}
+	if a.SLO != nil {
+		return c.sectionNode("slo", a.Name)
+	}
+	return c.sectionNode("alert", a.Name)
+}
+
+// lint runs the checks that find problems which are not errors.
+func (c *Conf) lint(metricSeen func(string) bool) {
+	c.lintTemplates()
+	c.lintNotifications()
+	c.lintEscalations()
+	c.lintLookups()
+	c.lintAlerts(metricSeen)
+	c.lintDepends()
+}
+
+var templateVarRE = regexp.MustCompile(`\.Alert\.Vars\.(\w+)`)
+
+func (c *Conf) lintTemplates() {
+	for _, name := range sortedKeys(c.Templates) {
+		t := c.Templates[name]
+		used := name == c.unknownTemplate
+		var users []*conf.Alert
+		for _, a := range c.Alerts {
+			if a.TemplateName == name {
+				used = true
+				users = append(users, a)
+			}
+		}
+		for _, r := range c.Reports {
+			if r.TemplateName == name {
+				used = true
+			}
+		}
+		included := regexp.MustCompile(`\{\{-?\s*template\s+"` + regexp.QuoteMeta(name) + `"`)
+		for _, other := range c.Templates {
+			if other != t && (included.MatchString(other.RawBody) || included.MatchString(other.RawSubject)) {
+				used = true
+			}
+		}
+		n := c.sectionNode("template", name)
+		if !used {
+			c.warnf(n, "unused-template", "template %s is not used by any alert", name)
+		}
+		sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+		seen := make(map[string]bool)
+		for _, m := range templateVarRE.FindAllStringSubmatch(t.RawBody+"\n"+t.RawSubject, -1) {
+			v := m[1]
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			for _, a := range users {
+				if _, ok := a.Vars[v]; !ok {
+					c.warnf(n, "unknown-variable", "template %s uses .Alert.Vars.%s, which alert %s does not define", name, v, a.Name)
+				}
+			}
+		}
+	}
+}
+
+func (c *Conf) lintNotifications() {
+	reached := make(map[string]bool)
+	var reach func(n *conf.Notification)
+	reach = func(n *conf.Notification) {
+		for ; n != nil && !reached[n.Name]; n = n.Next {
+			reached[n.Name] = true
+		}
+	}
+	for _, a := range c.Alerts {
+		if a.Escalation != nil {
+			for _, level := range a.Escalation.Levels {
+				for _, n := range level {
+					reach(n)
+				}
+			}
+		}
+		for _, ns := range []*conf.Notifications{a.CritNotification, a.WarnNotification} {
+			for _, n := range ns.Notifications {
+				reach(n)
+			}
+			for key, l := range ns.Lookups {
+				for _, e := range l.Entries {
+					for _, name := range strings.Split(e.Values[key], ",") {
+						reach(c.Notifications[strings.TrimSpace(name)])
+					}
+				}
+			}
+		}
+	}
+	for _, r := range c.Reports {
+		for _, n := range r.Notifications.Notifications {
+			reach(n)
+		}
+	}
+	for _, name := range sortedKeys(c.Notifications) {
+		n := c.Notifications[name]
+		node := c.sectionNode("notification", name)
+		if !reached[name] {
+			var from []string
+			for _, other := range c.Notifications {
+				if other.NextName == name && other != n {
+					from = append(from, other.Name)
+				}
+			}
+			if len(from) == 0 {
+				c.warnf(node, "unused-notification", "notification %s is not used by any alert", name)
+			} else {
+				sort.Strings(from)
+				c.warnf(node, "unreachable-next", "notification %s is only the next of unused notifications: %s", name, strings.Join(from, ", "))
+			}
+			continue
+		}
+		// A chain that loops back to n without any timeout notifies on
+		// every check. The loop is reported at its first notification.
+		loop := []string{name}
+		timeout := n.Timeout
+		next := n.Next
+		for ; next != nil && next != n && len(loop) <= len(c.Notifications); next = next.Next {
+			loop = append(loop, next.Name)
+			timeout += next.Timeout
+		}
+		if next == n && timeout == 0 {
+			first := append([]string{}, loop...)
+			sort.Strings(first)
+			if first[0] == name {
+				c.warnf(node, "next-loop", "notifications loop through next without a timeout: %s -> %s", strings.Join(loop, " -> "), name)
+			}
+		}
+	}
+}
+
+func (c *Conf) lintLookups() {
+	for _, name := range sortedKeys(c.Lookups) {
+		used := false
+		for _, a := range c.Alerts {
+			for _, ns := range []*conf.Notifications{a.CritNotification, a.WarnNotification} {
+				for _, l := range ns.Lookups {
+					if l.Name == name {
+						used = true
+					}
+				}
+			}
+		}
+		ref := regexp.MustCompile(`(?i)lookup\w*\W+"` + regexp.QuoteMeta(name) + `"`)
+		for _, a := range c.Alerts {
+			used = used || ref.MatchString(a.Text)
+		}
+		for _, t := range c.Templates {
+			used = used || ref.MatchString(t.Text)
+		}
+		for _, m := range c.Macros {
+			used = used || ref.MatchString(m.Text)
+		}
+		if !used {
+			c.warnf(c.sectionNode("lookup", name), "unused-lookup", "lookup %s is not used by any alert or template", name)
+		}
+	}
+}
+
+func (c *Conf) lintEscalations() {
+	for _, name := range sortedKeys(c.Escalations) {
+		used := false
+		for _, a := range c.Alerts {
+			used = used || a.Escalation == c.Escalations[name]
+		}
+		if !used {
+			c.warnf(c.sectionNode("escalation", name), "unused-escalation", "escalation %s is not used by any alert", name)
+		}
+	}
+}
+
+func (c *Conf) lintAlerts(metricSeen func(string) bool) {
+	for _, name := range sortedKeys(c.Alerts) {
+		a := c.Alerts[name]
+		n := c.alertNode(a)
+		if a.Crit == nil {
+			c.warnf(n, "no-crit", "alert %s has no crit expression", name)
+		}
+		if metricSeen == nil {
+			continue
+		}
+		seen := make(map[string]bool)
+		if a.Heartbeat != nil {
+			seen[a.Heartbeat.Metric] = true
+			if !metricSeen(a.Heartbeat.Metric) {
+				c.warnf(n, "unknown-metric", "heartbeat %s watches metric %s, which has never been seen", name, a.Heartbeat.Metric)
+			}
+		}
+		for _, e := range []*expr.Expr{a.Crit, a.Warn, a.Depends} {
+			for _, metric := range queriedMetrics(e) {
+				if seen[metric] {
+					continue
+				}
+				seen[metric] = true
+				if !metricSeen(metric) {
+					c.warnf(n, "unknown-metric", "alert %s queries metric %s, which has never been seen", name, metric)
+				}
+			}
+		}
+	}
+}
+
+// queriedMetrics returns the metrics queried by the OpenTSDB functions in e.
+func queriedMetrics(e *expr.Expr) []string {
+	if e == nil {
+		return nil
+	}
+	var metrics []string
+	eparse.Walk(e.Root, func(n eparse.Node) {
+		f, ok := n.(*eparse.FuncNode)
+		if !ok || len(f.Args) == 0 {
+			return
+		}
+		if _, ok := expr.TSDB[f.Name]; !ok {
+			return
+		}
+		s, ok := f.Args[0].(*eparse.StringNode)
+		if !ok {
+			return
+		}
+		q, err := opentsdb.ParseQuery(s.Text, opentsdb.Version2_2)
+		if err != nil {
+			return
+		}
+		metrics = append(metrics, q.Metric)
+	})
+	return metrics
+}
+
+var alertRefRE = regexp.MustCompile(`\balert\(\s*"([^"]+)"`)
+
+// lintDepends reports alerts that refer to each other in a cycle with the
+// alert function. Such alerts fail to load, as an alert can only refer to
+// alerts defined before it, but the error does not explain why. The
+// references are found in the text of each alert section, because the
+// alerts in a cycle never load.
+func (c *Conf) lintDepends() {
+	refs := make(map[string][]string)
+	for _, n := range c.rootNodes() {
+		s, ok := n.(*parse.SectionNode)
+		if !ok || s.SectionType.Text != "alert" {
+			continue
+		}
+		for _, p := range s.Nodes.Nodes {
+			if p, ok := p.(*parse.PairNode); ok {
+				for _, m := range alertRefRE.FindAllStringSubmatch(p.Val.Text, -1) {
+					refs[s.Name.Text] = append(refs[s.Name.Text], m[1])
+				}
+			}
+		}
+	}
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		switch state[name] {
+		case visiting:
+			for i, p := range path {
+				if p == name {
+					cycle := append(append([]string{}, path[i:]...), name)
+					c.warnf(c.sectionNode("alert", name), "depends-cycle", "alerts refer to each other in a cycle: %s", strings.Join(cycle, " -> "))
+				}
+			}
+			return
+		case done:
+			return
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, ref := range refs[name] {
+			visit(ref)
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+	}
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		visit(name)
+	}
+}
+
+// sortedKeys returns the keys of m, which must be a map with string keys,
+// in order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*conf.Template:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*conf.Notification:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*conf.Escalation:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*conf.Lookup:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*conf.Alert:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,117 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+const lintConf = `$threshold = 5
+
+template used {
+	subject = {{.Alert.Vars.threshold}} {{.Alert.Vars.missing}}
+}
+
+template unused {
+	subject = unused
+}
+
+notification first {
+	print = true
+	next = first
+}
+
+notification orphan {
+	print = true
+	next = orphaned
+}
+
+notification orphaned {
+	print = true
+}
+
+notification broken {
+	email = not an email
+}
+
+lookup unused {
+	entry host=* {
+		v = 1
+	}
+}
+
+macro m {
+	$threshold = 10
+}
+
+alert warnOnly {
+	template = used
+	macro = m
+	warn = avg(q("avg:seen", "1h", "")) > $threshold
+	warnNotification = first
+}
+
+alert unseen {
+	crit = avg(q("avg:unseen", "1h", "")) > 1
+}
+
+alert duplicate {
+	crit = 1
+	crit = 2
+}
+
+alert a {
+	crit = alert("b", "crit")
+}
+
+alert b {
+	crit = alert("a", "crit")
+}
+
+macro m {
+	$threshold = 20
+}
+`
+
+func TestLint(t *testing.T) {
+	metricSeen := func(metric string) bool {
+		return metric == "seen"
+	}
+	diagnostics := Lint("lint", conf.EnabledBackends{OpenTSDB: true}, nil, lintConf, metricSeen)
+	expected := []Diagnostic{
+		{SeverityWarning, "unknown-variable", "", 3, 0, "template used", "template used uses .Alert.Vars.missing, which alert warnOnly does not define"},
+		{SeverityWarning, "unused-template", "", 7, 0, "template unused", "template unused is not used by any alert"},
+		{SeverityWarning, "next-loop", "", 11, 0, "notification first", "notifications loop through next without a timeout: first -> first"},
+		{SeverityWarning, "unused-notification", "", 16, 0, "notification orphan", "notification orphan is not used by any alert"},
+		{SeverityError, "", "", 18, 1, "notification orphan", "unknown notification orphaned"},
+		{SeverityWarning, "unreachable-next", "", 21, 0, "notification orphaned", "notification orphaned is only the next of unused notifications: orphan"},
+		{SeverityWarning, "unused-notification", "", 25, 0, "notification broken", "notification broken is not used by any alert"},
+		{SeverityError, "", "", 26, 1, "notification broken", "mail: no angle-addr"},
+		{SeverityWarning, "unused-lookup", "", 29, 0, "lookup unused", "lookup unused is not used by any alert or template"},
+		{SeverityWarning, "shadowed-variable", "", 36, 1, "macro m", "$threshold shadows the global variable of the same name"},
+		{SeverityWarning, "no-crit", "", 39, 0, "alert warnOnly", "alert warnOnly has no crit expression"},
+		{SeverityWarning, "unknown-metric", "", 46, 0, "alert unseen", "alert unseen queries metric unseen, which has never been seen"},
+		{SeverityError, "", "", 52, 1, "alert duplicate", "duplicate key: crit"},
+		{SeverityError, "", "", 55, 0, "alert a", "bad alert name b"},
+		{SeverityWarning, "depends-cycle", "", 55, 0, "alert a", "alerts refer to each other in a cycle: a -> b -> a"},
+		{SeverityError, "", "", 59, 0, "alert b", "bad alert name a"},
+		{SeverityError, "", "", 63, 0, "macro m", "duplicate macro name: m"},
+	}
+	for i := 0; i < len(diagnostics) || i < len(expected); i++ {
+		switch {
+		case i >= len(diagnostics):
+			t.Errorf("missing diagnostic %v", expected[i])
+		case i >= len(expected):
+			t.Errorf("unexpected diagnostic %v", diagnostics[i])
+		case diagnostics[i] != expected[i]:
+			t.Errorf("got %#v, expected %#v", diagnostics[i], expected[i])
+		}
+	}
+}
+
+func TestLintSyntaxError(t *testing.T) {
+	diagnostics := Lint("lint", conf.EnabledBackends{}, nil, "alert a {\n\tcrit = 1\n", nil)
+	if len(diagnostics) != 1 || diagnostics[0].Severity != SeverityError || diagnostics[0].Line != 3 {
+		t.Errorf("unexpected diagnostics %v", diagnostics)
+	}
+}
@@ -3,36 +3,36 @@ package rule
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/leapar/bosun/cmd/bosun/conf"
 	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
 	"github.com/pmezard/go-difflib/difflib"
 )
 
-// SaveRawText saves a new configuration file. The contextual diff of the change is provided
+// SaveRawText saves a new text of a configuration file, which is the first file if file is
+// empty. Other files are not changed. The contextual diff of the change is provided
 // to verify that no other changes have happened since the save request is issue. User, message, and
 // args are passed to an optionally configured save hook. If the config file is not valid the file
 // will not be saved. If the savehook fails to run or returns an error thaen the orginal config
 // will be restored and the reload will not take place.
-func (c *Conf) SaveRawText(rawConfig, diff, user, message string, args ...string) error {
-	newConf, err := NewConf(c.Name, c.backends, c.sysVars, rawConfig)
+func (c *Conf) SaveRawText(file, rawConfig, diff, user, message string, args ...string) error {
+	name, err := c.fileName(file)
 	if err != nil {
 		return err
 	}
-	currentDiff, err := c.RawDiff(rawConfig)
+	newConf, err := c.checkRawText(name, rawConfig, diff)
 	if err != nil {
-		return fmt.Errorf("couldn't save config because failed to generate a diff: %v", err)
-	}
-	if currentDiff != diff {
-		return fmt.Errorf("couldn't save config file because the change and supplied diff do not match the current diff")
+		return err
 	}
 	if err = c.SaveConf(newConf); err != nil {
 		return fmt.Errorf("couldn't save config file: %v", err)
 	}
 	if c.saveHook != nil {
-		err := c.callSaveHook(c.Name, user, message, args...)
+		err := c.callSaveHook(name, user, message, args...)
 		if err != nil {
-			sErr := c.SaveConf(c)
+			sErr := newConf.SaveConf(c)
 			restore := "successful"
 			if sErr != nil {
 				restore = sErr.Error()
@@ -47,8 +47,37 @@ func (c *Conf) SaveRawText(rawConfig, diff, user, message string, args ...string
 	return nil
 }
 
-// BulkEdit applies sequental edits to the configuration file. Each individual edit
-// must generate a valid configuration or the edit request will fail.
+// CheckRawText verifies that a new configuration file is valid and that
+// the diff of the change is the current diff, as SaveRawText does, without
+// saving it.
+func (c *Conf) CheckRawText(file, rawConfig, diff string) error {
+	name, err := c.fileName(file)
+	if err != nil {
+		return err
+	}
+	_, err = c.checkRawText(name, rawConfig, diff)
+	return err
+}
+
+func (c *Conf) checkRawText(name, rawConfig, diff string) (*Conf, error) {
+	newConf, err := c.withFile(name, rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	currentDiff, err := c.RawDiff(name, rawConfig)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't save config because failed to generate a diff: %v", err)
+	}
+	if currentDiff != diff {
+		return nil, fmt.Errorf("couldn't save config file because the change and supplied diff do not match the current diff")
+	}
+	return newConf, nil
+}
+
+// BulkEdit applies sequental edits to the configuration files. Each individual edit
+// must generate a valid configuration or the edit request will fail. An edit changes
+// the file of the section it edits, and a new section is added to the file of the
+// edit, or to the first file.
 func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
 	select {
 	case c.writeLock <- true:
@@ -59,76 +88,184 @@ func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
 	defer func() {
 		<-c.writeLock
 	}()
+	newConf, err := c.applyEdits(edits)
+	if err != nil {
+		return err
+	}
+	if err := c.SaveConf(newConf); err != nil {
+		return fmt.Errorf("couldn't save config file: %v", err)
+	}
+	err = c.reload()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// BulkEditChanges returns the names of the alerts that are added, removed
+// or changed by edits, and whether anything other than alerts is changed.
+func (c *Conf) BulkEditChanges(edits conf.BulkEditRequest) (alerts []string, other bool, err error) {
+	newConf, err := c.applyEdits(edits)
+	if err != nil {
+		return nil, false, err
+	}
+	alerts, other = c.changes(newConf)
+	return alerts, other, nil
+}
+
+// applyEdits returns the configuration with edits applied, without saving
+// it.
+func (c *Conf) applyEdits(edits conf.BulkEditRequest) (*Conf, error) {
 	newConf := c
 	var err error
 	for _, edit := range edits {
-		var l Location
+		var l conf.Locator
 		switch edit.Type {
-		case "alert":
+		case "alert", "heartbeat", "slo":
 			a := newConf.GetAlert(edit.Name)
 			if a != nil {
-				l = a.Locator.(Location)
+				l = a.Locator
 			}
 		case "template":
 			t := newConf.GetTemplate(edit.Name)
 			if t != nil {
-				l = t.Locator.(Location)
+				l = t.Locator
 			}
 		case "notification":
 			n := newConf.GetNotification(edit.Name)
 			if n != nil {
-				l = n.Locator.(Location)
+				l = n.Locator
+			}
+		case "escalation":
+			e := newConf.GetEscalation(edit.Name)
+			if e != nil {
+				l = e.Locator
 			}
 		case "lookup":
 			look := newConf.GetLookup(edit.Name)
 			if look != nil {
-				l = look.Locator.(Location)
+				l = look.Locator
 			}
 		case "macro":
 			m := newConf.GetMacro(edit.Name)
 			if m != nil {
-				l = m.Locator.(Location)
+				l = m.Locator
+			}
+		case "holidays":
+			h := newConf.GetHolidays(edit.Name)
+			if h != nil {
+				l = h.Locator
+			}
+		case "schedule":
+			s := newConf.GetSchedule(edit.Name)
+			if s != nil {
+				l = s.Locator
+			}
+		case "dashboard":
+			d := newConf.GetDashboard(edit.Name)
+			if d != nil {
+				l = d.Locator
+			}
+		case "report":
+			r := newConf.GetReport(edit.Name)
+			if r != nil {
+				l = r.Locator
 			}
 		default:
-			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup or macro", edit.Type)
+			return nil, fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, heartbeat, template, notification, escalation, lookup, macro, holidays, schedule, dashboard, report or slo", edit.Type)
 		}
+		loc, found := l.(Location)
+		file := loc.File
+		if !found {
+			if file, err = newConf.fileName(edit.File); err != nil {
+				return nil, err
+			}
+		}
+		rawText := newConf.fileNamed(file).RawText
 		var rawConf string
 		if edit.Delete {
-			if l == nil {
-				return fmt.Errorf("could not delete %v:%v - not found", edit.Type, edit.Name)
+			if !found {
+				return nil, fmt.Errorf("could not delete %v:%v - not found", edit.Type, edit.Name)
 			}
-			rawConf = removeSection(l, newConf.RawText)
+			rawConf = removeSection(loc, rawText)
+		} else if found {
+			rawConf = writeSection(loc, rawText, edit.Text)
 		} else {
-			rawConf = writeSection(l, newConf.RawText, edit.Text)
+			rawConf = rawText + "\n" + edit.Text + "\n"
 		}
-		newConf, err = NewConf(c.Name, c.backends, c.sysVars, rawConf)
+		newConf, err = newConf.withFile(file, rawConf)
 		if err != nil {
-			return fmt.Errorf("could not create new conf: failed on step %v:%v : %v", edit.Type, edit.Name, err)
+			return nil, fmt.Errorf("could not create new conf: failed on step %v:%v : %v", edit.Type, edit.Name, err)
 		}
 	}
-	if err := c.SaveConf(newConf); err != nil {
-		return fmt.Errorf("couldn't save config file: %v", err)
+	return newConf, nil
+}
+
+// Location stores the file, and the start byte position and end byte position in it of
+// an object in the raw configuration
+type Location struct {
+	File       string
+	Start, End int
+}
+
+// ChangedAlerts returns the names of the alerts that are added, removed or
+// changed by saving rawConf as file, which is the first file if file is
+// empty, and whether anything other than alerts is changed.
+func (c *Conf) ChangedAlerts(file, rawConf string) (alerts []string, other bool, err error) {
+	name, err := c.fileName(file)
+	if err != nil {
+		return nil, false, err
 	}
-	err = c.reload()
+	newConf, err := c.withFile(name, rawConf)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
-	return nil
+	alerts, other = c.changes(newConf)
+	return alerts, other, nil
 }
 
-// Location stores the start byte position and end byte position of
-// an object in the raw configuration
-type Location []int
+// changes returns the names of the alerts that are added, removed or
+// changed in newConf, and whether anything other than alerts is changed in
+// any file.
+func (c *Conf) changes(newConf *Conf) (alerts []string, other bool) {
+	for n, a := range c.Alerts {
+		if na, ok := newConf.Alerts[n]; !ok || na.Text != a.Text {
+			alerts = append(alerts, n)
+		}
+	}
+	for n := range newConf.Alerts {
+		if _, ok := c.Alerts[n]; !ok {
+			alerts = append(alerts, n)
+		}
+	}
+	sort.Strings(alerts)
+	for _, f := range c.Files {
+		if newConf.fileNamed(f.Name) == nil || c.withoutAlerts(f.Name) != newConf.withoutAlerts(f.Name) {
+			other = true
+		}
+	}
+	return alerts, other
+}
+
+// withoutAlerts returns the text of the file name with its alert sections
+// removed and whitespace collapsed.
+func (c *Conf) withoutAlerts(name string) string {
+	var locs []Location
+	for _, a := range c.Alerts {
+		if l, ok := a.Locator.(Location); ok && l.File == name {
+			locs = append(locs, l)
+		}
+	}
+	sort.Slice(locs, func(i, j int) bool { return locs[i].Start > locs[j].Start })
+	text := c.fileNamed(name).RawText
+	for _, l := range locs {
+		text = removeSection(l, text)
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
 
 func writeSection(l Location, orginalRaw, newText string) string {
 	var newRawConf bytes.Buffer
-	if l == nil {
-		newRawConf.WriteString(orginalRaw)
-		newRawConf.WriteString("\n")
-		newRawConf.WriteString(newText)
-		newRawConf.WriteString("\n")
-		return newRawConf.String()
-	}
 	newRawConf.WriteString(orginalRaw[:getLocationStart(l)])
 	newRawConf.WriteString(newText)
 	newRawConf.WriteString(orginalRaw[getLocationEnd(l):])
@@ -142,31 +279,35 @@ func removeSection(l Location, orginalRaw string) string {
 	return newRawConf.String()
 }
 
-func newSectionLocator(s *parse.SectionNode) Location {
+func (c *Conf) newSectionLocator(s *parse.SectionNode) Location {
 	start := int(s.Position())
 	end := int(s.Position()) + len(s.RawText)
-	return Location{start, end}
+	return Location{c.fileOf(s).Name, start, end}
 }
 
 func getLocationStart(l Location) int {
-	return l[0]
+	return l.Start
 }
 
 func getLocationEnd(l Location) int {
-	return l[1]
+	return l.End
 }
 
-// RawDiff returns a contextual diff of the running rule configuration
-// against the provided configuration. This contextual diff library
+// RawDiff returns a contextual diff of a file of the running rule configuration,
+// which is the first file if file is empty, against the provided text of it. This contextual diff library
 // does not guarantee that the generated unified diff can be applied
 // so this is only used for human consumption and verifying that the diff
 // has not change since an edit request was issued
-func (c *Conf) RawDiff(rawConf string) (string, error) {
+func (c *Conf) RawDiff(file, rawConf string) (string, error) {
+	name, err := c.fileName(file)
+	if err != nil {
+		return "", err
+	}
 	diff := difflib.UnifiedDiff{
-		A:        difflib.SplitLines(c.RawText),
+		A:        difflib.SplitLines(c.fileNamed(name).RawText),
 		B:        difflib.SplitLines(rawConf),
-		FromFile: c.Name,
-		ToFile:   c.Name,
+		FromFile: name,
+		ToFile:   name,
 		Context:  3,
 	}
 	return difflib.GetUnifiedDiffString(diff)
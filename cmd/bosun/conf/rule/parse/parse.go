@@ -79,16 +79,7 @@ func New(name string) *Tree {
 
 // ErrorContext returns a textual representation of the location of the node in the input text.
 func (t *Tree) ErrorContext(n Node) (location, context string) {
-	pos := int(n.Position())
-	text := t.text[:pos]
-	byteNum := strings.LastIndex(text, "\n")
-	if byteNum == -1 {
-		byteNum = pos // On first line.
-	} else {
-		byteNum++ // After the newline.
-		byteNum = pos - byteNum
-	}
-	lineNum := 1 + strings.Count(text, "\n")
+	lineNum, byteNum := t.LineColumn(n)
 	context = n.String()
 	context = strings.TrimSpace(context)
 	context = strings.Replace(context, "\n", "\\n", -1)
@@ -98,11 +89,40 @@ func (t *Tree) ErrorContext(n Node) (location, context string) {
 	return fmt.Sprintf("%s:%d:%d", t.Name, lineNum, byteNum), context
 }
 
+// LineColumn returns the line number, starting at 1, and the byte offset
+// within that line, starting at 0, of the node in the input text.
+func (t *Tree) LineColumn(n Node) (line, column int) {
+	pos := int(n.Position())
+	text := t.text[:pos]
+	column = strings.LastIndex(text, "\n")
+	if column == -1 {
+		column = pos // On first line.
+	} else {
+		column++ // After the newline.
+		column = pos - column
+	}
+	return 1 + strings.Count(text, "\n"), column
+}
+
+// Error is a syntax error in a configuration.
+type Error struct {
+	Name string // name of the tree that failed to parse
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("parse: %s:%d: %s", e.Name, e.Line, e.Msg)
+}
+
 // errorf formats the error and terminates processing.
 func (t *Tree) errorf(format string, args ...interface{}) {
 	t.Root = nil
-	format = fmt.Sprintf("parse: %s:%d: %s", t.Name, t.lex.lineNumber(), format)
-	panic(fmt.Errorf(format, args...))
+	panic(&Error{
+		Name: t.Name,
+		Line: t.lex.lineNumber(),
+		Msg:  fmt.Sprintf(format, args...),
+	})
 }
 
 // error terminates processing.
@@ -9,6 +9,7 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -30,15 +31,22 @@ import (
 
 type Conf struct {
 	Vars conf.Vars
-	Name string // Config file name
+	Name string // Config file, directory or glob name
 
 	UnknownTemplate *conf.Template
 	Templates       map[string]*conf.Template
 	Alerts          map[string]*conf.Alert
 	Notifications   map[string]*conf.Notification `json:"-"`
-	RawText         string
+	Escalations     map[string]*conf.Escalation   `json:"-"`
+	RawText         string                        // text of the first file
+	Files           []*File                       `json:"-"`
 	Macros          map[string]*conf.Macro
 	Lookups         map[string]*conf.Lookup
+	Holidays        map[string]*conf.Holidays
+	Schedules       map[string]*conf.Schedule
+	Dashboards      map[string]*conf.Dashboard
+	Reports         map[string]*conf.Report
+	SLOs            map[string]*conf.SLO
 	Squelch         conf.Squelches `json:"-"`
 	NoSleep         bool
 
@@ -47,9 +55,13 @@ type Conf struct {
 
 	sysVars map[string]string
 
-	tree            *parse.Tree
+	roots           []string          // files to load, in order
+	override        map[string]string // text of files to use instead of reading them
+	file            *File             // file being loaded
+	nodeFiles       map[parse.Node]*File
 	node            parse.Node
 	unknownTemplate string
+	unknownTmplNode parse.Node
 	bodies          *htemplate.Template
 	subjects        *ttemplate.Template
 	squelch         []string
@@ -59,6 +71,9 @@ type Conf struct {
 	deferredSections map[string][]deferredSection // SectionType:[]deferredSection
 	saveHook         conf.SaveHook                // func that gets called on save if not nil
 	Hash             string
+
+	linting     bool // record errors as diagnostics and keep loading
+	diagnostics []Diagnostic
 }
 
 type deferredSection struct {
@@ -82,15 +97,18 @@ func (c *Conf) at(node parse.Node) {
 }
 
 func (c *Conf) error(err error) {
-	c.errorf(err.Error())
+	c.errorf("%s", err)
 }
 
 // errorf formats the error and terminates processing.
 func (c *Conf) errorf(format string, args ...interface{}) {
+	if c.linting {
+		panic(&lintError{node: c.node, msg: fmt.Sprintf(format, args...)})
+	}
 	if c.node == nil {
 		format = fmt.Sprintf("conf: %s: %s", c.Name, format)
 	} else {
-		location, context := c.tree.ErrorContext(c.node)
+		location, context := c.fileOf(c.node).tree.ErrorContext(c.node)
 		format = fmt.Sprintf("conf: %s: at <%s>: %s", location, context, format)
 	}
 	panic(fmt.Errorf(format, args...))
@@ -127,76 +145,106 @@ func (c *Conf) parseNotifications(v string) (map[string]*conf.Notification, erro
 	return ns, nil
 }
 
+// ParseFile loads the rule configuration at fname, which is a file,
+// directory or glob as described by RuleFiles.
 func ParseFile(fname string, backends conf.EnabledBackends, sysVars map[string]string) (*Conf, error) {
-	f, err := ioutil.ReadFile(fname)
-	if err != nil {
-		return nil, err
-	}
-	return NewConf(fname, backends, sysVars, string(f))
+	return ParseFiles(fname, backends, sysVars, nil)
 }
 
+// SaveConf writes the files of newConf whose text differs from that of the
+// same file of c.
 func (c *Conf) SaveConf(newConf *Conf) error {
-	return ioutil.WriteFile(c.Name, []byte(newConf.RawText), os.FileMode(int(0640)))
+	for _, f := range newConf.Files {
+		old := c.fileNamed(f.Name)
+		if old == nil || old.RawText == f.RawText {
+			continue
+		}
+		if err := ioutil.WriteFile(f.Name, []byte(f.RawText), os.FileMode(int(0640))); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// NewConf loads the rule configuration in text, named name. Files it
+// includes are read relative to the directory of name.
 func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]string, text string) (c *Conf, err error) {
-	defer errRecover(&err)
-	c = &Conf{
+	return loadConf(name, []string{name}, backends, sysVars, map[string]string{name: text})
+}
+
+func newConf(name string, backends conf.EnabledBackends, sysVars map[string]string, text string) *Conf {
+	return &Conf{
 		Name:             name,
 		Vars:             make(map[string]string),
 		Templates:        make(map[string]*conf.Template),
 		Alerts:           make(map[string]*conf.Alert),
 		Notifications:    make(map[string]*conf.Notification),
+		Escalations:      make(map[string]*conf.Escalation),
 		RawText:          text,
 		bodies:           htemplate.New(name).Funcs(htemplate.FuncMap(defaultFuncs)),
 		subjects:         ttemplate.New(name).Funcs(defaultFuncs),
 		Lookups:          make(map[string]*conf.Lookup),
+		Holidays:         make(map[string]*conf.Holidays),
+		Schedules:        make(map[string]*conf.Schedule),
+		Dashboards:       make(map[string]*conf.Dashboard),
+		Reports:          make(map[string]*conf.Report),
+		SLOs:             make(map[string]*conf.SLO),
 		Macros:           make(map[string]*conf.Macro),
 		writeLock:        make(chan bool, 1),
 		deferredSections: make(map[string][]deferredSection),
+		nodeFiles:        make(map[parse.Node]*File),
 		backends:         backends,
 		sysVars:          sysVars,
 	}
-	c.tree, err = parse.Parse(name, text)
-	if err != nil {
-		c.error(err)
-	}
+}
+
+// load reads and loads the root files and the files they include.
+func (c *Conf) load() {
 	saw := make(map[string]bool)
-	for _, n := range c.tree.Root.Nodes {
-		c.at(n)
-		switch n := n.(type) {
-		case *parse.PairNode:
-			c.seen(n.Key.Text, saw)
-			c.loadGlobal(n)
-		case *parse.SectionNode:
-			c.loadSection(n)
-		default:
-			c.errorf("unexpected parse node %s", n)
-		}
+	for _, name := range c.roots {
+		c.try(func() {
+			c.at(nil)
+			c.loadFile(c.addFile(name), saw)
+		})
 	}
+	c.file = nil
 
 	loadSections := func(sectionType string) {
 		for _, dSec := range c.deferredSections[sectionType] {
-			c.at(dSec.SectionNode)
-			dSec.LoadFunc(dSec.SectionNode)
+			ok := c.try(func() {
+				c.at(dSec.SectionNode)
+				dSec.LoadFunc(dSec.SectionNode)
+			})
+			if !ok {
+				c.placeholder(dSec.SectionNode)
+			}
 		}
 	}
 
 	loadSections("template")
 	if c.unknownTemplate != "" {
-		t, ok := c.Templates[c.unknownTemplate]
-		if !ok {
-			c.errorf("template not found: %s", c.unknownTemplate)
-		}
-		c.UnknownTemplate = t
+		c.try(func() {
+			c.at(c.unknownTmplNode)
+			t, ok := c.Templates[c.unknownTemplate]
+			if !ok {
+				c.errorf("template not found: %s", c.unknownTemplate)
+			}
+			c.UnknownTemplate = t
+		})
 	}
 	loadSections("notification")
+	loadSections("escalation")
 	loadSections("macro")
 	loadSections("lookup")
+	loadSections("holidays")
+	loadSections("schedule")
+	loadSections("slo")
 	loadSections("alert")
+	loadSections("heartbeat")
+	loadSections("dashboard")
+	loadSections("report")
 
 	c.genHash()
-	return
 }
 
 func (c *Conf) loadGlobal(p *parse.PairNode) {
@@ -204,6 +252,7 @@ func (c *Conf) loadGlobal(p *parse.PairNode) {
 	switch k := p.Key.Text; k {
 	case "unknownTemplate":
 		c.unknownTemplate = v
+		c.unknownTmplNode = p
 	case "squelch":
 		c.squelch = append(c.squelch, v)
 		if err := c.Squelch.Add(v); err != nil {
@@ -225,12 +274,26 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		ds.LoadFunc = c.loadTemplate
 	case "alert":
 		ds.LoadFunc = c.loadAlert
+	case "heartbeat":
+		ds.LoadFunc = c.loadHeartbeat
 	case "notification":
 		ds.LoadFunc = c.loadNotification
+	case "escalation":
+		ds.LoadFunc = c.loadEscalation
 	case "macro":
 		ds.LoadFunc = c.loadMacro
 	case "lookup":
 		ds.LoadFunc = c.loadLookup
+	case "holidays":
+		ds.LoadFunc = c.loadHolidays
+	case "schedule":
+		ds.LoadFunc = c.loadSchedule
+	case "dashboard":
+		ds.LoadFunc = c.loadDashboard
+	case "report":
+		ds.LoadFunc = c.loadReport
+	case "slo":
+		ds.LoadFunc = c.loadSLO
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
@@ -256,6 +319,9 @@ func (c *Conf) getPairs(s *parse.SectionNode, vars conf.Vars, st sectionType) (p
 	ignoreBadExpand := st == sMacro
 	add := func(n parse.Node, k, v string) {
 		c.seen(k, saw)
+		if _, ok := c.Vars[k]; ok && vars != nil && strings.HasPrefix(k, "$") {
+			c.warnf(n, "shadowed-variable", "%s shadows the global variable of the same name", k)
+		}
 		if vars != nil && strings.HasPrefix(k, "$") {
 			vars[k] = v
 			if st != sMacro {
@@ -302,7 +368,7 @@ func (c *Conf) loadLookup(s *parse.SectionNode) {
 		Name: name,
 	}
 	l.Text = s.RawText
-	l.Locator = newSectionLocator(s)
+	l.Locator = c.newSectionLocator(s)
 	var lookupTags opentsdb.TagSet
 	saw := make(map[string]bool)
 	for _, n := range s.Nodes.Nodes {
@@ -361,6 +427,256 @@ func (c *Conf) loadLookup(s *parse.SectionNode) {
 	c.Lookups[name] = &l
 }
 
+func (c *Conf) loadHolidays(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Holidays[name]; ok {
+		c.errorf("duplicate holidays name: %s", name)
+	}
+	h := conf.Holidays{
+		Name:     name,
+		Location: time.UTC,
+		Dates:    make(map[string]string),
+	}
+	h.Text = s.RawText
+	h.Locator = c.newSectionLocator(s)
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		switch n := n.(type) {
+		case *parse.PairNode:
+			c.seen(n.Key.Text, saw)
+			v := c.Expand(n.Val.Text, nil, false)
+			switch k := n.Key.Text; k {
+			case "timezone":
+				loc, err := expr.LoadLocation(v)
+				if err != nil {
+					c.error(err)
+				}
+				h.Location = loc
+			default:
+				if _, err := time.Parse(conf.HolidayDateFormat, k); err != nil {
+					c.errorf("holiday keys must be dates in the form YYYY-MM-DD, got %s", k)
+				}
+				h.Dates[k] = v
+			}
+		default:
+			c.errorf("unexpected node")
+		}
+	}
+	c.at(s)
+	c.Holidays[name] = &h
+}
+
+func (c *Conf) loadSchedule(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Schedules[name]; ok {
+		c.errorf("duplicate schedule name: %s", name)
+	}
+	sc := conf.Schedule{
+		Name:     name,
+		Location: time.UTC,
+	}
+	sc.Text = s.RawText
+	sc.Locator = c.newSectionLocator(s)
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		switch n := n.(type) {
+		case *parse.PairNode:
+			c.seen(n.Key.Text, saw)
+			v := c.Expand(n.Val.Text, nil, false)
+			switch k := n.Key.Text; k {
+			case "timezone":
+				loc, err := expr.LoadLocation(v)
+				if err != nil {
+					c.error(err)
+				}
+				sc.Location = loc
+			case "holidays":
+				h, ok := c.Holidays[v]
+				if !ok {
+					c.errorf("holidays not found: %s", v)
+				}
+				sc.Holidays = h
+			default:
+				c.errorf("unknown key %s", k)
+			}
+		case *parse.SectionNode:
+			if n.SectionType.Text != "entry" {
+				c.errorf("unexpected subsection type")
+			}
+			if saw["entry "+n.Name.Text] {
+				c.errorf("duplicate entry")
+			}
+			saw["entry "+n.Name.Text] = true
+			e := conf.ScheduleEntry{
+				Name:   n.Name.Text,
+				Values: make(map[string]string),
+			}
+			for _, en := range n.Nodes.Nodes {
+				c.at(en)
+				switch en := en.(type) {
+				case *parse.PairNode:
+					v := c.Expand(en.Val.Text, nil, false)
+					switch en.Key.Text {
+					case "window":
+						w, err := expr.ParseTimeWindow(v)
+						if err != nil {
+							c.error(err)
+						}
+						e.Window = w
+					case "holiday":
+						b, err := strconv.ParseBool(v)
+						if err != nil {
+							c.errorf("holiday must be true or false")
+						}
+						e.Holiday = &b
+					default:
+						e.Values[en.Key.Text] = v
+					}
+				default:
+					c.errorf("unexpected node")
+				}
+			}
+			sc.Entries = append(sc.Entries, &e)
+		default:
+			c.errorf("unexpected node")
+		}
+	}
+	c.at(s)
+	for _, e := range sc.Entries {
+		if e.Holiday != nil && sc.Holidays == nil {
+			c.errorf("entry %s uses holiday but the schedule has no holidays", e.Name)
+		}
+	}
+	c.Schedules[name] = &sc
+}
+
+func (c *Conf) loadDashboard(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Dashboards[name]; ok {
+		c.errorf("duplicate dashboard name: %s", name)
+	}
+	d := conf.Dashboard{
+		Dashboard: models.Dashboard{
+			Name:   name,
+			Source: models.DashboardConfig,
+		},
+	}
+	d.Text = s.RawText
+	d.Locator = c.newSectionLocator(s)
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		switch n := n.(type) {
+		case *parse.PairNode:
+			c.seen(n.Key.Text, saw)
+			v := c.Expand(n.Val.Text, nil, false)
+			switch k := n.Key.Text; k {
+			case "title":
+				d.Title = v
+			default:
+				c.errorf("unknown key %s", k)
+			}
+		case *parse.SectionNode:
+			switch n.SectionType.Text {
+			case "variable":
+				d.Variables = append(d.Variables, c.loadDashboardVariable(n))
+			case "panel":
+				d.Panels = append(d.Panels, c.loadDashboardPanel(n))
+			default:
+				c.errorf("unexpected subsection type")
+			}
+		default:
+			c.errorf("unexpected node")
+		}
+	}
+	c.at(s)
+	if err := d.Validate(); err != nil {
+		c.error(err)
+	}
+	vars := d.Vars(nil)
+	for _, p := range d.Panels {
+		e, err := expr.New(c.Expand(p.Expr, vars, false), c.GetFuncs(c.backends))
+		if err != nil {
+			c.errorf("panel %s: %v", p.Name, err)
+		}
+		if err := p.CheckReturn(e.Root.Return()); err != nil {
+			c.error(err)
+		}
+	}
+	c.Dashboards[name] = &d
+}
+
+func (c *Conf) loadDashboardVariable(s *parse.SectionNode) *models.DashboardVariable {
+	v := &models.DashboardVariable{
+		Name:   s.Name.Text,
+		TagKey: s.Name.Text,
+	}
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		p, ok := n.(*parse.PairNode)
+		if !ok {
+			c.errorf("unexpected node")
+		}
+		c.seen(p.Key.Text, saw)
+		val := c.Expand(p.Val.Text, nil, false)
+		switch k := p.Key.Text; k {
+		case "tagKey":
+			v.TagKey = val
+		case "metric":
+			v.Metric = val
+		case "default":
+			v.Default = val
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	return v
+}
+
+// loadDashboardPanel loads a panel subsection. The expression is kept as
+// written: the variables of the dashboard in it are expanded when the panel
+// is rendered.
+func (c *Conf) loadDashboardPanel(s *parse.SectionNode) *models.DashboardPanel {
+	p := &models.DashboardPanel{
+		Name: s.Name.Text,
+		Type: "graph",
+	}
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		pn, ok := n.(*parse.PairNode)
+		if !ok {
+			c.errorf("unexpected node")
+		}
+		c.seen(pn.Key.Text, saw)
+		if pn.Key.Text == "expr" {
+			p.Expr = pn.Val.Text
+			continue
+		}
+		v := c.Expand(pn.Val.Text, nil, false)
+		switch k := pn.Key.Text; k {
+		case "title":
+			p.Title = v
+		case "type":
+			p.Type = v
+		case "unit":
+			p.Unit = v
+		case "width":
+			w, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			p.Width = w
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	return p
+}
+
 func (c *Conf) loadMacro(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Macros[name]; ok {
@@ -370,7 +686,7 @@ func (c *Conf) loadMacro(s *parse.SectionNode) {
 		Name: name,
 	}
 	m.Text = s.RawText
-	m.Locator = newSectionLocator(s)
+	m.Locator = c.newSectionLocator(s)
 	pairs := c.getPairs(s, nil, sMacro)
 	for _, p := range pairs {
 		if _, ok := m.Pairs.([]nodePair); !ok { //bad
@@ -478,7 +794,7 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 		Name: name,
 	}
 	t.Text = s.RawText
-	t.Locator = newSectionLocator(s)
+	t.Locator = c.newSectionLocator(s)
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
 			return c.Expand(v, t.Vars, false)
@@ -528,6 +844,42 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 
 var lookupNotificationRE = regexp.MustCompile(`^lookup\("(.*)", "(.*)"\)$`)
 
+// procNotification adds the notifications, or lookup of notifications, in v
+// to ns.
+func (c *Conf) procNotification(v string, ns *conf.Notifications) {
+	if lookup := lookupNotificationRE.FindStringSubmatch(v); lookup != nil {
+		if ns.Lookups == nil {
+			ns.Lookups = make(map[string]*conf.Lookup)
+		}
+		l := c.Lookups[lookup[1]]
+		if l == nil {
+			c.errorf("unknown lookup table %s", lookup[1])
+		}
+		for _, e := range l.Entries {
+			for k, v := range e.Values {
+				if k != lookup[2] {
+					continue
+				}
+				if _, err := c.parseNotifications(v); err != nil {
+					c.errorf("lookup %s: %v", v, err)
+				}
+			}
+		}
+		ns.Lookups[lookup[2]] = l
+		return
+	}
+	n, err := c.parseNotifications(v)
+	if err != nil {
+		c.error(err)
+	}
+	if ns.Notifications == nil {
+		ns.Notifications = make(map[string]*conf.Notification)
+	}
+	for k, v := range n {
+		ns.Notifications[k] = v
+	}
+}
+
 func (c *Conf) loadAlert(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Alerts[name]; ok {
@@ -540,40 +892,7 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 		WarnNotification: new(conf.Notifications),
 	}
 	a.Text = s.RawText
-	a.Locator = newSectionLocator(s)
-	procNotification := func(v string, ns *conf.Notifications) {
-		if lookup := lookupNotificationRE.FindStringSubmatch(v); lookup != nil {
-			if ns.Lookups == nil {
-				ns.Lookups = make(map[string]*conf.Lookup)
-			}
-			l := c.Lookups[lookup[1]]
-			if l == nil {
-				c.errorf("unknown lookup table %s", lookup[1])
-			}
-			for _, e := range l.Entries {
-				for k, v := range e.Values {
-					if k != lookup[2] {
-						continue
-					}
-					if _, err := c.parseNotifications(v); err != nil {
-						c.errorf("lookup %s: %v", v, err)
-					}
-				}
-			}
-			ns.Lookups[lookup[2]] = l
-			return
-		}
-		n, err := c.parseNotifications(v)
-		if err != nil {
-			c.error(err)
-		}
-		if ns.Notifications == nil {
-			ns.Notifications = make(map[string]*conf.Notification)
-		}
-		for k, v := range n {
-			ns.Notifications[k] = v
-		}
-	}
+	a.Locator = c.newSectionLocator(s)
 	pairs := c.getPairs(s, a.Vars, sNormal)
 	for _, p := range pairs {
 		c.at(p.node)
@@ -598,9 +917,15 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.error(err)
 			}
 		case "critNotification":
-			procNotification(v, a.CritNotification)
+			c.procNotification(v, a.CritNotification)
 		case "warnNotification":
-			procNotification(v, a.WarnNotification)
+			c.procNotification(v, a.WarnNotification)
+		case "escalation":
+			e, ok := c.Escalations[v]
+			if !ok {
+				c.errorf("escalation not found: %s", v)
+			}
+			a.Escalation = e
 		case "unknown":
 			od, err := opentsdb.ParseDuration(v)
 			if err != nil {
@@ -629,6 +954,8 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			a.UnknownsNormal = true
 		case "log":
 			a.Log = true
+		case "noMetrics":
+			a.NoMetrics = true
 		case "runEvery":
 			var err error
 			a.RunEvery, err = strconv.Atoi(v)
@@ -696,14 +1023,316 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 		if warnLength+critLength == 0 {
 			c.errorf("log specified but no notification")
 		}
+		if a.Escalation != nil {
+			c.errorf("cannot use log with an escalation")
+		}
 	}
-	if warnLength+critLength > 0 && a.Template == nil {
+	if (warnLength+critLength > 0 || a.Escalation != nil) && a.Template == nil {
 		c.errorf("notifications specified but no template")
 	}
 	a.ReturnType = ret
 	c.Alerts[name] = &a
 }
 
+// loadHeartbeat loads a heartbeat section as an alert that is critical for
+// each group of tags whose metric has not been seen for longer than the
+// threshold.
+func (c *Conf) loadHeartbeat(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Alerts[name]; ok {
+		c.errorf("duplicate alert name: %s", name)
+	}
+	a := conf.Alert{
+		Vars:             make(map[string]string),
+		Name:             name,
+		CritNotification: new(conf.Notifications),
+		WarnNotification: new(conf.Notifications),
+		IgnoreUnknown:    true,
+	}
+	a.Text = s.RawText
+	a.Locator = c.newSectionLocator(s)
+	hb := &conf.Heartbeat{Tags: opentsdb.TagSet{"host": "*"}}
+	var forget string
+	pairs := c.getPairs(s, a.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "metric":
+			hb.Metric = v
+		case "tags":
+			ts, err := opentsdb.ParseTags(v)
+			if ts == nil {
+				c.error(err)
+			}
+			hb.Tags = ts
+		case "threshold", "forget":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Second {
+				c.errorf("%s must be at least 1s", p.key)
+			}
+			if p.key == "threshold" {
+				hb.Threshold = d
+			} else {
+				hb.Forget = d
+				forget = v
+			}
+		case "template":
+			a.TemplateName = v
+			t, ok := c.Templates[a.TemplateName]
+			if !ok {
+				c.errorf("template not found %s", a.TemplateName)
+			}
+			a.Template = t
+		case "critNotification":
+			c.procNotification(v, a.CritNotification)
+		case "escalation":
+			e, ok := c.Escalations[v]
+			if !ok {
+				c.errorf("escalation not found: %s", v)
+			}
+			a.Escalation = e
+		case "squelch":
+			a.RawSquelch = append(a.RawSquelch, v)
+			if err := a.Squelch.Add(v); err != nil {
+				c.error(err)
+			}
+		case "noMetrics":
+			a.NoMetrics = true
+		case "runEvery":
+			var err error
+			a.RunEvery, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if hb.Metric == "" {
+		c.errorf("no metric specified")
+	}
+	if hb.Threshold == 0 {
+		c.errorf("no threshold specified")
+	}
+	if hb.Forget != 0 && hb.Forget <= hb.Threshold {
+		c.errorf("forget must be longer than threshold")
+	}
+	a.Crit = c.NewExpr(fmt.Sprintf(`lastSeen("%s", "%s", "%s") > %d`, hb.Metric, hb.Tags.Tags(), forget, int64(hb.Threshold.Seconds())))
+	critLength := len(a.CritNotification.Notifications) + len(a.CritNotification.Lookups)
+	if (critLength > 0 || a.Escalation != nil) && a.Template == nil {
+		c.errorf("notifications specified but no template")
+	}
+	a.Heartbeat = hb
+	a.ReturnType = a.Crit.Root.Return()
+	c.Alerts[name] = &a
+}
+
+// loadSLO loads an slo section, and the alert of the same name that checks
+// the burn rates of its error budget.
+func (c *Conf) loadSLO(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Alerts[name]; ok {
+		c.errorf("duplicate alert name: %s", name)
+	}
+	a := conf.Alert{
+		Vars:             make(map[string]string),
+		Name:             name,
+		CritNotification: new(conf.Notifications),
+		WarnNotification: new(conf.Notifications),
+	}
+	a.Text = s.RawText
+	a.Locator = c.newSectionLocator(s)
+	slo := &conf.SLO{
+		Text:     s.RawText,
+		Name:     name,
+		Window:   "30d",
+		CritBurn: conf.DefaultCritBurn,
+		WarnBurn: conf.DefaultWarnBurn,
+		Locator:  a.Locator,
+	}
+	pairs := c.getPairs(s, a.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "good":
+			slo.Good = v
+		case "total":
+			slo.Total = v
+		case "target":
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				c.error(err)
+			}
+			if f <= 0 || f >= 100 {
+				c.errorf("target must be a percentage between 0 and 100")
+			}
+			slo.Target = f
+		case "window":
+			if _, err := opentsdb.ParseDuration(v); err != nil {
+				c.error(err)
+			}
+			slo.Window = v
+		case "critBurn", "warnBurn":
+			rates, err := conf.ParseBurnRates(v)
+			if err != nil {
+				c.error(err)
+			}
+			if p.key == "critBurn" {
+				slo.CritBurn = rates
+			} else {
+				slo.WarnBurn = rates
+			}
+		case "template":
+			a.TemplateName = v
+			t, ok := c.Templates[a.TemplateName]
+			if !ok {
+				c.errorf("template not found %s", a.TemplateName)
+			}
+			a.Template = t
+		case "critNotification":
+			c.procNotification(v, a.CritNotification)
+		case "warnNotification":
+			c.procNotification(v, a.WarnNotification)
+		case "escalation":
+			e, ok := c.Escalations[v]
+			if !ok {
+				c.errorf("escalation not found: %s", v)
+			}
+			a.Escalation = e
+		case "squelch":
+			a.RawSquelch = append(a.RawSquelch, v)
+			if err := a.Squelch.Add(v); err != nil {
+				c.error(err)
+			}
+		case "noMetrics":
+			a.NoMetrics = true
+		case "runEvery":
+			var err error
+			a.RunEvery, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if slo.Good == "" || slo.Total == "" {
+		c.errorf("good and total queries must be specified")
+	}
+	if slo.Target == 0 {
+		c.errorf("no target specified")
+	}
+	if len(slo.CritBurn)+len(slo.WarnBurn) == 0 {
+		c.errorf("critBurn and warnBurn cannot both be none")
+	}
+	if len(slo.CritBurn) > 0 {
+		a.Crit = c.NewExpr(slo.AlertExpr(slo.CritBurn))
+	}
+	if len(slo.WarnBurn) > 0 {
+		a.Warn = c.NewExpr(slo.AlertExpr(slo.WarnBurn))
+	}
+	compliance := c.NewExpr(slo.ComplianceExpr())
+	if a.Crit != nil && a.Warn != nil {
+		ctags, _ := a.Crit.Root.Tags()
+		wtags, _ := a.Warn.Root.Tags()
+		if !ctags.Equal(wtags) {
+			c.errorf("crit tags (%v) and warn tags (%v) must be equal", ctags, wtags)
+		}
+	}
+	for k, v := range map[string]string{
+		"compliance": compliance.String(),
+		"budget":     slo.BudgetExpr(),
+	} {
+		a.Vars[k] = v
+		a.Vars["$"+k] = v
+	}
+	warnLength := len(a.WarnNotification.Notifications) + len(a.WarnNotification.Lookups)
+	critLength := len(a.CritNotification.Notifications) + len(a.CritNotification.Lookups)
+	if (warnLength+critLength > 0 || a.Escalation != nil) && a.Template == nil {
+		c.errorf("notifications specified but no template")
+	}
+	a.SLO = slo
+	if a.Crit != nil {
+		a.ReturnType = a.Crit.Root.Return()
+	} else {
+		a.ReturnType = a.Warn.Root.Return()
+	}
+	c.SLOs[name] = slo
+	c.Alerts[name] = &a
+}
+
+// loadReport loads a report section, whose template is sent to its
+// notifications on a cron schedule.
+func (c *Conf) loadReport(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Reports[name]; ok {
+		c.errorf("duplicate report name: %s", name)
+	}
+	r := conf.Report{
+		Vars:          make(map[string]string),
+		Name:          name,
+		Location:      time.UTC,
+		Notifications: new(conf.Notifications),
+	}
+	r.Text = s.RawText
+	r.Locator = c.newSectionLocator(s)
+	pairs := c.getPairs(s, r.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "cron":
+			cron, err := conf.ParseCron(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Cron = cron
+		case "timezone":
+			loc, err := expr.LoadLocation(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Location = loc
+		case "template":
+			r.TemplateName = v
+			t, ok := c.Templates[r.TemplateName]
+			if !ok {
+				c.errorf("template not found %s", r.TemplateName)
+			}
+			r.Template = t
+		case "notification":
+			c.procNotification(v, r.Notifications)
+			if len(r.Notifications.Lookups) > 0 {
+				c.errorf("reports cannot use notification lookups")
+			}
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if r.Cron == nil {
+		c.errorf("no cron specified")
+	}
+	if r.Template == nil {
+		c.errorf("no template specified")
+	}
+	if r.Template.Body == nil {
+		c.errorf("template %s has no body", r.TemplateName)
+	}
+	if len(r.Notifications.Notifications) == 0 {
+		c.errorf("no notification specified")
+	}
+	c.Reports[name] = &r
+}
+
 func (c *Conf) loadNotification(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Notifications[name]; ok {
@@ -716,7 +1345,7 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		RunOnActions: true,
 	}
 	n.Text = s.RawText
-	n.Locator = newSectionLocator(s)
+	n.Locator = c.newSectionLocator(s)
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
 			return c.Expand(v, n.Vars, false)
@@ -795,6 +1424,64 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 	}
 }
 
+var escalationLevelRE = regexp.MustCompile(`^level([1-9][0-9]*)$`)
+
+func (c *Conf) loadEscalation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Escalations[name]; ok {
+		c.errorf("duplicate escalation name: %s", name)
+	}
+	e := conf.Escalation{
+		Name: name,
+	}
+	e.Text = s.RawText
+	e.Locator = c.newSectionLocator(s)
+	levels := make(map[int][]*conf.Notification)
+	pairs := c.getPairs(s, nil, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch k := p.key; k {
+		case "team":
+			e.Team = v
+		case "timeout":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			e.Timeout = time.Duration(d)
+		default:
+			m := escalationLevelRE.FindStringSubmatch(k)
+			if m == nil {
+				c.errorf("unknown key %s", k)
+			}
+			ns, err := c.parseNotifications(v)
+			if err != nil {
+				c.error(err)
+			}
+			level, _ := strconv.Atoi(m[1])
+			for _, n := range ns {
+				levels[level] = append(levels[level], n)
+			}
+			sort.Slice(levels[level], func(i, j int) bool { return levels[level][i].Name < levels[level][j].Name })
+		}
+	}
+	c.at(s)
+	for i := 1; i <= len(levels); i++ {
+		if levels[i] == nil {
+			c.errorf("escalation levels must be numbered from level1 without gaps, missing level%d", i)
+		}
+		e.Levels = append(e.Levels, levels[i])
+	}
+	if len(e.Levels) == 0 {
+		c.errorf("escalation has no levels")
+	}
+	if len(e.Levels) > 1 && e.Timeout < time.Minute {
+		c.errorf("escalation timeout must be at least 1m")
+	}
+	c.Escalations[name] = &e
+}
+
 var exRE = regexp.MustCompile(`\$(?:[\w.]+|\{[\w.]+\})`)
 
 func (c *Conf) Expand(v string, vars map[string]string, ignoreBadExpand bool) string {
@@ -996,6 +1683,61 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 		return t, nil
 	}
 
+	isHoliday := func(e *expr.State, T miniprofiler.Timer, name string) (*expr.Results, error) {
+		h := c.Holidays[name]
+		if h == nil {
+			return nil, fmt.Errorf("holidays not found: %v", name)
+		}
+		var v float64
+		if h.Contains(e.Now()) {
+			v = 1
+		}
+		return &expr.Results{
+			Results: expr.ResultSlice{
+				{Value: expr.Scalar(v)},
+			},
+		}, nil
+	}
+	thresholdByTime := func(e *expr.State, T miniprofiler.Timer, schedule, key string) (*expr.Results, error) {
+		sc := c.Schedules[schedule]
+		if sc == nil {
+			return nil, fmt.Errorf("schedule not found: %v", schedule)
+		}
+		value, ok := sc.Get(key, e.Now())
+		if !ok {
+			return nil, fmt.Errorf("schedule %v: no entry with key %v matches %v", schedule, key, e.Now().In(sc.Location))
+		}
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &expr.Results{
+			Results: expr.ResultSlice{
+				{Value: expr.Scalar(num)},
+			},
+		}, nil
+	}
+	holidaysCheck := func(t *eparse.Tree, f *eparse.FuncNode) error {
+		n, ok := f.Args[0].(*eparse.StringNode)
+		if !ok {
+			return nil
+		}
+		if _, ok := c.Holidays[n.Text]; !ok {
+			return fmt.Errorf("holidays not found: %v", n.Text)
+		}
+		return nil
+	}
+	scheduleCheck := func(t *eparse.Tree, f *eparse.FuncNode) error {
+		n, ok := f.Args[0].(*eparse.StringNode)
+		if !ok {
+			return nil
+		}
+		if _, ok := c.Schedules[n.Text]; !ok {
+			return fmt.Errorf("schedule not found: %v", n.Text)
+		}
+		return nil
+	}
+
 	tagAlert := func(args []eparse.Node) (eparse.Tags, error) {
 		name := args[0].(*eparse.StringNode).Text
 		key := args[1].(*eparse.StringNode).Text
@@ -1009,6 +1751,14 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 		return e.Root.Tags()
 	}
 
+	tagSLO := func(args []eparse.Node) (eparse.Tags, error) {
+		e, err := c.getSLOExpr(args[0].(*eparse.StringNode).Text, args[1].(*eparse.StringNode).Text)
+		if err != nil {
+			return nil, err
+		}
+		return e.Root.Tags()
+	}
+
 	funcs := map[string]eparse.Func{
 		"alert": {
 			Args:   []models.FuncType{models.TypeString, models.TypeString},
@@ -1016,12 +1766,34 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 			Tags:   tagAlert,
 			F:      c.alert,
 		},
+		"slo": {
+			Args:   []models.FuncType{models.TypeString, models.TypeString},
+			Return: models.TypeNumberSet,
+			Tags:   tagSLO,
+			F:      c.slo,
+			Doc: `slo(name, value) returns a value of slo name for each of its groups: "compliance", the percentage of good events over its window, ` +
+				`"budget", the percentage of its error budget left, or, given a duration such as "1h", the rate its budget burns at over that duration.`,
+		},
 		"lookup": {
 			Args:   []models.FuncType{models.TypeString, models.TypeString},
 			Return: models.TypeNumberSet,
 			Tags:   lookupTags,
 			F:      lookup,
 		},
+		"isHoliday": {
+			Args:   []models.FuncType{models.TypeString},
+			Return: models.TypeScalar,
+			F:      isHoliday,
+			Check:  holidaysCheck,
+			Doc:    "isHoliday(name) returns 1 if today is in the holidays table name, otherwise 0.",
+		},
+		"thresholdByTime": {
+			Args:   []models.FuncType{models.TypeString, models.TypeString},
+			Return: models.TypeScalar,
+			F:      thresholdByTime,
+			Check:  scheduleCheck,
+			Doc:    "thresholdByTime(schedule, key) returns key from the first entry of schedule that matches now.",
+		},
 		"lookupSeries": {
 			Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString},
 			Return: models.TypeNumberSet,
@@ -1075,6 +1847,29 @@ func (c *Conf) getAlertExpr(name, key string) (*conf.Alert, *expr.Expr, error) {
 	return a, e, nil
 }
 
+// getSLOExpr returns the expression of a value of the slo section name, as
+// accepted by conf.SLO.Expr.
+func (c *Conf) getSLOExpr(name, value string) (*expr.Expr, error) {
+	slo := c.SLOs[name]
+	if slo == nil {
+		return nil, fmt.Errorf("bad slo name %v", name)
+	}
+	text, err := slo.Expr(value)
+	if err != nil {
+		return nil, err
+	}
+	return expr.New(text, c.GetFuncs(c.backends))
+}
+
+func (c *Conf) slo(s *expr.State, T miniprofiler.Timer, name, value string) (*expr.Results, error) {
+	e, err := c.getSLOExpr(name, value)
+	if err != nil {
+		return nil, err
+	}
+	results, _, err := e.ExecuteState(s, T)
+	return results, err
+}
+
 func (c *Conf) alert(s *expr.State, T miniprofiler.Timer, name, key string) (results *expr.Results, err error) {
 	_, e, err := c.getAlertExpr(name, key)
 	if err != nil {
@@ -1157,12 +1952,44 @@ func (c *Conf) GetLookup(s string) *conf.Lookup {
 	return c.Lookups[s]
 }
 
-func (c *Conf) GetSquelches() conf.Squelches {
-	return c.Squelch
+func (c *Conf) GetHolidays(s string) *conf.Holidays {
+	return c.Holidays[s]
 }
 
-func (c *Conf) GetRawText() string {
-	return c.RawText
+func (c *Conf) GetDashboards() map[string]*conf.Dashboard {
+	return c.Dashboards
+}
+
+func (c *Conf) GetDashboard(s string) *conf.Dashboard {
+	return c.Dashboards[s]
+}
+
+func (c *Conf) GetReports() map[string]*conf.Report {
+	return c.Reports
+}
+
+func (c *Conf) GetReport(s string) *conf.Report {
+	return c.Reports[s]
+}
+
+func (c *Conf) GetSLOs() map[string]*conf.SLO {
+	return c.SLOs
+}
+
+func (c *Conf) GetSLO(s string) *conf.SLO {
+	return c.SLOs[s]
+}
+
+func (c *Conf) GetSchedule(s string) *conf.Schedule {
+	return c.Schedules[s]
+}
+
+func (c *Conf) GetEscalation(s string) *conf.Escalation {
+	return c.Escalations[s]
+}
+
+func (c *Conf) GetSquelches() conf.Squelches {
+	return c.Squelch
 }
 
 func (c *Conf) SetReload(reload func() error) {
@@ -1184,10 +2011,6 @@ func (c *Conf) callSaveHook(file, user, message string, args ...string) error {
 	return c.saveHook(file, user, message, args...)
 }
 
-func (c *Conf) genHash() {
-	c.Hash = conf.GenHash(c.RawText)
-}
-
 func (c *Conf) GetHash() string {
 	return c.Hash
 }
@@ -1,12 +1,17 @@
 package rule
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
 )
 
 func TestPrint(t *testing.T) {
@@ -38,6 +43,46 @@ func TestPrint(t *testing.T) {
 		t.Errorf("bad lookup: %v", w)
 	}
 	checkMacroVarAlert(t, c.Alerts["macroVarAlert"])
+	checkSchedule(t, c.Schedules["cpu"])
+	checkEscalation(t, c.Alerts["escalated"].Escalation)
+}
+
+func checkEscalation(t *testing.T, e *conf.Escalation) {
+	if e == nil || e.Name != "ops" || e.Team != "ops" || e.Timeout != 30*time.Minute {
+		t.Fatalf("bad escalation: %+v", e)
+	}
+	var levels [][]string
+	for i := 1; e.Level(i) != nil; i++ {
+		var names []string
+		for _, n := range e.Level(i) {
+			names = append(names, n.Name)
+		}
+		levels = append(levels, names)
+	}
+	if fmt.Sprint(levels) != "[[nc1] [nc2 nc3]]" {
+		t.Errorf("bad escalation levels: %v", levels)
+	}
+}
+
+func checkSchedule(t *testing.T, s *conf.Schedule) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[time.Time]string{
+		time.Date(2016, 7, 4, 12, 0, 0, 0, ny):       "95",
+		time.Date(2016, 7, 5, 12, 0, 0, 0, ny):       "80",
+		time.Date(2016, 7, 5, 12, 0, 0, 0, time.UTC): "90",
+		time.Date(2016, 7, 9, 12, 0, 0, 0, ny):       "90",
+	}
+	for at, expected := range tests {
+		if v, _ := s.Get("high", at); v != expected {
+			t.Errorf("schedule at %v: got %v, expected %v", at, v, expected)
+		}
+	}
+	if _, ok := s.Get("low", time.Now()); ok {
+		t.Errorf("expected no value for unknown key")
+	}
 }
 
 func checkMacroVarAlert(t *testing.T, a *conf.Alert) {
@@ -72,6 +117,10 @@ func TestInvalid(t *testing.T) {
 		"depends-no-overlap": `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
 		"log-no-notification": `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log specified but no notification`,
 		"crit-notification-no-template": `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: notifications specified but no template`,
+		"schedule-holiday-no-holidays":  `conf: schedule-holiday-no-holidays:1:0: at <schedule s {\n	entry...>: entry e uses holiday but the schedule has no holidays`,
+		"schedule-not-found":            `conf: schedule-not-found:2:1: at <crit = thresholdByTi...>: expr: schedule not found: missing`,
+		"escalation-level-gap":          `conf: escalation-level-gap:1:0: at <escalation e {\n	tim...>: escalation levels must be numbered from level1 without gaps, missing level2`,
+		"escalation-not-found":          `conf: escalation-not-found:8:1: at <escalation = missing>: escalation not found: missing`,
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -89,3 +138,276 @@ func TestInvalid(t *testing.T) {
 		}
 	}
 }
+
+func TestChangedAlerts(t *testing.T) {
+	text := `
+$t = 1
+alert db.cpu {
+	crit = $t
+}
+alert web.cpu {
+	crit = 2
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		text   string
+		alerts []string
+		other  bool
+	}{
+		{text, nil, false},
+		{strings.Replace(text, "crit = 2", "crit = 3", 1), []string{"web.cpu"}, false},
+		{text + "alert db.mem {\n\tcrit = 1\n}\n", []string{"db.mem"}, false},
+		{strings.Replace(text, "alert web.cpu {\n\tcrit = 2\n}\n", "", 1), []string{"web.cpu"}, false},
+		{strings.Replace(text, "$t = 1", "$t = 2", 1), nil, true},
+	}
+	for i, test := range tests {
+		alerts, other, err := c.ChangedAlerts("", test.text)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(alerts, test.alerts) || other != test.other {
+			t.Errorf("%d: got %v %v, expected %v %v", i, alerts, other, test.alerts, test.other)
+		}
+	}
+}
+
+func TestBulkEditChanges(t *testing.T) {
+	text := `
+alert db.cpu {
+	crit = 1
+}
+alert web.cpu {
+	crit = 2
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		edit   conf.EditRequest
+		alerts []string
+		other  bool
+	}{
+		{conf.EditRequest{Type: "alert", Name: "web.cpu", Text: "alert web.cpu {\n\tcrit = 3\n}"}, []string{"web.cpu"}, false},
+		// Renaming the alert changes the new name too.
+		{conf.EditRequest{Type: "alert", Name: "web.cpu", Text: "alert db.mem {\n\tcrit = 3\n}"}, []string{"db.mem", "web.cpu"}, false},
+		{conf.EditRequest{Type: "alert", Name: "web.cpu", Text: "alert web.cpu {\n\tcrit = 2\n}\nnotification n {\n\tprint = true\n}"}, nil, true},
+		{conf.EditRequest{Type: "alert", Name: "web.cpu", Delete: true}, []string{"web.cpu"}, false},
+	}
+	for i, test := range tests {
+		alerts, other, err := c.BulkEditChanges(conf.BulkEditRequest{test.edit})
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(alerts, test.alerts) || other != test.other {
+			t.Errorf("%d: got %v %v, expected %v %v", i, alerts, other, test.alerts, test.other)
+		}
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	text := `
+heartbeat os.heartbeat {
+	metric = os.cpu
+	tags = host=ny-*
+	threshold = 10m
+	forget = 30d
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := c.Alerts["os.heartbeat"]
+	if a == nil || a.Heartbeat == nil {
+		t.Fatal("heartbeat not loaded as an alert")
+	}
+	if want := `lastSeen("os.cpu", "host=ny-*", "30d") > 600`; a.Crit.String() != want {
+		t.Errorf("got crit %s, expected %s", a.Crit, want)
+	}
+	if !a.IgnoreUnknown {
+		t.Error("expected heartbeat to ignore unknowns")
+	}
+	for _, invalid := range []string{
+		"heartbeat a {\n\tthreshold = 10m\n}",
+		"heartbeat a {\n\tmetric = os.cpu\n}",
+		"heartbeat a {\n\tmetric = os.cpu\n\tthreshold = 1h\n\tforget = 10m\n}",
+		"heartbeat a {\n\tmetric = os.cpu\n\tthreshold = 1h\n\tcrit = 1\n}",
+	} {
+		if _, err := NewConf("test", conf.EnabledBackends{}, nil, invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	base := `
+template capacity {
+	subject = Capacity report
+	body = {{.Eval .Alert.Vars.disk}}
+}
+notification ops {
+	print = true
+}
+`
+	text := base + `
+report capacity {
+	$disk = 42
+	cron = 0 9 * * mon
+	timezone = America/New_York
+	template = capacity
+	notification = ops
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := c.Reports["capacity"]
+	if r == nil {
+		t.Fatal("report not loaded")
+	}
+	if r.Cron.Text != "0 9 * * mon" || r.Location.String() != "America/New_York" || r.Template != c.Templates["capacity"] || r.Vars["disk"] != "42" {
+		t.Errorf("bad report: %+v", r)
+	}
+	if r.Notifications.Notifications["ops"] == nil {
+		t.Errorf("missing notification: %+v", r.Notifications)
+	}
+	for _, invalid := range []string{
+		"report r {\n\ttemplate = capacity\n\tnotification = ops\n}",
+		"report r {\n\tcron = 0 25 * * *\n\ttemplate = capacity\n\tnotification = ops\n}",
+		"report r {\n\tcron = @daily\n\tnotification = ops\n}",
+		"report r {\n\tcron = @daily\n\ttemplate = capacity\n}",
+		"report r {\n\tcron = @daily\n\ttemplate = nope\n\tnotification = ops\n}",
+		"report r {\n\tcron = @daily\n\ttemplate = capacity\n\tnotification = ops\n\ttimezone = Nowhere/Nothing\n}",
+	} {
+		if _, err := NewConf("test", conf.EnabledBackends{}, nil, base+invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestSLO(t *testing.T) {
+	base := `
+template t {
+	subject = {{.Alert.Name}}
+}
+notification ops {
+	print = true
+}
+`
+	text := base + `
+slo api {
+	good = sum:rate:http.requests.ok{host=*}
+	total = sum:rate:http.requests{host=*}
+	target = 99.9
+	window = 28d
+	warnBurn = none
+	template = t
+	critNotification = ops
+}
+alert api.budget {
+	crit = slo("api", "budget") < 10
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slo := c.SLOs["api"]
+	if slo == nil || slo.Target != 99.9 || slo.Window != "28d" || slo.WarnBurn != nil || len(slo.CritBurn) != 2 {
+		t.Fatalf("bad slo: %+v", slo)
+	}
+	a := c.Alerts["api"]
+	if a == nil || a.SLO != slo || a.Crit == nil || a.Warn != nil || a.Template != c.Templates["t"] {
+		t.Fatalf("bad slo alert: %+v", a)
+	}
+	if tags, _ := a.Crit.Root.Tags(); tags.String() != "host" {
+		t.Errorf("bad crit tags: %v", tags)
+	}
+	if a.Vars["$budget"] == "" || a.Vars["compliance"] == "" {
+		t.Errorf("missing slo vars: %v", a.Vars)
+	}
+	for _, invalid := range []string{
+		"slo api {\n\ttotal = sum:m\n\ttarget = 99\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 100\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 99\n\twindow = 1x\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 99\n\tcritBurn = 1h:5m\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 99\n\tcritBurn = none\n\twarnBurn = none\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 99\n\tcritNotification = ops\n}",
+		"slo api {\n\tgood = sum:m\n\ttotal = sum:m\n\ttarget = 99\n}\nalert api {\n\tcrit = 1\n}",
+		"alert a {\n\tcrit = slo(\"nope\", \"budget\")\n}",
+	} {
+		if _, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, base+invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestDashboard(t *testing.T) {
+	text := `
+$ds = 5m-avg
+dashboard web {
+	title = Web servers
+	variable host {
+		metric = os.cpu
+		default = ny-web01
+	}
+	variable site {
+		tagKey = dc
+	}
+	panel cpu {
+		expr = q("sum:$ds:rate:os.cpu{host=$host,dc=$site}", "1h", "")
+		unit = %
+		width = 6
+	}
+	panel load {
+		type = table
+		expr = avg(q("avg:os.load{host=${host}}", "1h", ""))
+	}
+}
+`
+	c, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := c.Dashboards["web"]
+	if d == nil {
+		t.Fatal("dashboard not loaded")
+	}
+	if d.Title != "Web servers" || d.Source != models.DashboardConfig || len(d.Variables) != 2 || len(d.Panels) != 2 {
+		t.Fatalf("bad dashboard: %+v", d.Dashboard)
+	}
+	if v := d.Variables[0]; v.TagKey != "host" || v.Metric != "os.cpu" || v.Default != "ny-web01" {
+		t.Errorf("bad host variable: %+v", v)
+	}
+	if v := d.Variables[1]; v.TagKey != "dc" {
+		t.Errorf("bad site variable: %+v", v)
+	}
+	if p := d.Panels[0]; p.Type != "graph" || p.Width != 6 || p.Expr != `q("sum:$ds:rate:os.cpu{host=$host,dc=$site}", "1h", "")` {
+		t.Errorf("bad cpu panel: %+v", p)
+	}
+	want := `q("sum:5m-avg:rate:os.cpu{host=web02,dc=*}", "1h", "")`
+	if got := c.Expand(d.Panels[0].Expr, d.Vars(map[string]string{"host": "web02"}), false); got != want {
+		t.Errorf("got expanded expression %s, expected %s", got, want)
+	}
+	for _, invalid := range []string{
+		"dashboard a {\n\ttitle = a\n}",
+		"dashboard a {\n\tpanel p {\n\t\ttype = pie\n\t\texpr = 1\n\t}\n}",
+		"dashboard a {\n\tpanel p {\n\t\texpr = 1\n\t}\n}",
+		"dashboard a {\n\tpanel p {\n\t\ttype = table\n\t\texpr = q(\"avg:os.cpu{host=$nope}\", \"1h\", \"\")\n\t}\n}",
+		"dashboard a {\n\tpanel p {\n\t\ttype = expr\n\t\texpr = 1\n\t}\n\tpanel p {\n\t\ttype = expr\n\t\texpr = 2\n\t}\n}",
+	} {
+		if _, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package conf
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leapar/bosun/opentsdb"
+)
+
+// SLO is a service level objective defined by an slo section: the ratio of
+// the Good to the Total events of its OpenTSDB queries over Window should be
+// at least Target percent. It is checked by an alert of the same name that
+// is critical or warning when the error budget burns faster than CritBurn or
+// WarnBurn.
+type SLO struct {
+	Text     string
+	Name     string
+	Good     string
+	Total    string
+	Target   float64
+	Window   string
+	CritBurn []BurnRate `json:",omitempty"`
+	WarnBurn []BurnRate `json:",omitempty"`
+	Locator  `json:"-"`
+}
+
+// BurnRate is a multi-window burn rate alert: it fires when the error budget
+// burns more than Factor times faster than it would be exhausted by the end
+// of the window of the SLO, over both the Long and the Short window.
+type BurnRate struct {
+	Long   string
+	Short  string
+	Factor float64
+}
+
+// DefaultCritBurn and DefaultWarnBurn are the burn rates of an SLO that does
+// not set them, which respectively spend 2% and 5% of a 30 day budget in an
+// hour and six hours, and 10% of it in a day and three days.
+var (
+	DefaultCritBurn = []BurnRate{{"1h", "5m", 14.4}, {"6h", "30m", 6}}
+	DefaultWarnBurn = []BurnRate{{"1d", "2h", 3}, {"3d", "6h", 1}}
+)
+
+// ParseBurnRates parses a comma separated list of long:short:factor burn
+// rates, such as "1h:5m:14.4, 6h:30m:6". "none" is no burn rates.
+func ParseBurnRates(s string) ([]BurnRate, error) {
+	if strings.TrimSpace(s) == "none" {
+		return nil, nil
+	}
+	var rates []BurnRate
+	for _, r := range strings.Split(s, ",") {
+		f := strings.Split(strings.TrimSpace(r), ":")
+		if len(f) != 3 {
+			return nil, fmt.Errorf("burn rate must be long:short:factor, got %q", r)
+		}
+		for _, d := range f[:2] {
+			if _, err := opentsdb.ParseDuration(d); err != nil {
+				return nil, fmt.Errorf("burn rate %q: %v", r, err)
+			}
+		}
+		factor, err := strconv.ParseFloat(f[2], 64)
+		if err != nil || factor <= 0 {
+			return nil, fmt.Errorf("burn rate %q: factor must be a positive number", r)
+		}
+		rates = append(rates, BurnRate{f[0], f[1], factor})
+	}
+	return rates, nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// ratio returns the expression of the ratio of good to total events over
+// window.
+func (s *SLO) ratio(window string) string {
+	return fmt.Sprintf(`(sum(q("%s", "%s", "")) / sum(q("%s", "%s", "")))`, s.Good, window, s.Total, window)
+}
+
+// budget returns the expression of the error budget of s, as a fraction of
+// the events.
+func (s *SLO) budget() string {
+	return fmt.Sprintf("(1 - %s / 100)", formatFloat(s.Target))
+}
+
+// ComplianceExpr returns the expression of the percentage of good events over
+// the window of s.
+func (s *SLO) ComplianceExpr() string {
+	return fmt.Sprintf("100 * %s", s.ratio(s.Window))
+}
+
+// BudgetExpr returns the expression of the percentage of the error budget of
+// s that is left over its window. It is negative once the budget is spent.
+func (s *SLO) BudgetExpr() string {
+	return fmt.Sprintf("100 * (1 - (1 - %s) / %s)", s.ratio(s.Window), s.budget())
+}
+
+// BurnExpr returns the expression of the rate the error budget of s burns at
+// over window, where 1 spends it exactly over the window of s.
+func (s *SLO) BurnExpr(window string) string {
+	return fmt.Sprintf("(1 - %s) / %s", s.ratio(window), s.budget())
+}
+
+// AlertExpr returns the expression that is true when any of rates fires, or
+// "" if there are none.
+func (s *SLO) AlertExpr(rates []BurnRate) string {
+	var conds []string
+	for _, r := range rates {
+		f := formatFloat(r.Factor)
+		conds = append(conds, fmt.Sprintf("(%s > %s && %s > %s)", s.BurnExpr(r.Long), f, s.BurnExpr(r.Short), f))
+	}
+	return strings.Join(conds, " || ")
+}
+
+// BurnWindows returns the windows of the burn rates of s, shortest first.
+func (s *SLO) BurnWindows() []string {
+	seen := make(map[string]bool)
+	var windows []string
+	for _, r := range append(append([]BurnRate{}, s.CritBurn...), s.WarnBurn...) {
+		for _, w := range []string{r.Long, r.Short} {
+			if !seen[w] {
+				seen[w] = true
+				windows = append(windows, w)
+			}
+		}
+	}
+	duration := func(w string) time.Duration {
+		d, _ := opentsdb.ParseDuration(w)
+		return time.Duration(d)
+	}
+	sort.Slice(windows, func(i, j int) bool { return duration(windows[i]) < duration(windows[j]) })
+	return windows
+}
+
+// Expr returns the expression of a value of s: "compliance", "budget", or
+// the burn rate over a duration such as "1h".
+func (s *SLO) Expr(value string) (string, error) {
+	switch value {
+	case "compliance":
+		return s.ComplianceExpr(), nil
+	case "budget":
+		return s.BudgetExpr(), nil
+	}
+	if _, err := opentsdb.ParseDuration(value); err != nil {
+		return "", fmt.Errorf("slo: unknown value %q, must be compliance, budget or a duration", value)
+	}
+	return s.BurnExpr(value), nil
+}
@@ -0,0 +1,54 @@
+package conf
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBurnRates(t *testing.T) {
+	rates, err := ParseBurnRates("1h:5m:14.4, 6h:30m:6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []BurnRate{{"1h", "5m", 14.4}, {"6h", "30m", 6}}; !reflect.DeepEqual(rates, want) {
+		t.Errorf("got %v, want %v", rates, want)
+	}
+	if rates, err := ParseBurnRates("none"); err != nil || rates != nil {
+		t.Errorf("got %v, %v for none", rates, err)
+	}
+	for _, bad := range []string{"", "1h:5m", "1x:5m:2", "1h:5m:0", "1h:5m:x"} {
+		if _, err := ParseBurnRates(bad); err == nil {
+			t.Errorf("expected error parsing %q", bad)
+		}
+	}
+}
+
+func TestSLOExpr(t *testing.T) {
+	s := &SLO{
+		Good:     "sum:good",
+		Total:    "sum:total",
+		Target:   99,
+		Window:   "30d",
+		CritBurn: []BurnRate{{"1h", "5m", 14.4}},
+		WarnBurn: []BurnRate{{"1d", "2h", 3}},
+	}
+	tests := map[string]string{
+		"compliance": `100 * (sum(q("sum:good", "30d", "")) / sum(q("sum:total", "30d", "")))`,
+		"budget":     `100 * (1 - (1 - (sum(q("sum:good", "30d", "")) / sum(q("sum:total", "30d", "")))) / (1 - 99 / 100))`,
+		"1h":         `(1 - (sum(q("sum:good", "1h", "")) / sum(q("sum:total", "1h", "")))) / (1 - 99 / 100)`,
+	}
+	for value, want := range tests {
+		got, err := s.Expr(value)
+		if err != nil {
+			t.Errorf("%s: %v", value, err)
+		} else if got != want {
+			t.Errorf("%s: got %s, want %s", value, got, want)
+		}
+	}
+	if _, err := s.Expr("nope"); err == nil {
+		t.Error("expected error for an unknown value")
+	}
+	if want := []string{"5m", "1h", "2h", "1d"}; !reflect.DeepEqual(s.BurnWindows(), want) {
+		t.Errorf("got windows %v, want %v", s.BurnWindows(), want)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/leapar/bosun/cmd/bosun/expr"
@@ -50,6 +51,26 @@ type SystemConf struct {
 
 	AnnotateConf AnnotateConf
 
+	QueryCacheConf QueryCacheConf
+
+	ReportConf ReportConf
+
+	InboundConf map[string]InboundConf
+
+	TopologyConf TopologyConf
+
+	AuditConf AuditConf
+
+	GitConf GitConf
+
+	InventoryConf InventoryConf
+
+	HealthCheckConf HealthCheckConf
+
+	SearchPruneConf SearchPruneConf
+
+	AlertMetricsConf AlertMetricsConf
+
 	AuthConf *AuthConf
 
 	EnableSave      bool
@@ -110,6 +131,154 @@ type AnnotateConf struct {
 	Index         string          // name of index / table
 }
 
+// QueryCacheConf configures the cache of query results that is shared by alert
+// checks and the web UI. Results from a backend are shared for its TTL, and
+// are not shared if the TTL is not set.
+type QueryCacheConf struct {
+	MaxEntries  int
+	OpenTSDBTTL Duration
+	GraphiteTTL Duration
+	InfluxTTL   Duration
+}
+
+// ReportConf configures the weekly digest of the alert reports. The digest is
+// only sent if DigestTo is set, through the mail server of SMTPConf.
+type ReportConf struct {
+	DigestTo   []string
+	DigestDay  string // day of the week to send the digest on: Monday
+	DigestHour int    // hour of the day, in UTC, to send the digest at: 9
+	DigestTop  int    // number of alerts in each section of the digest: 10
+}
+
+// InboundConf configures a provider of inbound webhooks, received at
+// /api/inbound/{provider}. Requests must carry Token, or be signed with an
+// HMAC-SHA256 of the body keyed by Secret, or both if both are set.
+type InboundConf struct {
+	Parser          string // payload format: generic, pagerduty or opsgenie
+	Token           string
+	Secret          string
+	SignatureHeader string // header of the hex signature, defaulting to that of the parser
+	Mapping         InboundMapping
+}
+
+// InboundMapping maps the fields of a generic JSON payload to incident
+// actions. Fields are dotted paths into the payload, such as
+// "incident.id". Events, if set, is the path of an array of events, to which
+// the other paths are relative. Actions maps values of the Action field to
+// the actions "ack", "close" and "note"; other values only link IDs.
+type InboundMapping struct {
+	Events     string
+	ExternalId string
+	IncidentId string
+	Action     string
+	User       string
+	Message    string
+	Actions    map[string]string
+}
+
+// TopologyConf configures where the upstream of tags, such as the switch a
+// host is behind, is read from. File is a file of "child parent" lines like
+// "host=ny-web01 host=ny-sw01"; the child may be a glob. Metadata is the name
+// of tag metadata whose value is the upstream of the tag it is set on, either
+// as tagk=tagv or as only the value for the same tag key.
+type TopologyConf struct {
+	File     string
+	Metadata string
+}
+
+// AuditConf configures the audit log of requests that change bosun's state.
+// Entries are always stored in the database, and are also appended as JSON
+// lines to File if it is set. If MaxEntries is positive, only that many of the
+// newest entries are kept in the database.
+type AuditConf struct {
+	File       string
+	MaxEntries int
+}
+
+// GitConf configures storing the rule file in a git repository. The
+// repository is the directory of RuleFilePath, and is created if it does
+// not exist. Every save is committed with the saving user as author. If
+// Remote is set, commits and proposals are pushed to Branch of it. If
+// Review is set, saves are stored as proposals that another user must
+// approve before they are applied.
+type GitConf struct {
+	Enabled bool
+	Remote  string
+	Branch  string
+	Review  bool
+}
+
+// InventoryConf configures the snapshots of the inventory of hosts, such as
+// their disks, interfaces and OS, which record how it changes. Snapshots are
+// taken every Interval, and not at all if it is 0. Changes older than
+// Retention are removed, unless it is 0.
+type InventoryConf struct {
+	Interval  Duration
+	Retention Duration
+}
+
+// SearchPruneConf configures the removal of metrics, tag keys, tag values
+// and tag sets from the search index when they have not been seen within
+// Retention. The index is pruned every Interval, at no more than Rate metrics
+// a second. It is never pruned if Retention is 0.
+type SearchPruneConf struct {
+	Retention Duration
+	Interval  Duration
+	Rate      int
+}
+
+// AlertMetricsConf configures sending the results of alert checks as data
+// points, so that alert state can be graphed next to the data and queried
+// with q(). Each check sends, for each alert key, its status as
+// bosun.alert.status, the values of its crit and warn expressions as
+// bosun.alert.value and, if Computations is set, the numeric values of up to
+// that many of their computations as bosun.alert.computation. Data points
+// are sent through collect, or directly to the OpenTSDB host with the time
+// of the check if Direct is set. Only MaxSeries alert keys of each alert and
+// data points with no more than MaxTags tags are sent; the others are
+// dropped and counted.
+type AlertMetricsConf struct {
+	Enabled      bool
+	Direct       bool
+	Computations int
+	MaxSeries    int // alert keys of each alert: 1000
+	MaxTags      int // tags of each data point, including alert: 8
+}
+
+// HealthCheckConf configures health checks of the hosts that bosun has
+// indexed, like Ping but with other protocols. Every Interval, each check is
+// run against the hosts matching it, with no more than Concurrency checks
+// running at once. A check fails if it takes longer than its Timeout, or
+// Timeout if it has none.
+type HealthCheckConf struct {
+	Interval    Duration
+	Concurrency int
+	Timeout     Duration
+	Checks      map[string]HealthCheck
+}
+
+// HealthCheck is a check of the hosts whose name matches the glob Hosts, or
+// all hosts if it is empty. Type is one of:
+//
+//	icmp:  ping the host.
+//	tcp:   connect to Port of the host.
+//	http:  get URL, or http://{host}/, and expect Status, or any status below
+//	       400 if it is 0. https URLs also report when the certificate expires.
+//	       Certificates are verified unless InsecureSkipVerify is set.
+//	dns:   resolve the host.
+//
+// {host} in URL is replaced by the name of the host.
+type HealthCheck struct {
+	Type    string
+	Hosts   string
+	Port    int
+	URL     string
+	Status  int
+	Timeout Duration
+
+	InsecureSkipVerify bool
+}
+
 // LogStashConf contains a list of elastic hosts for the depcrecated logstash functions
 type LogStashConf struct {
 	Hosts expr.LogstashElasticHosts
@@ -181,6 +350,24 @@ type AuthConf struct {
 	CookieSecret string
 	//LDAP configuration
 	LDAP LDAPConf
+	// Scopes are permissions limited to some alerts, by name. They are
+	// granted like other permissions, with "Scope:name".
+	Scopes map[string]AuthScope
+}
+
+// AuthScope grants permissions on the alerts it matches, for example to let
+// a team acknowledge and silence only their own alerts.
+type AuthScope struct {
+	// Id identifies the scope in tokens and login cookies. It must be from
+	// 1 to 15, unique, and not changed or reused while they exist.
+	Id int
+	// Permissions granted within the scope. Only "Actions", "Silence",
+	// "Save Config" and "View Dashboard" can be scoped.
+	Role string
+	// Alert name patterns, like "db.*|mysql.*". Any alert if empty.
+	Alerts []string
+	// Tag patterns alerts must have, like "service=db*". Any tags if empty.
+	Tags string
 }
 
 type LDAPConf struct {
@@ -240,6 +427,31 @@ func newSystemConf() *SystemConf {
 			ResponseLimit: 1 << 20, // 1MB
 			Version:       opentsdb.Version2_1,
 		},
+		QueryCacheConf: QueryCacheConf{
+			MaxEntries: 1000,
+		},
+		ReportConf: ReportConf{
+			DigestDay:  "Monday",
+			DigestHour: 9,
+			DigestTop:  10,
+		},
+		InventoryConf: InventoryConf{
+			Interval:  Duration{Duration: time.Hour},
+			Retention: Duration{Duration: time.Hour * 24 * 90},
+		},
+		SearchPruneConf: SearchPruneConf{
+			Interval: Duration{Duration: time.Hour * 24},
+			Rate:     10,
+		},
+		AlertMetricsConf: AlertMetricsConf{
+			MaxSeries: 1000,
+			MaxTags:   8,
+		},
+		HealthCheckConf: HealthCheckConf{
+			Interval:    Duration{Duration: time.Second * 15},
+			Concurrency: 50,
+			Timeout:     Duration{Duration: time.Second * 5},
+		},
 		SearchSince:      Duration{time.Duration(opentsdb.Day) * 3},
 		UnknownThreshold: 5,
 	}
@@ -283,6 +495,63 @@ func loadSystemConfig(conf string, isFileName bool) (*SystemConf, error) {
 		return sc, fmt.Errorf("Can't use both ES SimpleClient and ES ClientOptions please remove or disable one in AnnotateConf: %#v", sc.AnnotateConf)
 	}
 
+	if _, err := parseWeekday(sc.ReportConf.DigestDay); err != nil {
+		return sc, fmt.Errorf("ReportConf.DigestDay: %v", err)
+	}
+	if h := sc.ReportConf.DigestHour; h < 0 || h > 23 {
+		return sc, fmt.Errorf("ReportConf.DigestHour must be between 0 and 23, is %d", h)
+	}
+
+	for name, ic := range sc.InboundConf {
+		if ic.Token == "" && ic.Secret == "" {
+			return sc, fmt.Errorf("InboundConf.%s needs a Token or Secret", name)
+		}
+	}
+
+	if sc.AuditConf.MaxEntries < 0 {
+		return sc, fmt.Errorf("AuditConf.MaxEntries must not be negative")
+	}
+	if sc.HealthCheckConf.Interval.Duration <= 0 {
+		return sc, fmt.Errorf("HealthCheckConf.Interval must be positive")
+	}
+	if sc.HealthCheckConf.Timeout.Duration <= 0 {
+		return sc, fmt.Errorf("HealthCheckConf.Timeout must be positive")
+	}
+	for name, hc := range sc.HealthCheckConf.Checks {
+		if hc.Timeout.Duration < 0 {
+			return sc, fmt.Errorf("HealthCheckConf.Checks.%s.Timeout must be positive", name)
+		}
+		switch hc.Type {
+		case "icmp", "http", "dns":
+		case "tcp":
+			if hc.Port <= 0 {
+				return sc, fmt.Errorf("HealthCheckConf.Checks.%s needs a Port", name)
+			}
+		default:
+			return sc, fmt.Errorf("HealthCheckConf.Checks.%s has unknown Type %q, must be icmp, tcp, http or dns", name, hc.Type)
+		}
+	}
+	if sc.SearchPruneConf.Rate < 1 {
+		return sc, fmt.Errorf("SearchPruneConf.Rate must be at least 1")
+	}
+	if sc.HealthCheckConf.Concurrency < 1 {
+		return sc, fmt.Errorf("HealthCheckConf.Concurrency must be at least 1")
+	}
+	if am := sc.AlertMetricsConf; am.Enabled {
+		if am.MaxSeries < 1 {
+			return sc, fmt.Errorf("AlertMetricsConf.MaxSeries must be at least 1")
+		}
+		if am.MaxTags < 2 {
+			return sc, fmt.Errorf("AlertMetricsConf.MaxTags must be at least 2")
+		}
+		if am.Computations < 0 {
+			return sc, fmt.Errorf("AlertMetricsConf.Computations cannot be negative")
+		}
+		if sc.OpenTSDBConf.Host == "" {
+			return sc, fmt.Errorf("AlertMetricsConf needs an OpenTSDBConf.Host to send to")
+		}
+	}
+
 	sc.md = decodeMeta
 	// clear default http listen if not explicitly specified
 	if !decodeMeta.IsDefined("HTTPListen") && decodeMeta.IsDefined("HTTPSListen") {
@@ -450,6 +719,22 @@ func (sc *SystemConf) GetRuleFilePath() string {
 	return sc.RuleFilePath
 }
 
+// GetQueryCacheMaxEntries returns the maximum number of query results kept in
+// the shared query cache
+func (sc *SystemConf) GetQueryCacheMaxEntries() int {
+	return sc.QueryCacheConf.MaxEntries
+}
+
+// GetQueryCacheTTLs returns how long query results from each backend are shared
+// between alert checks and the web UI
+func (sc *SystemConf) GetQueryCacheTTLs() expr.QueryCacheTTLs {
+	return expr.QueryCacheTTLs{
+		OpenTSDB: sc.QueryCacheConf.OpenTSDBTTL.Duration,
+		Graphite: sc.QueryCacheConf.GraphiteTTL.Duration,
+		Influx:   sc.QueryCacheConf.InfluxTTL.Duration,
+	}
+}
+
 // SetTSDBHost sets the OpenTSDB host and used when Bosun is set to readonly mode
 func (sc *SystemConf) SetTSDBHost(tsdbHost string) {
 	sc.OpenTSDBConf.Host = tsdbHost
@@ -739,4 +1024,84 @@ func parseESAnnoteConfig(sc *SystemConf) expr.ElasticConfig {
 
 func (sc *SystemConf) GetUid() string {
 	return sc.Uid
-}
\ No newline at end of file
+}
+
+// GetReportDigestTo returns the addresses the weekly report digest is sent to.
+// No digest is sent if it is empty.
+func (sc *SystemConf) GetReportDigestTo() []string {
+	return sc.ReportConf.DigestTo
+}
+
+// GetReportDigestTime returns the day of the week and hour of the day in UTC
+// at which the weekly report digest is sent.
+func (sc *SystemConf) GetReportDigestTime() (time.Weekday, int) {
+	day, _ := parseWeekday(sc.ReportConf.DigestDay)
+	return day, sc.ReportConf.DigestHour
+}
+
+// GetReportDigestTop returns the number of alerts listed in each section of
+// the weekly report digest.
+func (sc *SystemConf) GetReportDigestTop() int {
+	return sc.ReportConf.DigestTop
+}
+
+// GetInboundConf returns the configuration of the inbound webhook providers
+// by name.
+func (sc *SystemConf) GetInboundConf() map[string]InboundConf {
+	return sc.InboundConf
+}
+
+// GetTopologyConf returns where the upstream of tags is read from.
+func (sc *SystemConf) GetTopologyConf() TopologyConf {
+	return sc.TopologyConf
+}
+
+// GetAuditFile returns the file audit entries are appended to as JSON
+// lines, or "" if they are only stored in the database.
+func (sc *SystemConf) GetAuditFile() string {
+	return sc.AuditConf.File
+}
+
+// GetAuditMaxEntries returns how many of the newest audit entries are kept
+// in the database, or 0 if all are.
+func (sc *SystemConf) GetAuditMaxEntries() int {
+	return sc.AuditConf.MaxEntries
+}
+
+// GetGitConf returns the configuration of the git repository of the rule
+// file.
+func (sc *SystemConf) GetGitConf() GitConf {
+	return sc.GitConf
+}
+
+// GetInventoryConf returns how often the inventory of hosts is snapshot, and
+// how long its changes are kept.
+func (sc *SystemConf) GetInventoryConf() (interval, retention time.Duration) {
+	return sc.InventoryConf.Interval.Duration, sc.InventoryConf.Retention.Duration
+}
+
+// GetSearchPruneConf returns how long search index entries are kept, how
+// often they are pruned and how many metrics are pruned a second.
+func (sc *SystemConf) GetSearchPruneConf() (retention, interval time.Duration, rate int) {
+	return sc.SearchPruneConf.Retention.Duration, sc.SearchPruneConf.Interval.Duration, sc.SearchPruneConf.Rate
+}
+
+// GetHealthCheckConf returns the health checks of hosts.
+func (sc *SystemConf) GetHealthCheckConf() HealthCheckConf {
+	return sc.HealthCheckConf
+}
+
+// GetAlertMetricsConf returns how the results of alert checks are sent as
+// data points.
+func (sc *SystemConf) GetAlertMetricsConf() AlertMetricsConf {
+	return sc.AlertMetricsConf
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("unknown day of the week: %s", s)
+}
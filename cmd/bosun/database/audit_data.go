@@ -0,0 +1,159 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+/*
+
+audit : list of json AuditEntry, oldest first. Entries are only removed if
+AddAuditEntry is given a maximum. The id of an entry is its position in the list, counting
+from 1, plus auditTrimmed.
+auditTrimmed : number of entries removed from the front of audit
+
+*/
+
+const (
+	auditKey        = "audit"
+	auditTrimmedKey = "auditTrimmed"
+)
+
+// auditPage is how many entries are read at once when querying.
+const auditPage = 500
+
+// AuditQuery selects audit entries. Zero fields select all.
+type AuditQuery struct {
+	User  string
+	Route string
+	Since time.Time
+	Until time.Time
+	// Before selects entries with lower ids, to page through results.
+	Before int64
+	Limit  int
+}
+
+type AuditDataAccess interface {
+	// AddAuditEntry appends an entry to the audit log, and sets its id. The
+	// oldest entries are removed so that at most max are kept, if max is
+	// positive.
+	AddAuditEntry(e *models.AuditEntry, max int) error
+	// GetAuditEntries returns the entries that match q, newest first.
+	GetAuditEntries(q AuditQuery) ([]*models.AuditEntry, error)
+}
+
+func (d *dataAccess) Audit() AuditDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddAuditEntry(e *models.AuditEntry, max int) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	// The id is only known after pushing, and is set from the position
+	// when read.
+	data, err := json.Marshal(e)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	// Trimming and counting the removed entries must not interleave with
+	// other additions, or ids would shift.
+	d.auditLock.Lock()
+	defer d.auditLock.Unlock()
+	trimmed, err := auditTrimmed(conn)
+	if err != nil {
+		return err
+	}
+	n, err := redis.Int64(conn.Do("RPUSH", auditKey, data))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	e.Id = trimmed + n
+	if max <= 0 || n <= int64(max) {
+		return nil
+	}
+	drop := n - int64(max)
+	return d.transact(conn, func() error {
+		if _, err := conn.Do("LTRIM", auditKey, drop, -1); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("INCRBY", auditTrimmedKey, drop); err != nil {
+			return slog.Wrap(err)
+		}
+		return nil
+	})
+}
+
+// auditTrimmed returns the number of entries removed from the front of the
+// audit log.
+func auditTrimmed(conn redis.Conn) (int64, error) {
+	trimmed, err := redis.Int64(conn.Do("GET", auditTrimmedKey))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	return trimmed, nil
+}
+
+func (d *dataAccess) GetAuditEntries(q AuditQuery) ([]*models.AuditEntry, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	// A trim between reading auditTrimmed and the entries would shift the
+	// ids of the entries read.
+	d.auditLock.Lock()
+	defer d.auditLock.Unlock()
+	trimmed, err := auditTrimmed(conn)
+	if err != nil {
+		return nil, err
+	}
+	end, err := redis.Int64(conn.Do("LLEN", auditKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if q.Before > 0 && q.Before-1-trimmed < end {
+		end = q.Before - 1 - trimmed
+	}
+	entries := []*models.AuditEntry{}
+	// Read pages backwards from the newest entry, until the limit or the
+	// start of the time range is reached.
+	for end > 0 {
+		start := end - auditPage
+		if start < 0 {
+			start = 0
+		}
+		data, err := redis.Strings(conn.Do("LRANGE", auditKey, start, end-1))
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		for i := len(data) - 1; i >= 0; i-- {
+			e := &models.AuditEntry{}
+			if err := json.Unmarshal([]byte(data[i]), e); err != nil {
+				return nil, slog.Wrap(err)
+			}
+			e.Id = trimmed + start + int64(i) + 1
+			if !q.Since.IsZero() && e.Time.Before(q.Since) {
+				return entries, nil
+			}
+			if !q.Until.IsZero() && e.Time.After(q.Until) {
+				continue
+			}
+			if (q.User != "" && e.User != q.User) || (q.Route != "" && e.Route != q.Route) {
+				continue
+			}
+			entries = append(entries, e)
+			if q.Limit > 0 && len(entries) >= q.Limit {
+				return entries, nil
+			}
+		}
+		end = start
+	}
+	return entries, nil
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+/*
+
+dashboard:{name} : json Dashboard stored through the API
+
+dashboards : set of names of stored dashboards
+
+*/
+
+const dashboardsKey = "dashboards"
+
+func dashboardKey(name string) string {
+	return fmt.Sprintf("dashboard:%s", name)
+}
+
+type DashboardDataAccess interface {
+	// GetDashboard returns the stored dashboard with the given name, or nil
+	// if there is none.
+	GetDashboard(name string) (*models.Dashboard, error)
+	// GetDashboards returns all stored dashboards.
+	GetDashboards() ([]*models.Dashboard, error)
+	// PutDashboard stores d, replacing the dashboard of the same name.
+	PutDashboard(d *models.Dashboard) error
+	// DeleteDashboard removes the stored dashboard with the given name.
+	DeleteDashboard(name string) error
+}
+
+func (d *dataAccess) Dashboards() DashboardDataAccess {
+	return d
+}
+
+func (d *dataAccess) GetDashboard(name string) (*models.Dashboard, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("GET", dashboardKey(name)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	dash := &models.Dashboard{}
+	if err := json.Unmarshal(b, dash); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return dash, nil
+}
+
+func (d *dataAccess) GetDashboards() ([]*models.Dashboard, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	names, err := redis.Strings(conn.Do("SMEMBERS", dashboardsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	dashboards := make([]*models.Dashboard, 0, len(names))
+	for _, name := range names {
+		dash, err := d.GetDashboard(name)
+		if err != nil {
+			return nil, err
+		}
+		if dash != nil {
+			dashboards = append(dashboards, dash)
+		}
+	}
+	return dashboards, nil
+}
+
+func (d *dataAccess) PutDashboard(dash *models.Dashboard) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(dash)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("SET", dashboardKey(dash.Name), b); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("SADD", dashboardsKey, dash.Name)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) DeleteDashboard(name string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", dashboardKey(name)); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("SREM", dashboardsKey, name)
+	return slog.Wrap(err)
+}
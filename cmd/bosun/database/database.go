@@ -7,6 +7,7 @@ import (
 	"log"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/captncraig/easyauth/providers/token/redisStore"
@@ -18,7 +19,7 @@ import (
 	"github.com/siddontang/ledisdb/server"
 )
 
-var SchemaVersion = int64(1)
+var SchemaVersion = int64(2)
 
 // Core data access interface for everything sched needs
 type DataAccess interface {
@@ -30,6 +31,10 @@ type DataAccess interface {
 	State() StateDataAccess
 	Silence() SilenceDataAccess
 	Notifications() NotificationDataAccess
+	Inbound() InboundDataAccess
+	Audit() AuditDataAccess
+	Inventory() InventoryDataAccess
+	Dashboards() DashboardDataAccess
 	Migrate() error
 }
 
@@ -42,6 +47,18 @@ type MetadataDataAccess interface {
 	PutTagMetadata(tags opentsdb.TagSet, name string, value string, updated time.Time) error
 	GetTagMetadata(tags opentsdb.TagSet, name string) ([]*TagMetadata, error)
 	DeleteTagMetadata(tags opentsdb.TagSet, name string) error
+
+	// PutMetricCatalog stores c as the catalogue entry of its metric, and
+	// adds it to the history of the entry.
+	PutMetricCatalog(c *MetricCatalog) error
+	// GetMetricCatalog returns the catalogue entry of metric, or nil if
+	// there is none.
+	GetMetricCatalog(metric string) (*MetricCatalog, error)
+	// GetMetricCatalogs returns the catalogue entries of all metrics.
+	GetMetricCatalogs() (map[string]*MetricCatalog, error)
+	// GetMetricCatalogHistory returns the versions of the catalogue entry
+	// of metric, newest first. All are returned if limit is 0.
+	GetMetricCatalogHistory(metric string, limit int) ([]*MetricCatalog, error)
 }
 
 type SearchDataAccess interface {
@@ -65,11 +82,27 @@ type SearchDataAccess interface {
 
 	AddHostTagSet(host, uid string, tagSet []opentsdb.TagSet) error
 	DelHostTagSet(host, uid string, tagSet []opentsdb.TagSet) error
+
+	// PruneMetric removes the tag keys, tag values and tag sets of metric
+	// last seen before a unix time, and the metric itself if it was. It
+	// returns the number of entries removed.
+	PruneMetric(metric, uid string, before int64) (int, error)
+	// PruneTagValues removes the values of tagK for metric last seen
+	// before a unix time, and returns how many were removed.
+	PruneTagValues(metric, tagK, uid string, before int64) (int, error)
+
+	ExportSearch(uid string) (*SearchIndex, error)
+	// ImportSearch adds the entries of idx, replacing the times of entries
+	// that are already present.
+	ImportSearch(uid string, idx *SearchIndex) error
 }
 
 type dataAccess struct {
 	pool    *redis.Pool
 	isRedis bool
+	// auditLock serializes additions to the audit log with each other and
+	// with reads of it.
+	auditLock sync.Mutex
 }
 
 // Create a new data access object pointed at the specified address. isRedis parameter used to distinguish true redis from ledis in-proc.
@@ -192,6 +225,20 @@ func (d *dataAccess) HCLEAR() string {
 	return "HCLEAR"
 }
 
+func (d *dataAccess) ZCLEAR() string {
+	if d.isRedis {
+		return "DEL"
+	}
+	return "ZCLEAR"
+}
+
+func (d *dataAccess) ZEXPIRE() string {
+	if d.isRedis {
+		return "EXPIRE"
+	}
+	return "ZEXPIRE"
+}
+
 func (d *dataAccess) LMCLEAR(key string, value string) (string, []interface{}) {
 	if d.isRedis {
 		return "LREM", []interface{}{key, 0, value}
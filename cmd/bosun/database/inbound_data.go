@@ -0,0 +1,123 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+/*
+
+inboundIncidents:{provider} : hash of external incident id - bosun incident id
+
+externalIds:{id} : hash of provider - external incident id, per bosun incident
+
+inboundAudit : list of json InboundAudit, newest first, capped at inboundAuditLength
+
+*/
+
+const (
+	inboundAuditKey    = "inboundAudit"
+	inboundAuditLength = 1000
+)
+
+func inboundIncidentsKey(provider string) string {
+	return fmt.Sprintf("inboundIncidents:%s", provider)
+}
+
+func externalIdsKey(id int64) string {
+	return fmt.Sprintf("externalIds:%d", id)
+}
+
+type InboundDataAccess interface {
+	// SetExternalIncident links the incident with an external id of provider.
+	SetExternalIncident(provider, externalId string, incidentId int64) error
+	// GetExternalIncident returns the incident linked with an external id of
+	// provider, or 0 if there is none.
+	GetExternalIncident(provider, externalId string) (int64, error)
+	// GetExternalIds returns the external ids of an incident by provider.
+	GetExternalIds(incidentId int64) (map[string]string, error)
+
+	AddInboundAudit(a *models.InboundAudit) error
+	// GetInboundAudit returns up to limit audit records, newest first,
+	// optionally only of provider.
+	GetInboundAudit(provider string, limit int) ([]*models.InboundAudit, error)
+}
+
+func (d *dataAccess) Inbound() InboundDataAccess {
+	return d
+}
+
+func (d *dataAccess) SetExternalIncident(provider, externalId string, incidentId int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("HSET", inboundIncidentsKey(provider), externalId, incidentId); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("HSET", externalIdsKey(incidentId), provider, externalId)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetExternalIncident(provider, externalId string) (int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	id, err := redis.Int64(conn.Do("HGET", inboundIncidentsKey(provider), externalId))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return id, slog.Wrap(err)
+}
+
+func (d *dataAccess) GetExternalIds(incidentId int64) (map[string]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := redis.StringMap(conn.Do("HGETALL", externalIdsKey(incidentId)))
+	return ids, slog.Wrap(err)
+}
+
+func (d *dataAccess) AddInboundAudit(a *models.InboundAudit) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("LPUSH", inboundAuditKey, data); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("LTRIM", inboundAuditKey, 0, inboundAuditLength-1)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetInboundAudit(provider string, limit int) ([]*models.InboundAudit, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := redis.Strings(conn.Do("LRANGE", inboundAuditKey, 0, inboundAuditLength-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	audits := []*models.InboundAudit{}
+	for _, s := range data {
+		if len(audits) >= limit {
+			break
+		}
+		a := &models.InboundAudit{}
+		if err := json.Unmarshal([]byte(s), a); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if provider != "" && a.Provider != provider {
+			continue
+		}
+		audits = append(audits, a)
+	}
+	return audits, nil
+}
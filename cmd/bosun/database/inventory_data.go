@@ -0,0 +1,169 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+/*
+
+inventory:{host} : json Inventory, the latest snapshot of host
+
+inventoryHosts : set of hosts with a snapshot
+
+inventoryChanges : sorted set of json InventoryChange by unix time
+
+inventoryChanges:{host} : sorted set of json InventoryChange of host by unix time
+
+*/
+
+const (
+	inventoryHostsKey   = "inventoryHosts"
+	inventoryChangesKey = "inventoryChanges"
+)
+
+func inventoryKey(host string) string {
+	return fmt.Sprintf("inventory:%s", host)
+}
+
+func inventoryHostChangesKey(host string) string {
+	return fmt.Sprintf("inventoryChanges:%s", host)
+}
+
+type InventoryDataAccess interface {
+	// GetInventory returns the latest snapshot of host, or nil if there is
+	// none.
+	GetInventory(host string) (*models.Inventory, error)
+	// SetInventory stores inv as the latest snapshot of its host, and adds
+	// changes to the history.
+	SetInventory(inv *models.Inventory, changes []*models.InventoryChange) error
+	// GetInventoryHosts returns the hosts with a snapshot.
+	GetInventoryHosts() ([]string, error)
+	// GetInventoryHistory returns the changes of host, newest first. All
+	// changes are returned if limit is 0.
+	GetInventoryHistory(host string, limit int) ([]*models.InventoryChange, error)
+	// GetInventoryChanges returns the changes of all hosts since a time,
+	// oldest first.
+	GetInventoryChanges(since time.Time) ([]*models.InventoryChange, error)
+	// ClearInventoryChangesBefore removes changes older than a time.
+	ClearInventoryChangesBefore(t time.Time) error
+}
+
+func (d *dataAccess) Inventory() InventoryDataAccess {
+	return d
+}
+
+func (d *dataAccess) GetInventory(host string) (*models.Inventory, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("GET", inventoryKey(host)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	inv := &models.Inventory{}
+	if err := json.Unmarshal(b, inv); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return inv, nil
+}
+
+func (d *dataAccess) SetInventory(inv *models.Inventory, changes []*models.InventoryChange) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(inv)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("SET", inventoryKey(inv.Host), b); err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("SADD", inventoryHostsKey, inv.Host); err != nil {
+		return slog.Wrap(err)
+	}
+	for _, c := range changes {
+		b, err := json.Marshal(c)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		ts := c.Time.UTC().Unix()
+		if _, err := conn.Do("ZADD", inventoryChangesKey, ts, b); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("ZADD", inventoryHostChangesKey(c.Host), ts, b); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (d *dataAccess) GetInventoryHosts() ([]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	hosts, err := redis.Strings(conn.Do("SMEMBERS", inventoryHostsKey))
+	return hosts, slog.Wrap(err)
+}
+
+func (d *dataAccess) GetInventoryHistory(host string, limit int) ([]*models.InventoryChange, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	vals, err := redis.Strings(conn.Do("ZREVRANGE", inventoryHostChangesKey(host), 0, limit-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return unmarshalInventoryChanges(vals)
+}
+
+func (d *dataAccess) GetInventoryChanges(since time.Time) ([]*models.InventoryChange, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	vals, err := redis.Strings(conn.Do("ZRANGEBYSCORE", inventoryChangesKey, since.UTC().Unix(), "+inf"))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return unmarshalInventoryChanges(vals)
+}
+
+func (d *dataAccess) ClearInventoryChangesBefore(t time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	max := fmt.Sprintf("(%d", t.UTC().Unix())
+	if _, err := conn.Do("ZREMRANGEBYSCORE", inventoryChangesKey, "-inf", max); err != nil {
+		return slog.Wrap(err)
+	}
+	hosts, err := redis.Strings(conn.Do("SMEMBERS", inventoryHostsKey))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	for _, host := range hosts {
+		if _, err := conn.Do("ZREMRANGEBYSCORE", inventoryHostChangesKey(host), "-inf", max); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func unmarshalInventoryChanges(vals []string) ([]*models.InventoryChange, error) {
+	changes := make([]*models.InventoryChange, 0, len(vals))
+	for _, v := range vals {
+		c := &models.InventoryChange{}
+		if err := json.Unmarshal([]byte(v), c); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
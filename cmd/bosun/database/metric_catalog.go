@@ -0,0 +1,111 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+
+	"github.com/leapar/bosun/slog"
+)
+
+/*
+
+mcatalog:{metric} : json MetricCatalog, the current catalogue entry of metric
+
+mcatalogMetrics : set of metrics with a catalogue entry
+
+mcatalogHistory:{metric} : list of json MetricCatalog, the versions of the entry, newest first, capped at metricCatalogHistoryLength
+
+*/
+
+const (
+	metricCatalogMetricsKey    = "mcatalogMetrics"
+	metricCatalogHistoryLength = 100
+)
+
+func metricCatalogKey(metric string) string {
+	return fmt.Sprintf("mcatalog:%s", metric)
+}
+
+func metricCatalogHistoryKey(metric string) string {
+	return fmt.Sprintf("mcatalogHistory:%s", metric)
+}
+
+func (d *dataAccess) PutMetricCatalog(c *MetricCatalog) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("SET", metricCatalogKey(c.Metric), b); err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("SADD", metricCatalogMetricsKey, c.Metric); err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("LPUSH", metricCatalogHistoryKey(c.Metric), b); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("LTRIM", metricCatalogHistoryKey(c.Metric), 0, metricCatalogHistoryLength-1)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetMetricCatalog(metric string) (*MetricCatalog, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("GET", metricCatalogKey(metric)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	c := &MetricCatalog{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return c, nil
+}
+
+func (d *dataAccess) GetMetricCatalogs() (map[string]*MetricCatalog, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	metrics, err := redis.Strings(conn.Do("SMEMBERS", metricCatalogMetricsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	catalogs := make(map[string]*MetricCatalog, len(metrics))
+	for _, m := range metrics {
+		c, err := d.GetMetricCatalog(m)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			catalogs[m] = c
+		}
+	}
+	return catalogs, nil
+}
+
+func (d *dataAccess) GetMetricCatalogHistory(metric string, limit int) ([]*MetricCatalog, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	vals, err := redis.Strings(conn.Do("LRANGE", metricCatalogHistoryKey(metric), 0, limit-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	history := make([]*MetricCatalog, 0, len(vals))
+	for _, v := range vals {
+		c := &MetricCatalog{}
+		if err := json.Unmarshal([]byte(v), c); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		history = append(history, c)
+	}
+	return history, nil
+}
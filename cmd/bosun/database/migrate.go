@@ -10,6 +10,7 @@ import (
 
 // Version 0 is the schema that was never verisoned
 // Version 1 migrates rendered templates from
+// Version 2 builds the secondary indexes used to search incidents
 
 var schemaKey = "schemaVersion"
 
@@ -25,6 +26,11 @@ var tasks = []Migration{
 		Task:    migrateRenderedTemplates,
 		Version: 1,
 	},
+	{
+		UID:     "Index Incidents",
+		Task:    migrateIndexIncidents,
+		Version: 2,
+	},
 }
 
 type oldIncidentState struct {
@@ -75,6 +81,30 @@ func migrateRenderedTemplates(d *dataAccess) error {
 	return nil
 }
 
+func migrateIndexIncidents(d *dataAccess) error {
+	slog.Infoln("Building incident search indexes. This can take several minutes.")
+
+	ids, err := d.getAllIncidentIdsByKeys()
+	if err != nil {
+		return err
+	}
+	slog.Infof("indexing %v incidents", len(ids))
+
+	conn := d.Get()
+	defer conn.Close()
+
+	for _, id := range ids {
+		s, err := d.getIncident(id, conn)
+		if err != nil {
+			return err
+		}
+		if err := indexIncident(conn, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *dataAccess) Migrate() error {
 	slog.Infoln("checking migrations")
 	conn := d.Get()
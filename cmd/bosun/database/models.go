@@ -1,6 +1,11 @@
 package database
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/leapar/bosun/opentsdb"
 )
 
@@ -11,6 +16,55 @@ type MetricMetadata struct {
 	LastTouched int64  `redis:"lastTouched"`
 }
 
+// MetricCatalog is the catalogue entry of a metric: who owns it, where it is
+// documented, and what its data points should look like. TagKeys are the
+// keys every data point must have, and the only keys it may have if Strict.
+// Retention is the retention class of the metric, such as "short" or "1y".
+// User and Time record who last changed the entry, and when.
+type MetricCatalog struct {
+	Metric     string
+	Owner      string   `json:",omitempty"`
+	Runbook    string   `json:",omitempty"`
+	TagKeys    []string `json:",omitempty"`
+	Strict     bool     `json:",omitempty"`
+	Retention  string   `json:",omitempty"`
+	Deprecated bool     `json:",omitempty"`
+	User       string   `json:",omitempty"`
+	Time       time.Time
+}
+
+// Validate returns how a data point of the metric with tags violates c,
+// or nil if it does not.
+func (c *MetricCatalog) Validate(tags opentsdb.TagSet) []string {
+	var problems []string
+	if c.Deprecated {
+		problems = append(problems, "metric is deprecated")
+	}
+	var missing, extra []string
+	expected := make(map[string]bool, len(c.TagKeys))
+	for _, k := range c.TagKeys {
+		expected[k] = true
+		if _, ok := tags[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if c.Strict {
+		for k := range tags {
+			if !expected[k] {
+				extra = append(extra, k)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing tag keys: %s", strings.Join(missing, ", ")))
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		problems = append(problems, fmt.Sprintf("unexpected tag keys: %s", strings.Join(extra, ", ")))
+	}
+	return problems
+}
+
 type TagMetadata struct {
 	Tags        opentsdb.TagSet
 	Name        string
@@ -23,3 +77,14 @@ type LastInfo struct {
 	DiffFromPrev float64
 	Timestamp    int64
 }
+
+// SearchIndex is the search index of a uid, in a form that can be exported
+// from one instance and imported into another. Each entry maps to the unix
+// time it was last seen. TagValues are by metric, or Search_All for the
+// values of any metric, and then by tag key.
+type SearchIndex struct {
+	Metrics   map[string]int64
+	TagKeys   map[string]map[string]int64
+	TagValues map[string]map[string]map[string]int64
+	TagSets   map[string]map[string]int64
+}
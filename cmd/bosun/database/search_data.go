@@ -208,6 +208,157 @@ func (d *dataAccess) LoadLastInfos() (map[string]map[string]*LastInfo, error) {
 	return m, nil
 }
 
+func (d *dataAccess) PruneMetric(metric, uid string, before int64) (int, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	n := 0
+	tagKeys, err := d.GetTagKeysForMetric(metric, uid)
+	if err != nil {
+		return n, slog.Wrap(err)
+	}
+	for tagK, t := range tagKeys {
+		pruned, err := d.PruneTagValues(metric, tagK, uid, before)
+		n += pruned
+		if err != nil {
+			return n, err
+		}
+		if t >= before {
+			continue
+		}
+		if _, err := conn.Do("HDEL", searchTagkKey(metric, uid), tagK); err != nil {
+			return n, slog.Wrap(err)
+		}
+		n++
+	}
+	tagSets, err := d.GetMetricTagSets(metric, uid, nil)
+	if err != nil {
+		return n, err
+	}
+	for tagSet, t := range tagSets {
+		if t >= before {
+			continue
+		}
+		if _, err := conn.Do("HDEL", searchMetricTagSetKey(metric, uid), tagSet); err != nil {
+			return n, slog.Wrap(err)
+		}
+		n++
+	}
+	t, err := redis.Int64(conn.Do("HGET", searchAllMetricsKey(uid), metric))
+	if err != nil && err != redis.ErrNil {
+		return n, slog.Wrap(err)
+	}
+	if err == nil && t < before {
+		if _, err := conn.Do("HDEL", searchAllMetricsKey(uid), metric); err != nil {
+			return n, slog.Wrap(err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (d *dataAccess) PruneTagValues(metric, tagK, uid string, before int64) (int, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	n := 0
+	tagValues, err := d.GetTagValues(metric, tagK, uid)
+	if err != nil {
+		return n, slog.Wrap(err)
+	}
+	for tagV, t := range tagValues {
+		if t >= before {
+			continue
+		}
+		if _, err := conn.Do("HDEL", searchTagvKey(metric, tagK, uid), tagV); err != nil {
+			return n, slog.Wrap(err)
+		}
+		if metric != Search_All {
+			// The metric is indexed for the tag at the same time as the value.
+			if _, err := conn.Do("HDEL", searchMetricKey(tagK, tagV, uid), metric); err != nil {
+				return n, slog.Wrap(err)
+			}
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (d *dataAccess) ExportSearch(uid string) (*SearchIndex, error) {
+	idx := &SearchIndex{
+		TagKeys:   make(map[string]map[string]int64),
+		TagValues: make(map[string]map[string]map[string]int64),
+		TagSets:   make(map[string]map[string]int64),
+	}
+	var err error
+	if idx.Metrics, err = d.GetAllMetrics(uid); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	all := make(map[string]map[string]int64)
+	for metric := range idx.Metrics {
+		tagKeys, err := d.GetTagKeysForMetric(metric, uid)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		idx.TagKeys[metric] = tagKeys
+		values := make(map[string]map[string]int64)
+		for tagK := range tagKeys {
+			if values[tagK], err = d.GetTagValues(metric, tagK, uid); err != nil {
+				return nil, slog.Wrap(err)
+			}
+			if _, ok := all[tagK]; !ok {
+				if all[tagK], err = d.GetTagValues(Search_All, tagK, uid); err != nil {
+					return nil, slog.Wrap(err)
+				}
+			}
+		}
+		idx.TagValues[metric] = values
+		if idx.TagSets[metric], err = d.GetMetricTagSets(metric, uid, nil); err != nil {
+			return nil, err
+		}
+	}
+	idx.TagValues[Search_All] = all
+	return idx, nil
+}
+
+func (d *dataAccess) ImportSearch(uid string, idx *SearchIndex) error {
+	for metric, t := range idx.Metrics {
+		if err := d.AddMetric(metric, uid, t); err != nil {
+			return err
+		}
+	}
+	for metric, tagKeys := range idx.TagKeys {
+		for tagK, t := range tagKeys {
+			if err := d.AddTagKeyForMetric(metric, tagK, uid, t); err != nil {
+				return err
+			}
+		}
+	}
+	for metric, values := range idx.TagValues {
+		for tagK, tagValues := range values {
+			for tagV, t := range tagValues {
+				if err := d.AddTagValue(metric, tagK, tagV, uid, t); err != nil {
+					return err
+				}
+				if metric == Search_All {
+					continue
+				}
+				if err := d.AddMetricForTag(tagK, tagV, metric, uid, t); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for metric, tagSets := range idx.TagSets {
+		for tagSet, t := range tagSets {
+			if err := d.AddMetricTagSet(metric, tagSet, uid, t); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 //This function not exposed on any public interface. See cmd/bosun/database/test/util/purge_search_data.go for usage.
 func (d *dataAccess) PurgeSearchData(metric, uid string, noop bool) error {
 	conn := d.Get()
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
 	"github.com/leapar/bosun/slog"
 	"github.com/garyburd/redigo/redis"
 )
@@ -26,10 +27,22 @@ openIncidents - Hash of open incident Ids. Alert Key -> incident id
 incidents:{ak} - List of incidents for alert key
 
 allIncidents - List of all incidents ever. Value is "incidentId:timestamp:ak"
+
+Secondary indexes for searching incidents. Each is a ZSET of incident ids
+scored by start time:
+incidentsByStart - all incidents
+incidentsByAlert:{alert} - incidents of an alert
+incidentsByTag:{k}={v} - incidents whose alert key has the tag
+incidentsByWorstStatus:{status} - incidents by their current worst status
+incidentsByAckUser:{user} - incidents acknowledged by a user
+incidentSearch:{n} - intersection of indexes for a search, removed after it
+incidentSearches - counter of the intersections
 */
 
 const (
 	statesOpenIncidentsKey = "openIncidents"
+	incidentsByStartKey    = "incidentsByStart"
+	incidentSearchesKey    = "incidentSearches"
 )
 
 func statesLastTouchedKey(alert string) string {
@@ -50,6 +63,21 @@ func renderedTemplatesKey(id int64) string {
 func incidentsForAlertKeyKey(ak models.AlertKey) string {
 	return fmt.Sprintf("incidents:%s", ak)
 }
+func incidentsByAlertKey(alert string) string {
+	return fmt.Sprintf("incidentsByAlert:%s", alert)
+}
+func incidentsByTagKey(k, v string) string {
+	return fmt.Sprintf("incidentsByTag:%s=%s", k, v)
+}
+func incidentsByWorstStatusKey(s models.Status) string {
+	return fmt.Sprintf("incidentsByWorstStatus:%s", s)
+}
+func incidentsByAckUserKey(user string) string {
+	return fmt.Sprintf("incidentsByAckUser:%s", user)
+}
+func incidentSearchKey(n int64) string {
+	return fmt.Sprintf("incidentSearch:%d", n)
+}
 
 type StateDataAccess interface {
 	TouchAlertKey(ak models.AlertKey, t time.Time) error
@@ -61,6 +89,7 @@ type StateDataAccess interface {
 	GetIncidentState(incidentId int64) (*models.IncidentState, error)
 
 	GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error)
+	SearchIncidents(q *IncidentQuery, offset, limit int) ([]*models.IncidentState, int, error)
 
 	UpdateIncidentState(s *models.IncidentState) (int64, error)
 	ImportIncidentState(s *models.IncidentState) error
@@ -308,7 +337,12 @@ func (d *dataAccess) save(s *models.IncidentState, isImport bool) (int64, error)
 		if err != nil {
 			return slog.Wrap(err)
 		}
-		_, err = conn.Do("SET", incidentStateKey(s.Id), data)
+		if _, err = conn.Do("SET", incidentStateKey(s.Id), data); err != nil {
+			return slog.Wrap(err)
+		}
+		if err = indexIncident(conn, s); err != nil {
+			return err
+		}
 
 		addRem := func(b bool) string {
 			if b {
@@ -359,6 +393,10 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 	if err != nil {
 		return slog.Wrap(err)
 	}
+	incidents, err := d.incidentMultiGet(conn, ids)
+	if err != nil {
+		return err
+	}
 	alert := ak.Name()
 	return d.transact(conn, func() error {
 		// last touched.
@@ -385,6 +423,11 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 				return slog.Wrap(err)
 			}
 		}
+		for _, incident := range incidents {
+			if err := unindexIncident(conn, incident); err != nil {
+				return err
+			}
+		}
 		if _, err := conn.Do(d.LCLEAR(), incidentsForAlertKeyKey(ak)); err != nil {
 			return slog.Wrap(err)
 		}
@@ -447,3 +490,224 @@ func (d *dataAccess) transact(conn redis.Conn, f func() error) error {
 	}
 	return nil
 }
+
+var indexedStatuses = []models.Status{models.StNormal, models.StWarning, models.StCritical, models.StUnknown}
+
+// indexIncident adds s to the secondary indexes used by SearchIncidents.
+func indexIncident(conn redis.Conn, s *models.IncidentState) error {
+	start := s.Start.UTC().Unix()
+	keys := []string{incidentsByStartKey, incidentsByAlertKey(s.AlertKey.Name())}
+	for k, v := range s.AlertKey.Group() {
+		keys = append(keys, incidentsByTagKey(k, v))
+	}
+	keys = append(keys, incidentsByWorstStatusKey(s.WorstStatus))
+	for _, a := range s.Actions {
+		if a.Type == models.ActionAcknowledge {
+			keys = append(keys, incidentsByAckUserKey(a.User))
+		}
+	}
+	for _, key := range keys {
+		if _, err := conn.Do("ZADD", key, start, s.Id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	// The worst status only increases, so drop the incident from the
+	// indexes of lower statuses.
+	for _, st := range indexedStatuses {
+		if st == s.WorstStatus {
+			continue
+		}
+		if _, err := conn.Do("ZREM", incidentsByWorstStatusKey(st), s.Id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// unindexIncident removes s from the secondary indexes.
+func unindexIncident(conn redis.Conn, s *models.IncidentState) error {
+	keys := []string{incidentsByStartKey, incidentsByAlertKey(s.AlertKey.Name())}
+	for k, v := range s.AlertKey.Group() {
+		keys = append(keys, incidentsByTagKey(k, v))
+	}
+	for _, st := range indexedStatuses {
+		keys = append(keys, incidentsByWorstStatusKey(st))
+	}
+	for _, a := range s.Actions {
+		if a.Type == models.ActionAcknowledge {
+			keys = append(keys, incidentsByAckUserKey(a.User))
+		}
+	}
+	for _, key := range keys {
+		if _, err := conn.Do("ZREM", key, s.Id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// IncidentQuery selects incidents for SearchIncidents. Zero fields match all
+// incidents.
+type IncidentQuery struct {
+	Alert string // name of the alert
+	// Tags must all be in the tags of the alert key.
+	Tags opentsdb.TagSet
+	// Start and End bound the start time of the incident. End is exclusive.
+	Start, End  time.Time
+	WorstStatus models.Status
+	AckUser     string // user that acknowledged the incident
+	// MinDuration and MaxDuration bound how long the incident was open,
+	// or has been open so far if it is still open.
+	MinDuration, MaxDuration time.Duration
+	// Match, if not nil, is applied to the incidents that match the other
+	// fields.
+	Match func(*models.IncidentState) (bool, error)
+}
+
+func (q *IncidentQuery) matches(s *models.IncidentState, now time.Time) (bool, error) {
+	end := now
+	if s.End != nil {
+		end = *s.End
+	}
+	d := end.Sub(s.Start)
+	if d < q.MinDuration || q.MaxDuration > 0 && d > q.MaxDuration {
+		return false, nil
+	}
+	if q.Match == nil {
+		return true, nil
+	}
+	return q.Match(s)
+}
+
+// searchBatchSize is the number of incidents fetched at a time while
+// matching them one by one.
+const searchBatchSize = 200
+
+// incidentSearchLifetime is how many seconds the intersection of indexes of
+// a search is kept if it is not removed.
+const incidentSearchLifetime = 60
+
+// SearchIncidents returns the incidents that match q, newest first, skipping
+// the first offset matches and returning at most limit. It also returns the
+// total number of matches. A limit of zero or less means no limit. Unless q
+// has a duration or Match, the page and total are read from the indexes, and
+// only the incidents of the page are fetched. Otherwise every incident in the
+// indexes within the time range is fetched and matched.
+func (d *dataAccess) SearchIncidents(q *IncidentQuery, offset, limit int) ([]*models.IncidentState, int, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	key, cleanup, err := d.incidentSearchIndex(conn, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cleanup()
+	min, max := interface{}("-inf"), interface{}("+inf")
+	if !q.Start.IsZero() {
+		min = q.Start.UTC().Unix()
+	}
+	if !q.End.IsZero() {
+		max = fmt.Sprintf("(%d", q.End.UTC().Unix())
+	}
+
+	results := []*models.IncidentState{}
+	if q.Match == nil && q.MinDuration == 0 && q.MaxDuration == 0 {
+		total, err := redis.Int(conn.Do("ZCOUNT", key, min, max))
+		if err != nil {
+			return nil, 0, slog.Wrap(err)
+		}
+		count := limit
+		if count <= 0 {
+			count = -1
+		}
+		ids, err := int64s(conn.Do("ZREVRANGEBYSCORE", key, max, min, "LIMIT", offset, count))
+		if err != nil {
+			return nil, 0, err
+		}
+		page, err := d.incidentMultiGet(conn, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		return append(results, page...), total, nil
+	}
+
+	now := time.Now().UTC()
+	total := 0
+	for i := 0; ; i += searchBatchSize {
+		ids, err := int64s(conn.Do("ZREVRANGEBYSCORE", key, max, min, "LIMIT", i, searchBatchSize))
+		if err != nil {
+			return nil, 0, err
+		}
+		batch, err := d.incidentMultiGet(conn, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, s := range batch {
+			match, err := q.matches(s, now)
+			if err != nil {
+				return nil, 0, err
+			}
+			if !match {
+				continue
+			}
+			if total >= offset && (limit <= 0 || len(results) < limit) {
+				results = append(results, s)
+			}
+			total++
+		}
+		if len(ids) < searchBatchSize {
+			break
+		}
+	}
+	return results, total, nil
+}
+
+// incidentSearchIndex returns the index of the incidents that match the
+// indexed fields of q. If several indexes apply, it is their intersection,
+// stored in a temporary key that the returned func removes.
+func (d *dataAccess) incidentSearchIndex(conn redis.Conn, q *IncidentQuery) (string, func(), error) {
+	var keys []string
+	if q.Alert != "" {
+		keys = append(keys, incidentsByAlertKey(q.Alert))
+	}
+	for k, v := range q.Tags {
+		keys = append(keys, incidentsByTagKey(k, v))
+	}
+	if q.WorstStatus != models.StNone {
+		keys = append(keys, incidentsByWorstStatusKey(q.WorstStatus))
+	}
+	if q.AckUser != "" {
+		keys = append(keys, incidentsByAckUserKey(q.AckUser))
+	}
+	switch len(keys) {
+	case 0:
+		return incidentsByStartKey, func() {}, nil
+	case 1:
+		return keys[0], func() {}, nil
+	}
+	n, err := redis.Int64(conn.Do("INCR", incidentSearchesKey))
+	if err != nil {
+		return "", nil, slog.Wrap(err)
+	}
+	key := incidentSearchKey(n)
+	args := []interface{}{key, len(keys)}
+	for _, k := range keys {
+		args = append(args, k)
+	}
+	// Every index scores an incident by its start, so keep that score.
+	args = append(args, "AGGREGATE", "MAX")
+	if _, err := conn.Do("ZINTERSTORE", args...); err != nil {
+		return "", nil, slog.Wrap(err)
+	}
+	cleanup := func() {
+		if _, err := conn.Do(d.ZCLEAR(), key); err != nil {
+			slog.Errorf("removing incident search %s: %v", key, err)
+		}
+	}
+	// Expire the intersection in case it is not removed.
+	if _, err := conn.Do(d.ZEXPIRE(), key, incidentSearchLifetime); err != nil {
+		cleanup()
+		return "", nil, slog.Wrap(err)
+	}
+	return key, cleanup, nil
+}
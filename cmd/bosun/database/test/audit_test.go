@@ -0,0 +1,59 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/models"
+)
+
+func TestAudit(t *testing.T) {
+	ad := testData.Audit()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	users := []string{"alice", "bob", "alice", "alice"}
+	var last int64
+	for i, u := range users {
+		e := &models.AuditEntry{Time: now.Add(time.Duration(i) * time.Minute), User: u, Route: "action"}
+		check(t, ad.AddAuditEntry(e, 0))
+		if e.Id == 0 {
+			t.Fatal("expected entry id to be set")
+		}
+		last = e.Id
+	}
+	entries, err := ad.GetAuditEntries(database.AuditQuery{User: "alice"})
+	check(t, err)
+	if len(entries) != 3 || !entries[0].Time.Equal(now.Add(3*time.Minute)) || entries[0].Id <= entries[1].Id {
+		t.Fatalf("bad entries of alice: %v", entries)
+	}
+	page, err := ad.GetAuditEntries(database.AuditQuery{User: "alice", Before: entries[0].Id, Limit: 1})
+	check(t, err)
+	if len(page) != 1 || page[0].Id != entries[1].Id {
+		t.Fatalf("bad page: %v", page)
+	}
+	entries, err = ad.GetAuditEntries(database.AuditQuery{Since: now.Add(time.Minute), Until: now.Add(2 * time.Minute)})
+	check(t, err)
+	if len(entries) != 2 || entries[0].User != "alice" || entries[1].User != "bob" {
+		t.Fatalf("bad entries in time range: %v", entries)
+	}
+
+	// Only the newest entries are kept, and their ids do not change.
+	for i := 0; i < 3; i++ {
+		e := &models.AuditEntry{Time: now.Add(time.Hour), User: "carol", Route: "action"}
+		check(t, ad.AddAuditEntry(e, 2))
+		if e.Id != last+int64(i)+1 {
+			t.Fatalf("got id %d, want %d", e.Id, last+int64(i)+1)
+		}
+	}
+	entries, err = ad.GetAuditEntries(database.AuditQuery{})
+	check(t, err)
+	if len(entries) != 2 || entries[0].Id != last+3 || entries[1].Id != last+2 || entries[1].User != "carol" {
+		t.Fatalf("bad entries after trimming: %v", entries)
+	}
+	page, err = ad.GetAuditEntries(database.AuditQuery{Before: last + 3})
+	check(t, err)
+	if len(page) != 1 || page[0].Id != last+2 {
+		t.Fatalf("bad page after trimming: %v", page)
+	}
+}
@@ -0,0 +1,43 @@
+package dbtest
+
+import (
+	"testing"
+
+	"github.com/leapar/bosun/models"
+)
+
+func TestDashboards(t *testing.T) {
+	dd := testData.Dashboards()
+	name := randString(8)
+	dash, err := dd.GetDashboard(name)
+	check(t, err)
+	if dash != nil {
+		t.Fatalf("expected no dashboard, got %+v", dash)
+	}
+	check(t, dd.PutDashboard(&models.Dashboard{Name: name, Title: "first"}))
+	check(t, dd.PutDashboard(&models.Dashboard{
+		Name:   name,
+		Title:  "second",
+		Panels: []*models.DashboardPanel{{Name: "cpu", Type: "graph", Expr: `q("avg:os.cpu", "1h", "")`}},
+	}))
+	dash, err = dd.GetDashboard(name)
+	check(t, err)
+	if dash == nil || dash.Title != "second" || len(dash.Panels) != 1 || dash.Panels[0].Name != "cpu" {
+		t.Fatalf("bad dashboard: %+v", dash)
+	}
+	all, err := dd.GetDashboards()
+	check(t, err)
+	found := false
+	for _, d := range all {
+		found = found || d.Name == name
+	}
+	if !found {
+		t.Errorf("dashboard %s missing from %v", name, all)
+	}
+	check(t, dd.DeleteDashboard(name))
+	dash, err = dd.GetDashboard(name)
+	check(t, err)
+	if dash != nil {
+		t.Errorf("expected dashboard to be deleted, got %+v", dash)
+	}
+}
@@ -0,0 +1,45 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/models"
+)
+
+func TestInbound(t *testing.T) {
+	id := testData.Inbound()
+
+	got, err := id.GetExternalIncident("pd", "P1")
+	check(t, err)
+	if got != 0 {
+		t.Fatalf("expected no incident, got %d", got)
+	}
+	check(t, id.SetExternalIncident("pd", "P1", 42))
+	check(t, id.SetExternalIncident("og", "abc", 42))
+	got, err = id.GetExternalIncident("pd", "P1")
+	check(t, err)
+	if got != 42 {
+		t.Fatalf("expected incident 42, got %d", got)
+	}
+	ids, err := id.GetExternalIds(42)
+	check(t, err)
+	if len(ids) != 2 || ids["pd"] != "P1" || ids["og"] != "abc" {
+		t.Fatalf("bad external ids: %v", ids)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, p := range []string{"pd", "og", "pd"} {
+		check(t, id.AddInboundAudit(&models.InboundAudit{Time: now.Add(time.Duration(i) * time.Second), Provider: p}))
+	}
+	audits, err := id.GetInboundAudit("pd", 10)
+	check(t, err)
+	if len(audits) != 2 || !audits[0].Time.Equal(now.Add(2*time.Second)) {
+		t.Fatalf("bad audit: %v", audits)
+	}
+	audits, err = id.GetInboundAudit("", 1)
+	check(t, err)
+	if len(audits) != 1 || audits[0].Provider != "pd" {
+		t.Fatalf("bad audit: %v", audits)
+	}
+}
@@ -0,0 +1,55 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/models"
+)
+
+func TestInventory(t *testing.T) {
+	id := testData.Inventory()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	inv, err := id.GetInventory("inv01")
+	check(t, err)
+	if inv != nil {
+		t.Fatalf("expected no inventory, got %v", inv)
+	}
+	first := &models.Inventory{Host: "inv01", Time: now.Add(-time.Hour), Fields: map[string]string{"os.version": "1", "disk.sda.size": "100"}}
+	check(t, id.SetInventory(first, nil))
+	second := &models.Inventory{Host: "inv01", Time: now, Fields: map[string]string{"os.version": "2", "disk.sdb.size": "200"}}
+	changes := second.Diff(first)
+	if len(changes) != 3 || changes[0].Field != "disk.sda.size" || changes[0].New != "" || changes[2].Old != "1" {
+		t.Fatalf("bad diff: %v", changes)
+	}
+	check(t, id.SetInventory(second, changes))
+
+	inv, err = id.GetInventory("inv01")
+	check(t, err)
+	if inv == nil || inv.Fields["os.version"] != "2" {
+		t.Fatalf("bad inventory: %v", inv)
+	}
+	history, err := id.GetInventoryHistory("inv01", 2)
+	check(t, err)
+	if len(history) != 2 {
+		t.Fatalf("bad history: %v", history)
+	}
+	all, err := id.GetInventoryChanges(now.Add(-time.Minute))
+	check(t, err)
+	found := 0
+	for _, c := range all {
+		if c.Host == "inv01" {
+			found++
+		}
+	}
+	if found != 3 {
+		t.Fatalf("expected 3 changes of inv01, got %v", all)
+	}
+	check(t, id.ClearInventoryChangesBefore(now.Add(time.Minute)))
+	history, err = id.GetInventoryHistory("inv01", 0)
+	check(t, err)
+	if len(history) != 0 {
+		t.Fatalf("expected changes to be cleared, got %v", history)
+	}
+}
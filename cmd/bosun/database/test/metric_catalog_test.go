@@ -0,0 +1,49 @@
+package dbtest
+
+import (
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+)
+
+func TestMetricCatalog(t *testing.T) {
+	md := testData.Metadata()
+	metric := randString(10)
+	c, err := md.GetMetricCatalog(metric)
+	check(t, err)
+	if c != nil {
+		t.Fatalf("expected no entry, got %+v", c)
+	}
+	check(t, md.PutMetricCatalog(&database.MetricCatalog{Metric: metric, Owner: "ops"}))
+	check(t, md.PutMetricCatalog(&database.MetricCatalog{Metric: metric, Owner: "dba", TagKeys: []string{"host"}}))
+
+	c, err = md.GetMetricCatalog(metric)
+	check(t, err)
+	if c == nil || c.Owner != "dba" || len(c.TagKeys) != 1 {
+		t.Fatalf("bad entry: %+v", c)
+	}
+	all, err := md.GetMetricCatalogs()
+	check(t, err)
+	if all[metric] == nil || all[metric].Owner != "dba" {
+		t.Errorf("entry missing from all entries: %+v", all)
+	}
+	history, err := md.GetMetricCatalogHistory(metric, 10)
+	check(t, err)
+	if len(history) != 2 || history[0].Owner != "dba" || history[1].Owner != "ops" {
+		t.Errorf("bad history: %+v", history)
+	}
+	history, err = md.GetMetricCatalogHistory(metric, 1)
+	check(t, err)
+	if len(history) != 1 {
+		t.Errorf("expected history limited to 1, got %d", len(history))
+	}
+	// Only the last 100 versions are kept.
+	for i := 0; i < 100; i++ {
+		check(t, md.PutMetricCatalog(&database.MetricCatalog{Metric: metric, Owner: "dba"}))
+	}
+	history, err = md.GetMetricCatalogHistory(metric, 1000)
+	check(t, err)
+	if len(history) != 100 || history[99].Owner != "dba" {
+		t.Errorf("expected the history to be trimmed to the last 100 versions, got %d", len(history))
+	}
+}
@@ -0,0 +1,69 @@
+package dbtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+)
+
+func TestSearchPruneExportImport(t *testing.T) {
+	sd := testData.Search()
+	uid := randString(5)
+	index := func(metric, host string, time int64) {
+		check(t, sd.AddMetric(metric, uid, time))
+		check(t, sd.AddTagKeyForMetric(metric, "host", uid, time))
+		check(t, sd.AddTagValue(metric, "host", host, uid, time))
+		check(t, sd.AddTagValue(database.Search_All, "host", host, uid, time))
+		check(t, sd.AddMetricForTag("host", host, metric, uid, time))
+		check(t, sd.AddMetricTagSet(metric, "host="+host, uid, time))
+	}
+	index("os.cpu", "web01", 100)
+	index("os.cpu", "web02", 200)
+	index("os.mem", "web01", 100)
+
+	exported, err := sd.ExportSearch(uid)
+	check(t, err)
+	if exported.Metrics["os.mem"] != 100 || exported.TagValues[database.Search_All]["host"]["web02"] != 200 || exported.TagSets["os.cpu"]["host=web01"] != 100 {
+		t.Fatalf("bad export: %+v", exported)
+	}
+
+	n, err := sd.PruneMetric("os.cpu", uid, 150)
+	check(t, err)
+	if n != 2 {
+		t.Errorf("expected 2 os.cpu entries pruned, got %d", n)
+	}
+	n, err = sd.PruneMetric("os.mem", uid, 150)
+	check(t, err)
+	if n != 4 {
+		t.Errorf("expected 4 os.mem entries pruned, got %d", n)
+	}
+	n, err = sd.PruneTagValues(database.Search_All, "host", uid, 150)
+	check(t, err)
+	if n != 1 {
+		t.Errorf("expected 1 value pruned, got %d", n)
+	}
+	metrics, err := sd.GetAllMetrics(uid)
+	check(t, err)
+	if !reflect.DeepEqual(metrics, map[string]int64{"os.cpu": 200}) {
+		t.Errorf("bad metrics after prune: %v", metrics)
+	}
+	forTag, err := sd.GetMetricsForTag("host", "web01", uid)
+	check(t, err)
+	if len(forTag) != 0 {
+		t.Errorf("expected no metrics for web01, got %v", forTag)
+	}
+
+	other := randString(5)
+	check(t, sd.ImportSearch(other, exported))
+	imported, err := sd.ExportSearch(other)
+	check(t, err)
+	if !reflect.DeepEqual(imported, exported) {
+		t.Errorf("import differs from export: %+v != %+v", imported, exported)
+	}
+	forTag, err = sd.GetMetricsForTag("host", "web01", other)
+	check(t, err)
+	if !reflect.DeepEqual(forTag, map[string]int64{"os.cpu": 100, "os.mem": 100}) {
+		t.Errorf("bad metrics for web01 after import: %v", forTag)
+	}
+}
@@ -0,0 +1,120 @@
+package dbtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestSearchIncidents(t *testing.T) {
+	sd := testData.State()
+	alert := "search" + randString(5)
+	now := time.Now().UTC().Truncate(time.Second)
+	end := now.Add(-time.Hour)
+	incidents := []*models.IncidentState{
+		{
+			AlertKey:    models.NewAlertKey(alert, opentsdb.TagSet{"host": "a", "dc": "ny"}),
+			Start:       now.Add(-3 * time.Hour),
+			End:         &end,
+			WorstStatus: models.StCritical,
+			Actions:     []models.Action{{User: "alice", Type: models.ActionAcknowledge}},
+		},
+		{
+			AlertKey:    models.NewAlertKey(alert, opentsdb.TagSet{"host": "b", "dc": "ny"}),
+			Start:       now.Add(-2 * time.Hour),
+			WorstStatus: models.StWarning,
+			Open:        true,
+		},
+		{
+			AlertKey:    models.NewAlertKey(alert, opentsdb.TagSet{"host": "a", "dc": "ny"}),
+			Start:       now.Add(-time.Hour),
+			WorstStatus: models.StWarning,
+			Open:        true,
+		},
+	}
+	for _, s := range incidents {
+		s.Alert = s.AlertKey.Name()
+		_, err := sd.UpdateIncidentState(s)
+		check(t, err)
+	}
+	// Worsen the last incident, which must move it between status indexes.
+	incidents[2].WorstStatus = models.StCritical
+	_, err := sd.UpdateIncidentState(incidents[2])
+	check(t, err)
+
+	tests := []struct {
+		name string
+		q    database.IncidentQuery
+		want []int // indexes into incidents, newest first
+	}{
+		{"alert", database.IncidentQuery{}, []int{2, 1, 0}},
+		{"tags", database.IncidentQuery{Tags: opentsdb.TagSet{"host": "a"}}, []int{2, 0}},
+		{"status", database.IncidentQuery{WorstStatus: models.StCritical}, []int{2, 0}},
+		{"warning", database.IncidentQuery{WorstStatus: models.StWarning}, []int{1}},
+		{"ack user", database.IncidentQuery{AckUser: "alice"}, []int{0}},
+		{"time", database.IncidentQuery{Start: now.Add(-150 * time.Minute), End: now.Add(-time.Hour)}, []int{1}},
+		{"min duration", database.IncidentQuery{MinDuration: 90 * time.Minute}, []int{1, 0}},
+		{"max duration", database.IncidentQuery{MaxDuration: 90 * time.Minute}, []int{2}},
+		{"match", database.IncidentQuery{Match: func(s *models.IncidentState) (bool, error) {
+			return s.Open, nil
+		}}, []int{2, 1}},
+	}
+	for _, test := range tests {
+		test.q.Alert = alert
+		got, total, err := sd.SearchIncidents(&test.q, 0, 0)
+		check(t, err)
+		if total != len(test.want) || len(got) != len(test.want) {
+			t.Errorf("%s: got %d incidents (total %d), want %d", test.name, len(got), total, len(test.want))
+			continue
+		}
+		for i, w := range test.want {
+			if got[i].Id != incidents[w].Id {
+				t.Errorf("%s: result %d is incident %d, want %d", test.name, i, got[i].Id, incidents[w].Id)
+			}
+		}
+	}
+
+	// pagination
+	got, total, err := sd.SearchIncidents(&database.IncidentQuery{Alert: alert}, 1, 1)
+	check(t, err)
+	if total != 3 || len(got) != 1 || got[0].Id != incidents[1].Id {
+		t.Errorf("bad page: total %d, %d incidents", total, len(got))
+	}
+
+	// pagination over several indexes, which leaves no intersection behind
+	got, total, err = sd.SearchIncidents(&database.IncidentQuery{Alert: alert, Tags: opentsdb.TagSet{"dc": "ny"}}, 1, 1)
+	check(t, err)
+	if total != 3 || len(got) != 1 || got[0].Id != incidents[1].Id {
+		t.Errorf("bad page of several indexes: total %d, %d incidents", total, len(got))
+	}
+	conn := testData.(database.RedisConnector).Get()
+	defer conn.Close()
+	n, err := redis.Int64(conn.Do("GET", "incidentSearches"))
+	check(t, err)
+	if size, err := redis.Int(conn.Do("ZCARD", fmt.Sprintf("incidentSearch:%d", n))); err != nil || size != 0 {
+		t.Errorf("intersection of the search was kept: %d, %v", size, err)
+	}
+
+	// pagination of matched incidents
+	open := func(s *models.IncidentState) (bool, error) {
+		return s.Open, nil
+	}
+	got, total, err = sd.SearchIncidents(&database.IncidentQuery{Alert: alert, Match: open}, 1, 1)
+	check(t, err)
+	if total != 2 || len(got) != 1 || got[0].Id != incidents[1].Id {
+		t.Errorf("bad page of matches: total %d, %d incidents", total, len(got))
+	}
+
+	// forgotten incidents are removed from the indexes
+	check(t, sd.Forget(incidents[0].AlertKey))
+	got, total, err = sd.SearchIncidents(&database.IncidentQuery{AckUser: "alice", Alert: alert}, 0, 0)
+	check(t, err)
+	if total != 0 {
+		t.Errorf("forgotten incident still found: %v", got)
+	}
+}
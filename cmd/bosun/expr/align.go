@@ -0,0 +1,122 @@
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/leapar/bosun/cmd/bosun/expr/parse"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func retagCheck(t *parse.Tree, f *parse.FuncNode) error {
+	if n, ok := f.Args[2].(*parse.StringNode); ok {
+		if _, err := regexp.Compile(n.Text); err != nil {
+			return fmt.Errorf("expr: retag: %v", err)
+		}
+	}
+	return nil
+}
+
+func Retag(e *State, T miniprofiler.Timer, set *Results, tagKey, pattern, replacement string) (*Results, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, r := range set.Results {
+		if v, ok := r.Group[tagKey]; ok {
+			r.Group[tagKey] = re.ReplaceAllString(v, replacement)
+		}
+		if seen[r.Group.String()] {
+			return set, fmt.Errorf("duplicate group would result from retagging %v: %v", tagKey, r.Group)
+		}
+		seen[r.Group.String()] = true
+	}
+	return set, nil
+}
+
+var resampleAggregators = map[string]func(Series, ...float64) float64{
+	"avg":    avg,
+	"sum":    sum,
+	"count":  length,
+	"dev":    dev,
+	"first":  first,
+	"last":   last,
+	"min":    func(dps Series, args ...float64) float64 { return percentile(dps, 0) },
+	"median": func(dps Series, args ...float64) float64 { return percentile(dps, .5) },
+	"max":    func(dps Series, args ...float64) float64 { return percentile(dps, 1) },
+}
+
+func resampleCheck(t *parse.Tree, f *parse.FuncNode) error {
+	if n, ok := f.Args[1].(*parse.StringNode); ok {
+		if _, err := opentsdb.ParseDuration(n.Text); err != nil {
+			return fmt.Errorf("expr: resample: %v", err)
+		}
+	}
+	if n, ok := f.Args[2].(*parse.StringNode); ok {
+		if _, ok := resampleAggregators[n.Text]; !ok {
+			return fmt.Errorf("expr: resample: unknown aggregator %v", n.Text)
+		}
+	}
+	return nil
+}
+
+func Resample(e *State, T miniprofiler.Timer, series *Results, step, aggregator string) (*Results, error) {
+	d, err := opentsdb.ParseDuration(step)
+	if err != nil {
+		return nil, err
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("resample: step must be positive")
+	}
+	agg, ok := resampleAggregators[aggregator]
+	if !ok {
+		return nil, fmt.Errorf("resample: unknown aggregator %v", aggregator)
+	}
+	for _, res := range series.Results {
+		buckets := make(map[int64]Series)
+		for t, v := range res.Value.Value().(Series) {
+			b := t.UnixNano() - t.UnixNano()%int64(d)
+			if buckets[b] == nil {
+				buckets[b] = make(Series)
+			}
+			buckets[b][t] = v
+		}
+		resampled := make(Series, len(buckets))
+		for b, dps := range buckets {
+			resampled[time.Unix(0, b).UTC()] = agg(dps)
+		}
+		res.Value = resampled
+	}
+	return series, nil
+}
+
+func Align(e *State, T miniprofiler.Timer, a *Results, b *Results) (*Results, error) {
+	res := &Results{}
+	for _, u := range e.union(a, b, "align union") {
+		aSeries, aOk := u.A.(Series)
+		bSeries, bOk := u.B.(Series)
+		if !aOk || !bOk {
+			// unjoined group
+			continue
+		}
+		sa := NewSortedSeries(aSeries)
+		aligned := make(Series)
+		for _, p := range NewSortedSeries(bSeries) {
+			i := sort.Search(len(sa), func(i int) bool { return sa[i].T.After(p.T) })
+			if i == 0 {
+				continue
+			}
+			aligned[p.T] = sa[i-1].V
+		}
+		res.Results = append(res.Results, &Result{
+			Group:        u.Group,
+			Value:        aligned,
+			Computations: u.Computations,
+		})
+	}
+	return res, nil
+}
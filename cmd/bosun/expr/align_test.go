@@ -0,0 +1,131 @@
+package expr
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestMisalignedSeriesOperations(t *testing.T) {
+	// Datapoints every 60s, one set offset by 15s from the other, as
+	// happens when joining series from different backends.
+	seriesA := `series("host=a", 0, 1, 60, 2, 120, 3)`
+	seriesB := `series("host=a", 15, 10, 75, 20, 135, 30)`
+	seriesC := `series("host=a", 0, 10, 75, 20, 120, 30, 180, 40)`
+	tests := []exprInOut{
+		{
+			// Operators only join datapoints with equal timestamps.
+			fmt.Sprintf("%v + %v", seriesA, seriesB),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf("%v + %v", seriesA, seriesC),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   11,
+							time.Unix(120, 0): 33,
+						},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf("align(%v, %v) + %v", seriesA, seriesB, seriesB),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(15, 0):  11,
+							time.Unix(75, 0):  22,
+							time.Unix(135, 0): 33,
+						},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+	}
+	for _, test := range tests {
+		if err := testExpression(test); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestJoinFunctions(t *testing.T) {
+	tests := []exprInOut{
+		{
+			`retag(series("host=ny-web01.example.com", 0, 1), "host", "^([^.]+)[.].*$", "$1")`,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{time.Unix(0, 0): 1},
+						Group: opentsdb.TagSet{"host": "ny-web01"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			`retag(series("host=a", 0, 1), "host", "(", "")`,
+			Results{},
+			true,
+		},
+		{
+			`resample(series("host=a", 0, 1, 30, 3, 60, 5, 170, 7), "1m", "avg")`,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0).UTC():   2,
+							time.Unix(60, 0).UTC():  5,
+							time.Unix(120, 0).UTC(): 7,
+						},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			`resample(series("host=a", 0, 1), "1m", "mode")`,
+			Results{},
+			true,
+		},
+		{
+			`align(series("host=a", 0, 1, 60, 2), series("host=a", -30, 0, 30, 0, 90, 0))`,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(30, 0): 1,
+							time.Unix(90, 0): 2,
+						},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+	}
+	for _, test := range tests {
+		if err := testExpression(test); err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+		}
+	}
+}
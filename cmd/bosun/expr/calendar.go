@@ -0,0 +1,188 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/leapar/bosun/cmd/bosun/expr/parse"
+)
+
+// TimeWindow is a recurring window of time defined by a set of weekdays
+// and an optional time of day range, for example "Mon-Fri 09:00-18:00".
+// When the time of day range ends before it starts (i.e. "22:00-06:00")
+// the window spans midnight and belongs to the day on which it starts.
+type TimeWindow struct {
+	Text  string
+	Days  [7]bool
+	Start time.Duration // offset from midnight
+	End   time.Duration // offset from midnight, equal to Start for the whole day
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseTimeWindow parses a window specification of the form
+// "<days> [<HH:MM>-<HH:MM>]". Days is "*" or a comma separated list of
+// days or day ranges such as "Mon-Fri" or "Sat,Sun". The days may be
+// omitted when a time of day range is given, in which case every day
+// is included.
+func ParseTimeWindow(s string) (*TimeWindow, error) {
+	w := &TimeWindow{Text: s}
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid time window %q: expected \"<days> [<HH:MM>-<HH:MM>]\"", s)
+	}
+	days, times := fields[0], ""
+	if len(fields) == 2 {
+		times = fields[1]
+	} else if strings.Contains(days, ":") {
+		days, times = "*", days
+	}
+	if err := w.parseDays(days); err != nil {
+		return nil, fmt.Errorf("invalid time window %q: %v", s, err)
+	}
+	if times != "" {
+		if err := w.parseTimes(times); err != nil {
+			return nil, fmt.Errorf("invalid time window %q: %v", s, err)
+		}
+	}
+	return w, nil
+}
+
+func (w *TimeWindow) parseDays(s string) error {
+	if s == "*" {
+		for i := range w.Days {
+			w.Days[i] = true
+		}
+		return nil
+	}
+	for _, section := range strings.Split(s, ",") {
+		r := strings.Split(section, "-")
+		if len(r) > 2 {
+			return fmt.Errorf("bad day range %q", section)
+		}
+		start, ok := weekdays[strings.ToLower(r[0])]
+		if !ok {
+			return fmt.Errorf("unknown day %q", r[0])
+		}
+		end := start
+		if len(r) == 2 {
+			if end, ok = weekdays[strings.ToLower(r[1])]; !ok {
+				return fmt.Errorf("unknown day %q", r[1])
+			}
+		}
+		for d := start; ; d = (d + 1) % 7 {
+			w.Days[d] = true
+			if d == end {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (w *TimeWindow) parseTimes(s string) error {
+	r := strings.Split(s, "-")
+	if len(r) != 2 {
+		return fmt.Errorf("bad time range %q", s)
+	}
+	var err error
+	if w.Start, err = parseTimeOfDay(r[0]); err != nil {
+		return err
+	}
+	if w.End, err = parseTimeOfDay(r[1]); err != nil {
+		return err
+	}
+	return nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("bad time of day %q, expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains reports whether t falls within the window. t is evaluated in
+// its own location, so callers should convert it to the desired time zone
+// first. Times of day are wall clock times, so on days with a daylight
+// saving change 09:00 is still 09:00.
+func (w *TimeWindow) Contains(t time.Time) bool {
+	offset := time.Duration(t.Hour()*60+t.Minute()) * time.Minute
+	switch {
+	case w.Start == w.End:
+		return w.Days[t.Weekday()]
+	case w.Start < w.End:
+		return w.Days[t.Weekday()] && offset >= w.Start && offset < w.End
+	default:
+		if offset >= w.Start {
+			return w.Days[t.Weekday()]
+		}
+		return offset < w.End && w.Days[(t.Weekday()+6)%7]
+	}
+}
+
+// LoadLocation is like time.LoadLocation, except that an empty name is UTC
+// and the server's local time zone can not be selected.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if name == "Local" {
+		return nil, fmt.Errorf("time zone must be explicit, got %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+func inWindowCheck(t *parse.Tree, f *parse.FuncNode) error {
+	if n, ok := f.Args[0].(*parse.StringNode); ok {
+		if _, err := ParseTimeWindow(n.Text); err != nil {
+			return fmt.Errorf("expr: inWindow: %v", err)
+		}
+	}
+	if n, ok := f.Args[1].(*parse.StringNode); ok {
+		if _, err := LoadLocation(n.Text); err != nil {
+			return fmt.Errorf("expr: inWindow: %v", err)
+		}
+	}
+	return nil
+}
+
+// InWindow returns 1 if the time the expression is evaluated at falls in
+// window in time zone tz, and 0 otherwise.
+func InWindow(e *State, T miniprofiler.Timer, window, tz string) (*Results, error) {
+	w, err := ParseTimeWindow(window)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := LoadLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	return boolScalar(w.Contains(e.now.In(loc))), nil
+}
+
+// Now returns the time the expression is being evaluated at.
+func (e *State) Now() time.Time {
+	return e.now
+}
+
+func boolScalar(b bool) *Results {
+	if b {
+		return wrap(1)
+	}
+	return wrap(0)
+}
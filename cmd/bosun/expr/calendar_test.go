@@ -0,0 +1,130 @@
+package expr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeWindowContains(t *testing.T) {
+	// 2016-06-06 is a Monday.
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2016, 6, 6+day, hour, min, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		window string
+		t      time.Time
+		in     bool
+	}{
+		{"Mon-Fri 09:00-18:00", at(0, 9, 0), true},
+		{"Mon-Fri 09:00-18:00", at(0, 8, 59), false},
+		{"Mon-Fri 09:00-18:00", at(4, 17, 59), true},
+		{"Mon-Fri 09:00-18:00", at(4, 18, 0), false},
+		{"Mon-Fri 09:00-18:00", at(5, 12, 0), false},
+		{"Sat,Sun", at(6, 23, 59), true},
+		{"Sat,Sun", at(0, 0, 0), false},
+		{"Fri-Mon", at(0, 12, 0), true},
+		{"Fri-Mon", at(2, 12, 0), false},
+		{"*", at(3, 3, 0), true},
+		{"12:00-13:00", at(3, 12, 30), true},
+		{"12:00-13:00", at(3, 13, 30), false},
+		{"Mon 22:00-06:00", at(0, 23, 0), true},
+		{"Mon 22:00-06:00", at(1, 5, 59), true},
+		{"Mon 22:00-06:00", at(0, 5, 0), false},
+		{"Mon 22:00-06:00", at(1, 23, 0), false},
+		{"Mon 00:00-24:00", at(0, 23, 59), true},
+	}
+	for _, test := range tests {
+		w, err := ParseTimeWindow(test.window)
+		if err != nil {
+			t.Errorf("%s: %v", test.window, err)
+			continue
+		}
+		if in := w.Contains(test.t); in != test.in {
+			t.Errorf("%s: %v: got %v, expected %v", test.window, test.t, in, test.in)
+		}
+	}
+}
+
+func TestTimeWindowContainsDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	w, err := ParseTimeWindow("Sun 09:00-10:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Clocks go forward on 2016-03-13 and back on 2016-11-06, both Sundays.
+	for _, day := range []time.Time{
+		time.Date(2016, 3, 13, 0, 0, 0, 0, ny),
+		time.Date(2016, 11, 6, 0, 0, 0, 0, ny),
+	} {
+		for _, test := range []struct {
+			hour, min int
+			in        bool
+		}{
+			{8, 59, false},
+			{9, 0, true},
+			{9, 59, true},
+			{10, 0, false},
+		} {
+			at := time.Date(day.Year(), day.Month(), day.Day(), test.hour, test.min, 0, 0, ny)
+			if in := w.Contains(at); in != test.in {
+				t.Errorf("%v: got %v, expected %v", at, in, test.in)
+			}
+		}
+	}
+}
+
+func TestParseTimeWindowInvalid(t *testing.T) {
+	for _, window := range []string{
+		"",
+		"Mon-Fri 09:00-18:00 extra",
+		"Monday",
+		"Mon-Fri-Sat",
+		"Mon 9-18",
+		"Mon 09:00",
+		"Mon 25:00-26:00",
+	} {
+		if _, err := ParseTimeWindow(window); err == nil {
+			t.Errorf("%q: expected error", window)
+		}
+	}
+}
+
+func TestInWindow(t *testing.T) {
+	// queryTime is Saturday 2000-01-01 12:00 UTC.
+	tests := []struct {
+		expr string
+		v    float64
+	}{
+		{`inWindow("Sat 11:00-13:00", "")`, 1},
+		{`inWindow("Mon-Fri", "UTC")`, 0},
+		{`inWindow("Sat 06:00-08:00", "America/New_York")`, 1},
+		{`inWindow("Sat 11:00-13:00", "Asia/Tokyo")`, 0},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{
+			test.expr,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Scalar(test.v),
+					},
+				},
+			},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%s: %v", test.expr, err)
+		}
+	}
+	for _, bad := range []string{
+		`inWindow("Someday", "UTC")`,
+		`inWindow("Mon", "Nowhere/Special")`,
+	} {
+		if err := testExpression(exprInOut{bad, Results{}, true}); err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -64,6 +64,10 @@ type BosunProviders struct {
 	History   AlertStatusProvider
 	Cache     *cache.Cache
 	Annotate  backend.Backend
+	// QueryCache, if set, shares backend query results with other
+	// executions.
+	QueryCache *QueryCache
+	Inventory  InventoryProvider
 }
 
 // Alert Status Provider is used to provide information about alert results.
@@ -72,6 +76,12 @@ type AlertStatusProvider interface {
 	GetUnknownAndUnevaluatedAlertKeys(alertName string) (unknown, unevaluated []models.AlertKey)
 }
 
+// InventoryProvider is used to provide the changes of the inventory of hosts.
+type InventoryProvider interface {
+	GetInventoryHosts() ([]string, error)
+	GetInventoryChanges(since time.Time) ([]*models.InventoryChange, error)
+}
+
 var ErrUnknownOp = fmt.Errorf("expr: unknown op type")
 
 type Expr struct {
@@ -3,6 +3,7 @@ package expr
 import (
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/leapar/bosun/cmd/bosun/expr/parse"
 	"github.com/leapar/bosun/models"
 	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/util"
 )
 
 func tagQuery(args []parse.Node) (parse.Tags, error) {
@@ -100,6 +102,41 @@ func tagRename(args []parse.Node) (parse.Tags, error) {
 	return tags, nil
 }
 
+func tagHost(args []parse.Node) (parse.Tags, error) {
+	return parse.Tags{"host": struct{}{}}, nil
+}
+
+func tagLastSeen(args []parse.Node) (parse.Tags, error) {
+	ts, err := parseTagGlobs(args[1].(*parse.StringNode).Text)
+	if err != nil {
+		return nil, err
+	}
+	t := make(parse.Tags)
+	for k := range ts {
+		t[k] = struct{}{}
+	}
+	return t, nil
+}
+
+// parseTagGlobs parses tags whose values may be glob patterns, which
+// opentsdb.ParseTags reports as invalid characters.
+func parseTagGlobs(text string) (opentsdb.TagSet, error) {
+	if text == "" {
+		return opentsdb.TagSet{}, nil
+	}
+	ts, err := opentsdb.ParseTags(text)
+	if ts == nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// Builtins returns the functions that are available in all expressions,
+// whichever backends are enabled. The map must not be modified.
+func Builtins() map[string]parse.Func {
+	return builtins
+}
+
 var builtins = map[string]parse.Func{
 	// Reduction functions
 
@@ -231,6 +268,40 @@ var builtins = map[string]parse.Func{
 		F:      Ungroup,
 	},
 
+	// Join functions, for combining series from different backends. Backends
+	// rarely agree on tag values or the timestamps of their datapoints, so
+	// these normalize the groups and times of series.
+
+	"retag": {
+		Args:          []models.FuncType{models.TypeVariantSet, models.TypeString, models.TypeString, models.TypeString},
+		VariantReturn: true,
+		Tags:          tagFirst,
+		F:             Retag,
+		Check:         retagCheck,
+		Doc: "retag(set, tagKey, regexp, replacement) rewrites the value of tagKey in each group " +
+			"by replacing matches of regexp with replacement ($1 expands to the first submatch). " +
+			"Groups without tagKey are left unchanged. Use rename() to change tag keys.",
+	},
+	"resample": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Resample,
+		Check:  resampleCheck,
+		Doc: "resample(series, step, aggregator) buckets each series into intervals of the duration step, " +
+			"aligned to the unix epoch, and reduces each bucket with aggregator " +
+			"(avg, sum, min, max, median, first, last, count or dev). Datapoints are stamped with the start of their bucket.",
+	},
+	"align": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeSeriesSet},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Align,
+		Doc: "align(a, b) returns the series of a sampled at the timestamps of the series of b it joins with, " +
+			"carrying the last value of a forward. Timestamps of b before the first datapoint of a are dropped. " +
+			"Groups join when one is a subset of the other, as with operators.",
+	},
+
 	// Other functions
 
 	"abs": {
@@ -302,6 +373,27 @@ var builtins = map[string]parse.Func{
 		Return: models.TypeScalar,
 		F:      Epoch,
 	},
+	"inventoryChanges": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeNumberSet,
+		Tags:   tagHost,
+		F:      InventoryChanges,
+		Doc:    `inventoryChanges(hosts, duration, fields) returns the number of changes of the inventory of each host matching the glob hosts in the last duration, counting only fields matching the regular expression fields if it is not empty.`,
+	},
+	"inWindow": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString},
+		Return: models.TypeScalar,
+		F:      InWindow,
+		Check:  inWindowCheck,
+		Doc:    `inWindow(window, timezone) returns 1 if now is within window (i.e. "Mon-Fri 09:00-18:00") in timezone, otherwise 0.`,
+	},
+	"lastSeen": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeNumberSet,
+		Tags:   tagLastSeen,
+		F:      LastSeen,
+		Doc:    `lastSeen(metric, tags, forget) returns the number of seconds since the last datapoint of metric for each group of tags, whose values may be globs. Groups not seen for longer than the duration forget are left out if it is not empty.`,
+	},
 	"filter": {
 		Args:          []models.FuncType{models.TypeVariantSet, models.TypeNumberSet},
 		VariantReturn: true,
@@ -501,6 +593,86 @@ func DropBool(e *State, T miniprofiler.Timer, target *Results, filter *Results)
 	return &res, nil
 }
 
+// InventoryChanges counts the changes of the inventory of hosts matching the
+// glob hosts since duration ago. Hosts without changes are 0.
+func InventoryChanges(e *State, T miniprofiler.Timer, hosts, duration, fields string) (*Results, error) {
+	if e.Inventory == nil {
+		return nil, fmt.Errorf("inventoryChanges: host inventory is not available")
+	}
+	d, err := opentsdb.ParseDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+	var re *regexp.Regexp
+	if fields != "" {
+		if re, err = regexp.Compile(fields); err != nil {
+			return nil, err
+		}
+	}
+	all, err := e.Inventory.GetInventoryHosts()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, h := range all {
+		if m, _ := util.Match(hosts, h); m {
+			counts[h] = 0
+		}
+	}
+	changes, err := e.Inventory.GetInventoryChanges(e.now.Add(-time.Duration(d)))
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		if _, ok := counts[c.Host]; !ok || c.Time.After(e.now) {
+			continue
+		}
+		if re == nil || re.MatchString(c.Field) {
+			counts[c.Host]++
+		}
+	}
+	r := new(Results)
+	for h, n := range counts {
+		r.Results = append(r.Results, &Result{
+			Value: Number(n),
+			Group: opentsdb.TagSet{"host": h},
+		})
+	}
+	return r, nil
+}
+
+// LastSeen returns the seconds since the last indexed datapoint of metric
+// for each group of tags. Groups last seen more than forget ago are omitted.
+func LastSeen(e *State, T miniprofiler.Timer, metric, tags, forget string) (*Results, error) {
+	if e.Search == nil {
+		return nil, fmt.Errorf("lastSeen: search is not available")
+	}
+	ts, err := parseTagGlobs(tags)
+	if err != nil {
+		return nil, err
+	}
+	var max int64
+	if forget != "" {
+		d, err := opentsdb.ParseDuration(forget)
+		if err != nil {
+			return nil, err
+		}
+		max = int64(time.Duration(d).Seconds())
+	}
+	r := new(Results)
+	for _, seen := range e.Search.LastSeen(metric, ts) {
+		age := e.now.Unix() - seen.Timestamp
+		if max > 0 && age > max {
+			continue
+		}
+		r.Results = append(r.Results, &Result{
+			Value: Number(age),
+			Group: seen.Group,
+		})
+	}
+	return r, nil
+}
+
 func Epoch(e *State, T miniprofiler.Timer) (*Results, error) {
 	return &Results{
 		Results: []*Result{
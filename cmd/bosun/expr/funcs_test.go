@@ -2,9 +2,12 @@ package expr
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/leapar/bosun/cmd/bosun/search"
+	"github.com/leapar/bosun/models"
 	"github.com/leapar/bosun/opentsdb"
 
 	"github.com/influxdata/influxdb/client/v2"
@@ -257,3 +260,90 @@ func TestTail(t *testing.T) {
 		}
 	}
 }
+
+type testInventory []*models.InventoryChange
+
+func (ti testInventory) GetInventoryHosts() ([]string, error) {
+	return []string{"web01", "web02", "db01"}, nil
+}
+
+func (ti testInventory) GetInventoryChanges(since time.Time) ([]*models.InventoryChange, error) {
+	var changes []*models.InventoryChange
+	for _, c := range ti {
+		if !c.Time.Before(since) {
+			changes = append(changes, c)
+		}
+	}
+	return changes, nil
+}
+
+func TestInventoryChanges(t *testing.T) {
+	inv := testInventory{
+		{Host: "web01", Time: queryTime.Add(-time.Hour), Field: "os.version"},
+		{Host: "web01", Time: queryTime.Add(-time.Minute), Field: "disk.sdb.size"},
+		{Host: "db01", Time: queryTime.Add(-time.Minute), Field: "os.version"},
+		{Host: "web02", Time: queryTime.Add(-48 * time.Hour), Field: "os.version"},
+	}
+	tests := []struct {
+		expr string
+		want map[string]float64
+	}{
+		{`inventoryChanges("web*", "1d", "")`, map[string]float64{"web01": 2, "web02": 0}},
+		{`inventoryChanges("*", "10m", "")`, map[string]float64{"web01": 1, "web02": 0, "db01": 1}},
+		{`inventoryChanges("*", "1d", "^os\\.")`, map[string]float64{"web01": 1, "web02": 0, "db01": 1}},
+	}
+	for _, test := range tests {
+		e, err := New(test.expr, builtins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(&Backends{}, &BosunProviders{Inventory: inv}, nil, queryTime, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]float64)
+		for _, res := range r.Results {
+			got[res.Group["host"]] = float64(res.Value.(Number))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.expr, got, test.want)
+		}
+	}
+}
+
+func TestLastSeen(t *testing.T) {
+	s := search.NewSearch(nil, true)
+	now := queryTime.Unix()
+	s.Index(opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Timestamp: now - 30, Value: 1, Tags: opentsdb.TagSet{"host": "web01", "cpu": "0"}},
+		{Metric: "os.cpu", Timestamp: now - 90, Value: 1, Tags: opentsdb.TagSet{"host": "web01", "cpu": "1"}},
+		{Metric: "os.cpu", Timestamp: now - 600, Value: 1, Tags: opentsdb.TagSet{"host": "web02", "cpu": "0"}},
+		{Metric: "os.cpu", Timestamp: now - 86400*40, Value: 1, Tags: opentsdb.TagSet{"host": "db01", "cpu": "0"}},
+	})
+	tests := []struct {
+		expr string
+		want map[string]float64
+	}{
+		{`lastSeen("os.cpu", "host=*", "")`, map[string]float64{"web01": 30, "web02": 600, "db01": 86400 * 40}},
+		{`lastSeen("os.cpu", "host=web*", "")`, map[string]float64{"web01": 30, "web02": 600}},
+		{`lastSeen("os.cpu", "host=*", "30d")`, map[string]float64{"web01": 30, "web02": 600}},
+		{`lastSeen("os.mem", "host=*", "")`, map[string]float64{}},
+	}
+	for _, test := range tests {
+		e, err := New(test.expr, builtins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(&Backends{}, &BosunProviders{Search: s}, nil, queryTime, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]float64)
+		for _, res := range r.Results {
+			got[res.Group["host"]] = float64(res.Value.(Number))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.expr, got, test.want)
+		}
+	}
+}
@@ -218,8 +218,10 @@ func timeGraphiteRequest(e *State, T miniprofiler.Timer, req *graphite.Request)
 			return e.GraphiteContext.Query(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(key, getFn)
-		resp = val.(graphite.Response)
+		targets, _ := json.Marshal(req.Targets)
+		sharedKey := fmt.Sprintf("%d-%d-%s", e.now.Unix()-req.Start.Unix(), e.now.Unix()-req.End.Unix(), targets)
+		val, err = e.cacheGet("graphite", key, sharedKey, getFn)
+		resp = val.(graphite.Response).Copy()
 	})
 	return
 }
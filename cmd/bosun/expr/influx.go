@@ -221,10 +221,38 @@ func timeInfluxRequest(e *State, T miniprofiler.Timer, db, query, startDuration,
 		}
 		var val interface{}
 		var ok bool
-		val, err = e.Cache.Get(q, getFn)
+		sharedKey := strings.Join([]string{db, query, startDuration, endDuration, groupByInterval}, "\n")
+		val, err = e.cacheGet("influx", q, sharedKey, getFn)
 		if s, ok = val.([]influxModels.Row); !ok {
 			err = fmt.Errorf("influx: did not get a valid result from InfluxDB")
 		}
+		s = copyInfluxRows(s)
 	})
 	return
 }
+
+// copyInfluxRows returns a deep copy of rows, so that cached results are not
+// changed by their users.
+func copyInfluxRows(rows []influxModels.Row) []influxModels.Row {
+	if rows == nil {
+		return nil
+	}
+	c := make([]influxModels.Row, len(rows))
+	for i, r := range rows {
+		c[i] = r
+		if r.Tags != nil {
+			c[i].Tags = make(map[string]string, len(r.Tags))
+			for k, v := range r.Tags {
+				c[i].Tags[k] = v
+			}
+		}
+		c[i].Columns = append([]string(nil), r.Columns...)
+		if r.Values != nil {
+			c[i].Values = make([][]interface{}, len(r.Values))
+			for j, v := range r.Values {
+				c[i].Values[j] = append([]interface{}(nil), v...)
+			}
+		}
+	}
+	return c
+}
@@ -44,6 +44,7 @@ type Func struct {
 	PrefixKey     bool
 	VariantReturn bool
 	Check         func(*Tree, *FuncNode) error
+	Doc           string // short help text for tooling such as editors
 }
 
 type Tags map[string]struct{}
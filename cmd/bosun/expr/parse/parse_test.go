@@ -164,6 +164,7 @@ var builtins = map[string]Func{
 		false,
 		false,
 		nil,
+		"",
 	},
 	"band": {
 		[]models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeScalar},
@@ -178,6 +179,7 @@ var builtins = map[string]Func{
 		false,
 		false,
 		nil,
+		"",
 	},
 	"q": {
 		[]models.FuncType{models.TypeString, models.TypeString},
@@ -192,6 +194,7 @@ var builtins = map[string]Func{
 		false,
 		false,
 		nil,
+		"",
 	},
 	"forecastlr": {
 		[]models.FuncType{models.TypeSeriesSet, models.TypeScalar},
@@ -206,5 +209,6 @@ var builtins = map[string]Func{
 		false,
 		false,
 		nil,
+		"",
 	},
 }
@@ -0,0 +1,61 @@
+package expr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/cache"
+)
+
+// QueryCacheTTLs is how long query results from each backend are shared for.
+// Results from a backend with a zero TTL are not shared.
+type QueryCacheTTLs struct {
+	OpenTSDB time.Duration
+	Graphite time.Duration
+	Influx   time.Duration
+}
+
+// QueryCache caches backend query results across expression executions, so
+// that alerts and graphs issuing the same query shortly after each other
+// share a single request to the backend.
+//
+// Queries are keyed on their text with absolute times made relative to the
+// time the expression is evaluated at, plus that time rounded down to the
+// backend's TTL. A query evaluated at a later time within the same bucket
+// therefore gets the results of the first query, which may lag behind by up
+// to the TTL.
+type QueryCache struct {
+	*cache.Cache
+	TTLs QueryCacheTTLs
+}
+
+// NewQueryCache returns a query cache holding at most maxEntries results.
+func NewQueryCache(maxEntries int, ttls QueryCacheTTLs) *QueryCache {
+	return &QueryCache{
+		Cache: cache.NewNamed("query", maxEntries),
+		TTLs:  ttls,
+	}
+}
+
+// cacheGet fetches the results of a query to backend through the shared
+// query cache if the backend has a TTL, and through the per-execution cache
+// otherwise. key identifies the query exactly, and sharedKey identifies it
+// relative to the time the expression is evaluated at.
+func (e *State) cacheGet(backend, key, sharedKey string, getFn func() (interface{}, error)) (interface{}, error) {
+	if qc := e.QueryCache; qc != nil {
+		var ttl time.Duration
+		switch backend {
+		case "tsdb":
+			ttl = qc.TTLs.OpenTSDB
+		case "graphite":
+			ttl = qc.TTLs.Graphite
+		case "influx":
+			ttl = qc.TTLs.Influx
+		}
+		if ttl > 0 {
+			bucket := e.now.Truncate(ttl).Unix()
+			return qc.GetWithTTL(fmt.Sprintf("%s-%d-%s", backend, bucket, sharedKey), ttl, getFn)
+		}
+	}
+	return e.Cache.Get(key, getFn)
+}
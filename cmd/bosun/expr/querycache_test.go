@@ -0,0 +1,89 @@
+package expr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/influxdata/influxdb/client/v2"
+	"github.com/leapar/bosun/cmd/bosun/cache"
+	"github.com/leapar/bosun/graphite"
+)
+
+type countingGraphite struct {
+	queries int
+}
+
+func (g *countingGraphite) Query(*graphite.Request) (graphite.Response, error) {
+	g.queries++
+	return graphite.Response{{
+		Target:     "a.b",
+		Datapoints: []graphite.DataPoint{{"1", "60"}},
+	}}, nil
+}
+
+// Results shared through the query cache are copied, so that changes to them
+// by one execution are not seen by the next.
+func TestQueryCacheCopies(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	influxQueries := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		influxQueries++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"series":[{"name":"m","tags":{"host":"a"},"columns":["time","value"],"values":[[0,1]]}]}]}`))
+	}))
+	defer ts.Close()
+	g := new(countingGraphite)
+	qc := NewQueryCache(10, QueryCacheTTLs{Graphite: time.Minute, Influx: time.Minute})
+	state := func() *State {
+		return &State{
+			now: now,
+			Backends: &Backends{
+				GraphiteContext: g,
+				InfluxConfig:    client.HTTPConfig{Addr: ts.URL},
+			},
+			BosunProviders: &BosunProviders{Cache: cache.New(0), QueryCache: qc},
+		}
+	}
+	T := new(miniprofiler.Profile)
+
+	req := &graphite.Request{Start: &now, End: &now, Targets: []string{"a.b"}}
+	resp, err := timeGraphiteRequest(state(), T, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := graphite.Response{{Target: "a.b", Datapoints: []graphite.DataPoint{{"1", "60"}}}}
+	resp[0].Target = "changed"
+	resp[0].Datapoints[0][0] = json.Number("2")
+	resp, err = timeGraphiteRequest(state(), T, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("got graphite response %v, want %v", resp, want)
+	}
+	if g.queries != 1 {
+		t.Errorf("expected one graphite query, got %d", g.queries)
+	}
+
+	rows, err := timeInfluxRequest(state(), T, "db", "select value from m", "1h", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows[0].Tags["host"] = "b"
+	rows[0].Values[0][1] = json.Number("2")
+	rows, err = timeInfluxRequest(state(), T, "db", "select value from m", "1h", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Tags["host"] != "a" || rows[0].Values[0][1] != json.Number("1") {
+		t.Errorf("got influx rows %v changed through the cache", rows)
+	}
+	if influxQueries != 1 {
+		t.Errorf("expected one influx query, got %d", influxQueries)
+	}
+}
@@ -75,6 +75,10 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 		}
 	}
 	b, _ := json.MarshalIndent(req, "", "  ")
+	relative := *req
+	relative.Start = relativeTime(e.now, req.Start)
+	relative.End = relativeTime(e.now, req.End)
+	rb, _ := json.Marshal(&relative)
 	tries := 1
 	for {
 		T.StepCustomTiming("tsdb", "query", string(b), func() {
@@ -82,7 +86,7 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 				return e.TSDBContext.Query(req)
 			}
 			var val interface{}
-			val, err = e.Cache.Get(string(b), getFn)
+			val, err = e.cacheGet("tsdb", string(b), string(rb), getFn)
 			rs := val.(opentsdb.ResponseSet)
 			s = rs.Copy()
 			for _, r := range rs {
@@ -100,6 +104,16 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 	return
 }
 
+// relativeTime returns an absolute request time as the number of seconds
+// before now, so that requests made at different times for the same window
+// compare equal. Other times are returned as is.
+func relativeTime(now time.Time, t interface{}) interface{} {
+	if ts, ok := t.(int64); ok {
+		return fmt.Sprintf("%ds-ago", now.Unix()-ts)
+	}
+	return t
+}
+
 func bandTSDB(e *State, T miniprofiler.Timer, query, duration, period string, num float64, rfunc func(*Results, *opentsdb.Response, time.Duration) error) (r *Results, err error) {
 	r = new(Results)
 	r.IgnoreOtherUnjoined = true
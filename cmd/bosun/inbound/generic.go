@@ -0,0 +1,122 @@
+package inbound
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+)
+
+func init() {
+	Register("generic", "", newGeneric)
+	Register("opsgenie", "", func(conf.InboundConf) (Parser, error) {
+		return newGeneric(conf.InboundConf{Mapping: opsgenieMapping})
+	})
+}
+
+// opsgenieMapping reads Opsgenie alert webhooks. Bosun incident ids are
+// expected in the alert alias.
+var opsgenieMapping = conf.InboundMapping{
+	ExternalId: "alert.alertId",
+	IncidentId: "alert.alias",
+	Action:     "action",
+	User:       "alert.username",
+	Message:    "alert.note",
+	Actions: map[string]string{
+		"Acknowledge": "ack",
+		"Close":       "close",
+		"AddNote":     "note",
+	},
+}
+
+// generic reads JSON payloads as configured by an InboundMapping.
+type generic struct {
+	m       conf.InboundMapping
+	actions map[string]models.ActionType
+}
+
+func newGeneric(c conf.InboundConf) (Parser, error) {
+	m := c.Mapping
+	if m.ExternalId == "" && m.IncidentId == "" {
+		return nil, fmt.Errorf("mapping needs an ExternalId or IncidentId")
+	}
+	g := &generic{m: m, actions: make(map[string]models.ActionType)}
+	for value, name := range m.Actions {
+		at, ok := actionTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown action %q for %q, must be ack, close or note", name, value)
+		}
+		g.actions[value] = at
+	}
+	return g, nil
+}
+
+func (g *generic) Parse(body []byte) ([]*Event, error) {
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var payload interface{}
+	if err := d.Decode(&payload); err != nil {
+		return nil, err
+	}
+	items := []interface{}{payload}
+	if g.m.Events != "" {
+		v, ok := lookup(payload, g.m.Events).([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not an array", g.m.Events)
+		}
+		items = v
+	}
+	events := make([]*Event, 0, len(items))
+	for _, item := range items {
+		e := &Event{
+			ExternalId: lookupString(item, g.m.ExternalId),
+			IncidentId: parseIncidentId(lookupString(item, g.m.IncidentId)),
+			User:       lookupString(item, g.m.User),
+			Message:    lookupString(item, g.m.Message),
+		}
+		if e.ExternalId == "" && e.IncidentId == 0 {
+			continue
+		}
+		e.Action = g.actions[lookupString(item, g.m.Action)]
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// lookup returns the value at a dotted path in v, where path elements are
+// object keys or array indexes, or nil if there is none.
+func lookup(v interface{}, path string) interface{} {
+	if path == "" {
+		return nil
+	}
+	for _, p := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			v = t[p]
+		case []interface{}:
+			i, err := strconv.Atoi(p)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil
+			}
+			v = t[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// lookupString returns the string or number at path in v, or "".
+func lookupString(v interface{}, path string) string {
+	switch t := lookup(v, path).(type) {
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+// Package inbound parses and verifies webhooks sent to bosun by external
+// incident tools, such as acknowledgements made in a paging tool.
+package inbound // import "github.com/leapar/bosun/cmd/bosun/inbound"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+)
+
+// Event is an action on an incident in an external tool.
+type Event struct {
+	// ExternalId is the tool's id of the incident.
+	ExternalId string
+	// IncidentId is the bosun incident id, if the payload has it, or 0.
+	// Events with both ids link them, so that later events only need the
+	// external id.
+	IncidentId int64
+	// Action is ActionAcknowledge, ActionClose or ActionNote, or ActionNone
+	// for events that only link ids.
+	Action  models.ActionType
+	User    string
+	Message string
+}
+
+// A Parser returns the events in the body of a webhook request.
+type Parser interface {
+	Parse(body []byte) ([]*Event, error)
+}
+
+// A Factory makes the parser of a provider.
+type Factory func(c conf.InboundConf) (Parser, error)
+
+type format struct {
+	factory         Factory
+	signatureHeader string
+}
+
+var formats = make(map[string]format)
+
+// Register makes a payload format available as the Parser of providers.
+// signatureHeader is the default header of request signatures.
+func Register(name, signatureHeader string, f Factory) {
+	if _, dup := formats[name]; dup {
+		panic("inbound: Register called twice for " + name)
+	}
+	formats[name] = format{f, signatureHeader}
+}
+
+// Formats returns the names of the registered payload formats.
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns the parser of a provider.
+func New(c conf.InboundConf) (Parser, error) {
+	f, ok := formats[c.Parser]
+	if !ok {
+		return nil, fmt.Errorf("unknown inbound parser %q, must be one of %s", c.Parser, strings.Join(Formats(), ", "))
+	}
+	return f.factory(c)
+}
+
+// DefaultSignatureHeader is the header of request signatures if neither the
+// provider nor its parser specify one.
+const DefaultSignatureHeader = "X-Bosun-Signature"
+
+// Verify checks that a request to a provider carries its token and is signed
+// with its secret. The token may be in the token query parameter, the
+// X-Bosun-Token header or a bearer Authorization header. The signature is
+// the hex HMAC-SHA256 of the body, optionally prefixed by "sha256=" or
+// "v1=". The header may hold several comma separated signatures, such as
+// during a secret rotation, of which one must match.
+func Verify(c conf.InboundConf, r *http.Request, body []byte) error {
+	if c.Token != "" {
+		token := r.URL.Query().Get("token")
+		if token == "" {
+			token = r.Header.Get("X-Bosun-Token")
+		}
+		if token == "" {
+			token = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) != 1 {
+			return fmt.Errorf("bad token")
+		}
+	}
+	if c.Secret != "" {
+		header := c.SignatureHeader
+		if header == "" {
+			header = formats[c.Parser].signatureHeader
+		}
+		if header == "" {
+			header = DefaultSignatureHeader
+		}
+		mac := hmac.New(sha256.New, []byte(c.Secret))
+		mac.Write(body)
+		expected := mac.Sum(nil)
+		for _, sig := range strings.Split(r.Header.Get(header), ",") {
+			sig = strings.TrimSpace(sig)
+			sig = strings.TrimPrefix(sig, "sha256=")
+			sig = strings.TrimPrefix(sig, "v1=")
+			if b, err := hex.DecodeString(sig); err == nil && hmac.Equal(b, expected) {
+				return nil
+			}
+		}
+		return fmt.Errorf("bad signature in %s", header)
+	}
+	return nil
+}
+
+// parseIncidentId returns the bosun incident id in s, such as 123 in "123",
+// "bosun-123" or "incident:123", or 0 if there is none.
+func parseIncidentId(s string) int64 {
+	s = s[strings.LastIndexAny(s, "-:#/ ")+1:]
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id < 0 {
+		return 0
+	}
+	return id
+}
+
+// actionTypes are the names of actions that webhooks may take.
+var actionTypes = map[string]models.ActionType{
+	"ack":   models.ActionAcknowledge,
+	"close": models.ActionClose,
+	"note":  models.ActionNote,
+}
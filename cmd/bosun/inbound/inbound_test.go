@@ -0,0 +1,117 @@
+package inbound
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"a":1}`)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	sig := hex.EncodeToString(mac.Sum(nil))
+	tests := []struct {
+		c      conf.InboundConf
+		url    string
+		header map[string]string
+		ok     bool
+	}{
+		{conf.InboundConf{Token: "t"}, "/?token=t", nil, true},
+		{conf.InboundConf{Token: "t"}, "/?token=x", nil, false},
+		{conf.InboundConf{Token: "t"}, "/", map[string]string{"Authorization": "Bearer t"}, true},
+		{conf.InboundConf{Token: "t"}, "/", nil, false},
+		{conf.InboundConf{Secret: "s3cret"}, "/", map[string]string{"X-Bosun-Signature": "sha256=" + sig}, true},
+		{conf.InboundConf{Secret: "s3cret"}, "/", map[string]string{"X-Bosun-Signature": "00"}, false},
+		{conf.InboundConf{Secret: "s3cret", Parser: "pagerduty"}, "/", map[string]string{"X-PagerDuty-Signature": "v1=00, v1=" + sig}, true},
+		{conf.InboundConf{Secret: "s3cret", Parser: "pagerduty"}, "/", map[string]string{"X-Bosun-Signature": sig}, false},
+		{conf.InboundConf{Secret: "s3cret", SignatureHeader: "X-Sig"}, "/", map[string]string{"X-Sig": sig}, true},
+		{conf.InboundConf{Token: "t", Secret: "s3cret"}, "/?token=t", nil, false},
+	}
+	for i, test := range tests {
+		r, _ := http.NewRequest("POST", test.url, nil)
+		for k, v := range test.header {
+			r.Header.Set(k, v)
+		}
+		err := Verify(test.c, r, body)
+		if (err == nil) != test.ok {
+			t.Errorf("%d: got error %v, expected ok %v", i, err, test.ok)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		c      conf.InboundConf
+		body   string
+		events []*Event
+	}{
+		{
+			conf.InboundConf{Parser: "pagerduty"},
+			`{"event":{"event_type":"incident.acknowledged","agent":{"summary":"Alice"},"data":{"id":"PGR0VU2","incident_key":"bosun-17"}}}`,
+			[]*Event{{ExternalId: "PGR0VU2", IncidentId: 17, Action: models.ActionAcknowledge, User: "Alice"}},
+		},
+		{
+			conf.InboundConf{Parser: "pagerduty"},
+			`{"event":{"event_type":"incident.annotated","agent":{"summary":"Bob"},"data":{"id":"N1","content":"looking","incident":{"id":"PGR0VU2"}}}}`,
+			[]*Event{{ExternalId: "PGR0VU2", Action: models.ActionNote, User: "Bob", Message: "looking"}},
+		},
+		{
+			conf.InboundConf{Parser: "pagerduty"},
+			`{"event":{"event_type":"incident.triggered","data":{"id":"P2","incident_key":"d3640fbd41094207a1c11e58e46b1662"}}}`,
+			[]*Event{{ExternalId: "P2"}},
+		},
+		{
+			conf.InboundConf{Parser: "opsgenie"},
+			`{"action":"Close","alert":{"alertId":"abc","alias":"5","username":"carol"}}`,
+			[]*Event{{ExternalId: "abc", IncidentId: 5, Action: models.ActionClose, User: "carol"}},
+		},
+		{
+			conf.InboundConf{Parser: "generic", Mapping: conf.InboundMapping{
+				Events:     "items",
+				ExternalId: "ref",
+				IncidentId: "meta.bosun",
+				Action:     "state",
+				User:       "by.0",
+				Actions:    map[string]string{"acked": "ack"},
+			}},
+			`{"items":[{"ref":7,"meta":{"bosun":"12"},"state":"acked","by":["dave"]},{"ref":"x","state":"open"},{"state":"acked"}]}`,
+			[]*Event{
+				{ExternalId: "7", IncidentId: 12, Action: models.ActionAcknowledge, User: "dave"},
+				{ExternalId: "x"},
+			},
+		},
+	}
+	for i, test := range tests {
+		p, err := New(test.c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events, err := p.Parse([]byte(test.body))
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(events, test.events) {
+			t.Errorf("%d: got %+v, expected %+v", i, events, test.events)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, c := range []conf.InboundConf{
+		{Parser: "nope"},
+		{Parser: "generic"},
+		{Parser: "generic", Mapping: conf.InboundMapping{ExternalId: "id", Actions: map[string]string{"x": "purge"}}},
+	} {
+		if _, err := New(c); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
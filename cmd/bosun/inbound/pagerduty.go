@@ -0,0 +1,63 @@
+package inbound
+
+import (
+	"encoding/json"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+)
+
+func init() {
+	Register("pagerduty", "X-PagerDuty-Signature", func(conf.InboundConf) (Parser, error) {
+		return pagerduty{}, nil
+	})
+}
+
+// pagerduty reads PagerDuty V3 incident webhooks. Bosun incident ids are
+// expected in the incident key, which is the dedup key of the events that
+// bosun sent to PagerDuty.
+type pagerduty struct{}
+
+var pagerdutyActions = map[string]models.ActionType{
+	"incident.acknowledged": models.ActionAcknowledge,
+	"incident.resolved":     models.ActionClose,
+	"incident.annotated":    models.ActionNote,
+}
+
+func (pagerduty) Parse(body []byte) ([]*Event, error) {
+	var payload struct {
+		Event struct {
+			EventType string `json:"event_type"`
+			Agent     struct {
+				Summary string `json:"summary"`
+			} `json:"agent"`
+			Data struct {
+				Id          string `json:"id"`
+				IncidentKey string `json:"incident_key"`
+				Content     string `json:"content"`
+				// Incident is set on notes.
+				Incident *struct {
+					Id string `json:"id"`
+				} `json:"incident"`
+			} `json:"data"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	pe := payload.Event
+	e := &Event{
+		ExternalId: pe.Data.Id,
+		IncidentId: parseIncidentId(pe.Data.IncidentKey),
+		Action:     pagerdutyActions[pe.EventType],
+		User:       pe.Agent.Summary,
+	}
+	if pe.Data.Incident != nil {
+		e.ExternalId = pe.Data.Incident.Id
+		e.Message = pe.Data.Content
+	}
+	if e.ExternalId == "" {
+		return nil, nil
+	}
+	return []*Event{e}, nil
+}
@@ -3,6 +3,7 @@ package main
 //go:generate go run ../../build/generate/generate.go
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
@@ -86,6 +87,7 @@ func init() {
 var (
 	flagConf     = flag.String("c", "bosun.toml", "system config file location")
 	flagTest     = flag.Bool("t", false, "test for valid config; exits with 0 on success, else 1")
+	flagLint     = flag.Bool("lint", false, "lint the rule config, printing errors and warnings as JSON; exits with 0 if there are no errors, else 1")
 	flagWatch    = flag.Bool("w", false, "watch .go files below current directory and exit; also build typescript files on change")
 	flagReadonly = flag.Bool("r", false, "readonly-mode: don't write or relay any OpenTSDB metrics")
 	flagQuiet    = flag.Bool("q", false, "quiet-mode: don't send any notifications except from the rule test page")
@@ -114,6 +116,9 @@ func main() {
 	if err != nil {
 		slog.Fatal(err)
 	}
+	if *flagLint {
+		os.Exit(lint(sysProvider.GetRuleFilePath(), systemConf))
+	}
 	ruleConf, err := rule.ParseFile(sysProvider.GetRuleFilePath(), systemConf.EnabledBackends(), systemConf.GetRuleVars())
 	if err != nil {
 		slog.Fatalf("couldn't read rules: %v", err)
@@ -195,6 +200,20 @@ func main() {
 		uid := "1"
 		go ping.PingHosts(sched.DefaultSched.Search, uid, systemConf.GetPingDuration())
 	}
+	// stopChecks stops the health checks, which are restarted with the
+	// search of the new schedule on reload.
+	stopChecks := func() {}
+	startChecks := func() {
+		if hc := systemConf.GetHealthCheckConf(); len(hc.Checks) > 0 {
+			uid := "1"
+			stop, err := ping.CheckHosts(sched.DefaultSched.Search, uid, systemConf.GetPingDuration(), hc)
+			if err != nil {
+				slog.Fatal(err)
+			}
+			stopChecks = stop
+		}
+	}
+	startChecks()
 	if sysProvider.GetInternetProxy() != "" {
 		web.InternetProxy, err = url.Parse(sysProvider.GetInternetProxy())
 		if err != nil {
@@ -207,8 +226,15 @@ func main() {
 		if err != nil {
 			slog.Fatal(err)
 		}
-		ruleProvider.SetSaveHook(cmdHook)
 	}
+	if gitConf := sysProvider.GetGitConf(); gitConf.Enabled {
+		web.RuleRepo, err = rule.OpenGitRepo(sysProvider.GetRuleFilePath(), ruleConf.GetFiles(), gitConf)
+		if err != nil {
+			slog.Fatalf("couldn't open rule git repository: %v", err)
+		}
+		cmdHook = conf.ChainSaveHooks(cmdHook, web.RuleRepo.SaveHook())
+	}
+	ruleProvider.SetSaveHook(cmdHook)
 	var reload func() error
 	reloading := make(chan bool, 1) // a lock that we can give up acquiring
 	reload = func() error {
@@ -229,6 +255,7 @@ func main() {
 		newConf.SetReload(reload)
 		oldSched := sched.DefaultSched
 		oldSearch := oldSched.Search
+		stopChecks()
 		sched.Close(true)
 		sched.Reset()
 		newSched := sched.DefaultSched
@@ -240,6 +267,7 @@ func main() {
 			slog.Fatal(err)
 		}
 		web.ResetSchedule() // Signal web to point to the new DefaultSchedule
+		startChecks()
 		go func() {
 			slog.Infoln("running new schedule")
 			if !*flagNoChecks {
@@ -347,3 +375,34 @@ func watch(root, pattern string, f func()) {
 		}
 	}()
 }
+
+// lint writes the diagnostics for the rule files at path to stdout and
+// returns the exit code.
+func lint(path string, systemConf *conf.SystemConf) int {
+	// Metrics are only checked against Redis: starting the embedded ledis
+	// would take the database of a running bosun.
+	var metricSeen func(string) bool
+	if systemConf.GetRedisHost() != "" {
+		da := database.NewDataAccess(systemConf.GetRedisHost(), true, systemConf.GetRedisDb(), systemConf.GetRedisPassword())
+		if metrics, err := da.Search().GetAllMetrics(systemConf.GetUid()); err != nil {
+			slog.Warningf("not checking for unknown metrics: %v", err)
+		} else {
+			metricSeen = func(metric string) bool {
+				_, ok := metrics[metric]
+				return ok
+			}
+		}
+	}
+	diagnostics := rule.LintFiles(path, systemConf.EnabledBackends(), systemConf.GetRuleVars(), nil, metricSeen)
+	b, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		slog.Fatal(err)
+	}
+	fmt.Println(string(b))
+	for _, d := range diagnostics {
+		if d.Severity == rule.SeverityError {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,248 @@
+package ping
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/search"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+	"github.com/leapar/bosun/util"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.check.up", metadata.Gauge, metadata.Bool,
+		"1=The health check of the host succeeded. 0=It failed or timed out.")
+	metadata.AddMetricMeta("bosun.check.latency", metadata.Gauge, metadata.MilliSecond,
+		"The number of milliseconds the health check of the host took to succeed.")
+	metadata.AddMetricMeta("bosun.check.status", metadata.Gauge, metadata.StatusCode,
+		"The HTTP status code returned to an http health check.")
+	metadata.AddMetricMeta("bosun.check.cert_expiry", metadata.Gauge, metadata.Second,
+		"The number of seconds until the TLS certificate of an https health check expires.")
+}
+
+// Result is the outcome of a health check of a host. Values are extra
+// metrics, such as the HTTP status, keyed by their name under bosun.check.
+type Result struct {
+	Up      bool
+	Latency time.Duration
+	Values  map[string]float64
+}
+
+// A Checker checks the health of a host, giving up when ctx is done.
+type Checker interface {
+	Check(ctx context.Context, host string) *Result
+}
+
+// NewChecker returns the Checker for hc.
+func NewChecker(hc conf.HealthCheck) (Checker, error) {
+	switch hc.Type {
+	case "icmp":
+		return icmpChecker{}, nil
+	case "tcp":
+		return tcpChecker{port: hc.Port}, nil
+	case "http":
+		url := hc.URL
+		if url == "" {
+			url = "http://{host}/"
+		}
+		client := httpCheckClient
+		if hc.InsecureSkipVerify {
+			client = insecureHTTPCheckClient
+		}
+		return &httpChecker{url: url, status: hc.Status, client: client}, nil
+	case "dns":
+		return dnsChecker{}, nil
+	}
+	return nil, fmt.Errorf("unknown health check type: %s", hc.Type)
+}
+
+type icmpChecker struct{}
+
+func (icmpChecker) Check(ctx context.Context, host string) *Result {
+	timeout := time.Second * 5
+	if d, ok := ctx.Deadline(); ok {
+		timeout = time.Until(d)
+	}
+	_, rtt, ok := ping(host, timeout)
+	return &Result{Up: ok, Latency: rtt}
+}
+
+type tcpChecker struct {
+	port int
+}
+
+func (c tcpChecker) Check(ctx context.Context, host string) *Result {
+	var d net.Dialer
+	start := time.Now()
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(c.port)))
+	if err != nil {
+		return &Result{}
+	}
+	conn.Close()
+	return &Result{Up: true, Latency: time.Since(start)}
+}
+
+type httpChecker struct {
+	url    string
+	status int
+	client *http.Client
+}
+
+var (
+	httpCheckClient         = newHTTPCheckClient(false)
+	insecureHTTPCheckClient = newHTTPCheckClient(true)
+)
+
+func newHTTPCheckClient(insecure bool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: insecure},
+			DisableKeepAlives: true,
+		},
+		// The status of the URL itself is checked, not that of its redirect.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (c *httpChecker) Check(ctx context.Context, host string) *Result {
+	r := &Result{Values: make(map[string]float64)}
+	req, err := http.NewRequest("GET", strings.Replace(c.url, "{host}", host, -1), nil)
+	if err != nil {
+		slog.Errorln(err)
+		return r
+	}
+	start := time.Now()
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return r
+	}
+	resp.Body.Close()
+	r.Values["status"] = float64(resp.StatusCode)
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		r.Values["cert_expiry"] = time.Until(resp.TLS.PeerCertificates[0].NotAfter).Seconds()
+	}
+	if c.status == 0 {
+		r.Up = resp.StatusCode < 400
+	} else {
+		r.Up = resp.StatusCode == c.status
+	}
+	if r.Up {
+		r.Latency = time.Since(start)
+	}
+	return r
+}
+
+type dnsChecker struct{}
+
+func (dnsChecker) Check(ctx context.Context, host string) *Result {
+	start := time.Now()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return &Result{}
+	}
+	return &Result{Up: true, Latency: time.Since(start)}
+}
+
+type check struct {
+	name    string
+	hosts   string
+	timeout time.Duration
+	Checker
+}
+
+// CheckHosts runs the health checks of hc against all hosts that bosun has
+// indexed as recently as duration, every interval of hc, until stop is
+// called. The results are recorded as bosun.check metrics tagged with the
+// host and the name of the check.
+func CheckHosts(search *search.Search, uid string, duration time.Duration, hc conf.HealthCheckConf) (stop func(), err error) {
+	var checks []*check
+	for name, c := range hc.Checks {
+		checker, err := NewChecker(c)
+		if err != nil {
+			return nil, fmt.Errorf("health check %s: %v", name, err)
+		}
+		timeout := c.Timeout.Duration
+		if timeout == 0 {
+			timeout = hc.Timeout.Duration
+		}
+		checks = append(checks, &check{name: name, hosts: c.Hosts, timeout: timeout, Checker: checker})
+	}
+	sem := make(chan struct{}, hc.Concurrency)
+	ticker := time.NewTicker(hc.Interval.Duration)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			hosts, err := search.TagValuesByTagKey("host", uid, duration)
+			if err != nil {
+				slog.Error(err)
+				continue
+			}
+			runChecks(checks, hosts, sem)
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}, nil
+}
+
+// runChecks runs each check against the hosts it matches, with no more
+// running at once than the capacity of sem, and waits for them to finish.
+func runChecks(checks []*check, hosts []string, sem chan struct{}) {
+	var wg sync.WaitGroup
+	for _, c := range checks {
+		for _, host := range hosts {
+			if c.hosts != "" {
+				if m, _ := util.Match(c.hosts, host); !m {
+					continue
+				}
+			}
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(c *check, host string) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+				defer cancel()
+				putResult(c.name, host, c.Check(ctx, host))
+			}(c, host)
+		}
+	}
+	wg.Wait()
+}
+
+func putResult(name, host string, r *Result) {
+	tags := opentsdb.TagSet{"host": host, "check": name}
+	up := 0
+	if r.Up {
+		up = 1
+		collect.Put("check.latency", tags, float64(r.Latency)/float64(time.Millisecond))
+	}
+	collect.Put("check.up", tags, up)
+	for k, v := range r.Values {
+		collect.Put("check."+k, tags, v)
+	}
+}
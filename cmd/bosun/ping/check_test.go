@@ -0,0 +1,98 @@
+package ping
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+func TestCheckers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, p, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, _ := strconv.Atoi(p)
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	tests := []struct {
+		hc     conf.HealthCheck
+		up     bool
+		status float64
+	}{
+		{conf.HealthCheck{Type: "tcp", Port: port}, true, 0},
+		{conf.HealthCheck{Type: "tcp", Port: closedPort}, false, 0},
+		{conf.HealthCheck{Type: "http", URL: "http://{host}:" + p + "/"}, true, 200},
+		{conf.HealthCheck{Type: "http", URL: "http://{host}:" + p + "/down"}, false, 503},
+		{conf.HealthCheck{Type: "http", URL: "http://{host}:" + p + "/down", Status: 503}, true, 503},
+		{conf.HealthCheck{Type: "dns"}, true, 0},
+	}
+	for _, test := range tests {
+		c, err := NewChecker(test.hc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		r := c.Check(ctx, host)
+		cancel()
+		if r.Up != test.up {
+			t.Errorf("%+v: got up %v, expected %v", test.hc, r.Up, test.up)
+		}
+		if r.Values["status"] != test.status {
+			t.Errorf("%+v: got status %v, expected %v", test.hc, r.Values["status"], test.status)
+		}
+	}
+	if _, err := NewChecker(conf.HealthCheck{Type: "smtp"}); err == nil {
+		t.Error("expected error for unknown type")
+	}
+}
+
+func TestHTTPCheckerCertificates(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, p, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The certificate of the test server is self-signed.
+	for _, insecure := range []bool{false, true} {
+		c, err := NewChecker(conf.HealthCheck{Type: "http", URL: "https://{host}:" + p + "/", InsecureSkipVerify: insecure})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		r := c.Check(ctx, host)
+		cancel()
+		if r.Up != insecure {
+			t.Errorf("InsecureSkipVerify %v: got up %v", insecure, r.Up)
+		}
+		if insecure && r.Values["cert_expiry"] <= 0 {
+			t.Errorf("expected the certificate expiry, got %v", r.Values)
+		}
+	}
+}
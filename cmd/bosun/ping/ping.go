@@ -40,26 +40,41 @@ func PingHosts(search *search.Search, uid string, duration time.Duration) {
 }
 
 func pingHost(host string) {
-	p := fastping.NewPinger()
 	tags := opentsdb.TagSet{"dst_host": host}
-	resolved := 0
-	defer func() {
-		collect.Put("ping.resolved", tags, resolved)
-	}()
+	resolved, rtt, ok := ping(host, time.Second*5)
+	if resolved {
+		timeout := 1
+		if ok {
+			collect.Put("ping.rtt", tags, float64(rtt)/float64(time.Millisecond))
+			timeout = 0
+		}
+		collect.Put("ping.timeout", tags, timeout)
+	}
+	collect.Put("ping.resolved", tags, boolToInt(resolved))
+}
+
+// ping sends an ICMP echo to host and returns whether it resolved, and the
+// round trip time if a reply was received within timeout.
+func ping(host string, timeout time.Duration) (resolved bool, rtt time.Duration, ok bool) {
 	ra, err := net.ResolveIPAddr("ip4:icmp", host)
 	if err != nil {
-		return
+		return false, 0, false
 	}
-	resolved = 1
+	p := fastping.NewPinger()
 	p.AddIPAddr(ra)
-	p.MaxRTT = time.Second * 5
-	timeout := 1
+	p.MaxRTT = timeout
 	p.OnRecv = func(addr *net.IPAddr, t time.Duration) {
-		collect.Put("ping.rtt", tags, float64(t)/float64(time.Millisecond))
-		timeout = 0
+		rtt, ok = t, true
 	}
 	if err := p.Run(); err != nil {
 		slog.Errorln(err)
 	}
-	collect.Put("ping.timeout", tags, timeout)
+	return true, rtt, ok
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
 }
@@ -16,6 +16,18 @@ func (s *Schedule) Run() error {
 	}
 	s.nc = make(chan interface{}, 1)
 	go s.dispatchNotifications()
+	if len(s.SystemConf.GetReportDigestTo()) > 0 {
+		go s.runReportDigest()
+	}
+	if len(s.RuleConf.GetReports()) > 0 {
+		go s.runReports()
+	}
+	if interval, _ := s.SystemConf.GetInventoryConf(); interval > 0 {
+		go s.runInventory()
+	}
+	if retention, interval, rate := s.SystemConf.GetSearchPruneConf(); retention > 0 && interval > 0 {
+		go s.Search.RunPrune(s.SystemConf.GetUid(), interval, retention, rate, s.runnerContext.Done())
+	}
 	type alertCh struct {
 		ch     chan<- *checkContext
 		modulo int
@@ -37,6 +49,7 @@ func (s *Schedule) Run() error {
 			return nil
 		default:
 		}
+		s.refreshTopology()
 		ctx := &checkContext{utcNow(), cache.New(0)}
 		s.LastCheck = utcNow()
 		for _, a := range chs {
@@ -84,7 +97,11 @@ func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
 		// Don't runHistory for the alert if expression evaluation has been cancelled
 		return
 	}
+	// Before the history, so that notifications of the check see the SLO
+	// as of the check.
+	s.checkSLO(a, ctx.runTime, ctx.checkCache)
 	start := utcNow()
 	s.RunHistory(rh)
 	slog.Infof("runHistory on %s took %v\n", a.Name, time.Since(start))
+	s.forgetHeartbeat(a, ctx.runTime)
 }
@@ -0,0 +1,222 @@
+package sched
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.alert.status", metadata.Gauge, metadata.StatusCode,
+		"The status of an alert key at its last check: 1 normal, 2 warning, 3 critical and 4 unknown.")
+	metadata.AddMetricMeta("bosun.alert.value", metadata.Gauge, metadata.None,
+		"The value of the crit or warn expression, by severity, of an alert key at its last check.")
+	metadata.AddMetricMeta("bosun.alert.computation", metadata.Gauge, metadata.None,
+		"The value of a computation, by severity and index, of the expression of an alert key at its last check.")
+	metadata.AddMetricMeta("bosun.alert_metrics.dropped", metadata.Counter, metadata.Item,
+		"The number of alert results not sent as data points, by reason: series if the alert has too many alert keys, tags if the alert key has too many tags and invalid if a tag cannot be sent.")
+	metadata.AddMetricMeta("bosun.alert_metrics.errors", metadata.Counter, metadata.Error,
+		"The number of failures sending alert results directly to the OpenTSDB host.")
+}
+
+// alertMetrics limits the alert keys of each alert whose results are sent
+// as data points. It is made anew with the schedule on each reload.
+type alertMetrics struct {
+	sync.Mutex
+	keys map[string]map[models.AlertKey]bool
+}
+
+// admit returns whether the results of ak are sent: its alert has sent
+// those of fewer than max other alert keys.
+func (am *alertMetrics) admit(ak models.AlertKey, max int) bool {
+	am.Lock()
+	defer am.Unlock()
+	if am.keys == nil {
+		am.keys = make(map[string]map[models.AlertKey]bool)
+	}
+	keys := am.keys[ak.Name()]
+	if keys == nil {
+		keys = make(map[models.AlertKey]bool)
+		am.keys[ak.Name()] = keys
+	}
+	if keys[ak] {
+		return true
+	}
+	if len(keys) >= max {
+		return false
+	}
+	keys[ak] = true
+	return true
+}
+
+// prune forgets the alert keys of the alerts of events that are not among
+// them, such as keys that were forgotten, so that their places go to new
+// alert keys.
+func (am *alertMetrics) prune(events map[models.AlertKey]*models.Event) {
+	alerts := make(map[string]bool)
+	for ak := range events {
+		alerts[ak.Name()] = true
+	}
+	am.Lock()
+	defer am.Unlock()
+	for name := range alerts {
+		keys := am.keys[name]
+		for ak := range keys {
+			if _, ok := events[ak]; !ok {
+				delete(keys, ak)
+			}
+		}
+	}
+}
+
+// alertDataPoints returns the data points of the result of a check of ak,
+// without their timestamps, or the reason they are dropped.
+func (s *Schedule) alertDataPoints(ak models.AlertKey, event *models.Event, c conf.AlertMetricsConf) (opentsdb.MultiDataPoint, string) {
+	tags := ak.Group().Copy()
+	if _, ok := tags["alert"]; ok {
+		return nil, "invalid"
+	}
+	tags["alert"] = ak.Name()
+	clean := func(s string) bool {
+		c, err := opentsdb.Clean(s)
+		return err == nil && c == s
+	}
+	for k, v := range tags {
+		if !clean(k) || !clean(v) {
+			return nil, "invalid"
+		}
+	}
+	// Values and computations add the severity tag.
+	if len(tags)+1 > c.MaxTags {
+		return nil, "tags"
+	}
+	if !s.alertMetrics.admit(ak, c.MaxSeries) {
+		return nil, "series"
+	}
+	mdp := opentsdb.MultiDataPoint{{
+		Metric: "bosun.alert.status",
+		Value:  int(event.Status),
+		Tags:   tags,
+	}}
+	add := func(severity string, r *models.Result) {
+		if r == nil {
+			return
+		}
+		vtags := tags.Copy().Merge(opentsdb.TagSet{"severity": severity})
+		if v := float64(r.Value); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			mdp = append(mdp, &opentsdb.DataPoint{Metric: "bosun.alert.value", Value: v, Tags: vtags})
+		}
+		for i, comp := range r.Computations {
+			if i >= c.Computations {
+				break
+			}
+			v, ok := computationValue(comp.Value)
+			if !ok {
+				continue
+			}
+			mdp = append(mdp, &opentsdb.DataPoint{
+				Metric: "bosun.alert.computation",
+				Value:  v,
+				Tags:   vtags.Copy().Merge(opentsdb.TagSet{"computation": strconv.Itoa(i)}),
+			})
+		}
+	}
+	add("crit", event.Crit)
+	add("warn", event.Warn)
+	return mdp, ""
+}
+
+// computationValue returns the value of a computation if it is a finite
+// number.
+func computationValue(v interface{}) (float64, bool) {
+	var f float64
+	switch v := v.(type) {
+	case expr.Number:
+		f = float64(v)
+	case expr.Scalar:
+		f = float64(v)
+	case float64:
+		f = v
+	case models.Float:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	default:
+		return 0, false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// sendAlertMetrics sends the results of the events of r as data points, if
+// enabled by the system configuration.
+func (s *Schedule) sendAlertMetrics(r *RunHistory) {
+	c := s.SystemConf.GetAlertMetricsConf()
+	if !c.Enabled {
+		return
+	}
+	s.alertMetrics.prune(r.Events)
+	var mdp opentsdb.MultiDataPoint
+	for ak, event := range r.Events {
+		a := s.RuleConf.GetAlert(ak.Name())
+		if a == nil || a.NoMetrics || event.Unevaluated {
+			continue
+		}
+		dps, reason := s.alertDataPoints(ak, event, c)
+		if reason != "" {
+			collect.Add("alert_metrics.dropped", opentsdb.TagSet{"alert": ak.Name(), "reason": reason}, 1)
+			continue
+		}
+		mdp = append(mdp, dps...)
+	}
+	if len(mdp) == 0 {
+		return
+	}
+	if !c.Direct {
+		for _, dp := range mdp {
+			tags := dp.Tags.Copy()
+			if _, ok := tags["host"]; !ok {
+				// Keep collect from adding bosun's host.
+				tags["host"] = ""
+			}
+			collect.Put(dp.Metric[len("bosun."):], tags, dp.Value)
+		}
+		return
+	}
+	for _, dp := range mdp {
+		dp.Timestamp = r.Start.Unix()
+	}
+	go func() {
+		if err := s.putDataPoints(mdp); err != nil {
+			collect.Add("alert_metrics.errors", nil, 1)
+			slog.Errorf("alert metrics: %v", err)
+		}
+	}()
+}
+
+// putDataPoints sends mdp to the OpenTSDB host.
+func (s *Schedule) putDataPoints(mdp opentsdb.MultiDataPoint) error {
+	u := fmt.Sprintf("http://%s/api/put", s.SystemConf.GetTSDBHost())
+	resp, err := collect.SendDataPoints(mdp, u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s: %s", u, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package sched
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestAlertMetrics(t *testing.T) {
+	defer setup()()
+	puts := make(chan opentsdb.MultiDataPoint, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/put" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			return
+		}
+		g, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		var mdp opentsdb.MultiDataPoint
+		if err := json.NewDecoder(g).Decode(&mdp); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		puts <- mdp
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert cpu {
+			$v = merge(series("host=a", 0, 5), series("host=b", 0, 0))
+			crit = max($v) > 1
+		}
+		alert disk {
+			crit = max(series("host=c,disk=sda,dc=ny", 0, 1))
+		}
+		alert quiet {
+			crit = 1
+			noMetrics = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{
+		CheckFrequency:  conf.Duration{Duration: time.Minute},
+		DefaultRunEvery: 1,
+		OpenTSDBConf:    conf.OpenTSDBConf{Host: u.Host},
+		AlertMetricsConf: conf.AlertMetricsConf{
+			Enabled:      true,
+			Direct:       true,
+			Computations: 2,
+			MaxSeries:    1,
+			MaxTags:      4,
+		},
+	}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Each alert is checked and sent separately; only cpu has data points
+	// that are sent, since disk has too many tags and quiet opted out.
+	check(s, now)
+	var mdp opentsdb.MultiDataPoint
+	select {
+	case mdp = <-puts:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no data points were sent")
+	}
+	select {
+	case extra := <-puts:
+		t.Fatalf("unexpected data points: %v", extra)
+	case <-time.After(100 * time.Millisecond):
+	}
+	counts := make(map[string]int)
+	for _, dp := range mdp {
+		counts[dp.Metric]++
+		if dp.Tags["alert"] != "cpu" {
+			t.Errorf("unexpected data point: %v", dp)
+		}
+		if dp.Timestamp != now.Unix() {
+			t.Errorf("got timestamp %d, want the time of the check", dp.Timestamp)
+		}
+		if dp.Metric != "bosun.alert.status" && dp.Tags["severity"] != "crit" {
+			t.Errorf("missing severity: %v", dp)
+		}
+	}
+	// Only one of the two hosts is within MaxSeries.
+	if counts["bosun.alert.status"] != 1 || counts["bosun.alert.value"] != 1 {
+		t.Errorf("bad data points: %v", counts)
+	}
+	if counts["bosun.alert.computation"] < 1 || counts["bosun.alert.computation"] > 2 {
+		t.Errorf("expected one or two computations, got %d", counts["bosun.alert.computation"])
+	}
+}
+
+func TestAlertMetricsPrune(t *testing.T) {
+	am := new(alertMetrics)
+	a, b := models.AlertKey("a{host=a}"), models.AlertKey("a{host=b}")
+	if !am.admit(a, 1) || am.admit(b, 1) {
+		t.Fatal("expected only the first alert key to be admitted")
+	}
+	// Events of other alerts leave those of a alone.
+	am.prune(map[models.AlertKey]*models.Event{"other{host=a}": {}})
+	if am.admit(b, 1) {
+		t.Error("expected a{host=a} to be kept")
+	}
+	// a{host=a} was forgotten, so a{host=b} takes its place.
+	am.prune(map[models.AlertKey]*models.Event{b: {}})
+	if !am.admit(b, 1) || am.admit(a, 1) {
+		t.Error("expected a{host=a} to be pruned")
+	}
+}
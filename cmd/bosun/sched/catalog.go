@@ -0,0 +1,146 @@
+package sched
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.catalog.violations", metadata.Counter, metadata.Count,
+		"Number of indexed data points that do not match the catalogue entry of their metric.")
+}
+
+// catalogLogInterval is how often a violation of the catalogue entry of a
+// metric is logged.
+const catalogLogInterval = 10 * time.Minute
+
+// CatalogViolation is the latest data point of a metric that did not match
+// the catalogue entry of the metric.
+type CatalogViolation struct {
+	Time     time.Time
+	Tags     opentsdb.TagSet
+	Problems []string
+	logged   time.Time
+}
+
+// Catalog caches the metric catalogue to validate data points as they are
+// indexed, and keeps their latest violations.
+type Catalog struct {
+	data database.MetadataDataAccess
+
+	sync.RWMutex
+	loaded     bool
+	entries    map[string]*database.MetricCatalog
+	violations map[string]*CatalogViolation
+}
+
+// NewCatalog returns a Catalog of the entries in data, which are loaded when
+// first needed.
+func NewCatalog(data database.MetadataDataAccess) *Catalog {
+	return &Catalog{
+		data:       data,
+		entries:    make(map[string]*database.MetricCatalog),
+		violations: make(map[string]*CatalogViolation),
+	}
+}
+
+func (c *Catalog) load() {
+	c.RLock()
+	loaded := c.loaded
+	c.RUnlock()
+	if loaded {
+		return
+	}
+	entries, err := c.data.GetMetricCatalogs()
+	if err != nil {
+		slog.Errorf("failed to load metric catalogue: %v", err)
+		return
+	}
+	c.Lock()
+	if !c.loaded {
+		c.entries = entries
+		c.loaded = true
+	}
+	c.Unlock()
+}
+
+// Put validates and stores entry as the catalogue entry of its metric.
+func (c *Catalog) Put(entry *database.MetricCatalog) error {
+	if entry.Metric == "" {
+		return fmt.Errorf("catalogue entry needs a metric")
+	}
+	for _, k := range entry.TagKeys {
+		if !opentsdb.ValidTSDBString(k) {
+			return fmt.Errorf("invalid tag key: %q", k)
+		}
+	}
+	if entry.Runbook != "" && !strings.HasPrefix(entry.Runbook, "http://") && !strings.HasPrefix(entry.Runbook, "https://") {
+		return fmt.Errorf("runbook must be an http or https URL")
+	}
+	if err := c.data.PutMetricCatalog(entry); err != nil {
+		return err
+	}
+	c.load()
+	c.Lock()
+	c.entries[entry.Metric] = entry
+	delete(c.violations, entry.Metric)
+	c.Unlock()
+	return nil
+}
+
+// Violation returns the latest violation of the catalogue entry of metric,
+// or nil if there has been none since the entry last changed.
+func (c *Catalog) Violation(metric string) *CatalogViolation {
+	c.RLock()
+	defer c.RUnlock()
+	return c.violations[metric]
+}
+
+// Validate checks the data points of mdp against the catalogue entries of
+// their metrics. Violations are counted, kept, and logged at most once every
+// catalogLogInterval per metric.
+func (c *Catalog) Validate(mdp opentsdb.MultiDataPoint) {
+	c.load()
+	c.RLock()
+	empty := len(c.entries) == 0
+	c.RUnlock()
+	if empty {
+		return
+	}
+	now := time.Now().UTC()
+	for _, dp := range mdp {
+		c.RLock()
+		entry := c.entries[dp.Metric]
+		c.RUnlock()
+		if entry == nil {
+			continue
+		}
+		problems := entry.Validate(dp.Tags)
+		if len(problems) == 0 {
+			continue
+		}
+		collect.Add("catalog.violations", opentsdb.TagSet{"metric": dp.Metric}, 1)
+		c.Lock()
+		v := &CatalogViolation{Time: now, Tags: dp.Tags, Problems: problems}
+		if prev := c.violations[dp.Metric]; prev != nil {
+			v.logged = prev.logged
+		}
+		log := now.Sub(v.logged) >= catalogLogInterval
+		if log {
+			v.logged = now
+		}
+		c.violations[dp.Metric] = v
+		c.Unlock()
+		if log {
+			slog.Warningf("%s%s violates its catalogue entry: %s", dp.Metric, dp.Tags, strings.Join(problems, "; "))
+		}
+	}
+}
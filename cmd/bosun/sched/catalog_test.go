@@ -0,0 +1,47 @@
+package sched
+
+import (
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestCatalogValidate(t *testing.T) {
+	defer setup()()
+	c := NewCatalog(db.Metadata())
+	if err := c.Put(&database.MetricCatalog{Metric: "os.cpu", Runbook: "wiki/cpu"}); err == nil {
+		t.Error("expected error for runbook that is not a URL")
+	}
+	if err := c.Put(&database.MetricCatalog{Metric: "os.cpu", TagKeys: []string{"host"}, Strict: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Put(&database.MetricCatalog{Metric: "os.old", Deprecated: true}); err != nil {
+		t.Fatal(err)
+	}
+	c.Validate(opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Tags: opentsdb.TagSet{"host": "a"}},
+		{Metric: "os.mem", Tags: opentsdb.TagSet{"dc": "ny"}},
+	})
+	if v := c.Violation("os.cpu"); v != nil {
+		t.Errorf("expected no violation, got %+v", v)
+	}
+	c.Validate(opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Tags: opentsdb.TagSet{"dc": "ny", "user": "x"}},
+		{Metric: "os.old", Tags: opentsdb.TagSet{"host": "a"}},
+	})
+	v := c.Violation("os.cpu")
+	if v == nil || len(v.Problems) != 2 || v.Problems[0] != "missing tag keys: host" || v.Problems[1] != "unexpected tag keys: dc, user" {
+		t.Errorf("bad os.cpu violation: %+v", v)
+	}
+	if v := c.Violation("os.old"); v == nil || v.Problems[0] != "metric is deprecated" {
+		t.Errorf("bad os.old violation: %+v", v)
+	}
+
+	// A new catalog loads the stored entries.
+	c = NewCatalog(db.Metadata())
+	c.Validate(opentsdb.MultiDataPoint{{Metric: "os.cpu", Tags: opentsdb.TagSet{}}})
+	if c.Violation("os.cpu") == nil {
+		t.Error("expected violation from stored entry")
+	}
+}
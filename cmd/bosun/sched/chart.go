@@ -1,15 +1,26 @@
 package sched
 
 import (
+	"fmt"
 	"image"
 	"image/color"
 	"image/png"
 	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/opentsdb"
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/ajstarks/svgo"
 	"github.com/bradfitz/slice"
+	"github.com/kylebrandt/boolq"
+	"github.com/leapar/annotate"
+	"github.com/llgcode/draw2d/draw2dimg"
 	"github.com/vdobler/chart"
 	"github.com/vdobler/chart/imgg"
 	"github.com/vdobler/chart/svgg"
@@ -40,10 +51,51 @@ func Autostyle(i int) chart.Style {
 	}
 }
 
-var white = color.RGBA{0xff, 0xff, 0xff, 0xff}
+var (
+	white           = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	annotationColor = color.NRGBA{0xff, 0xc0, 0x40, 0x50}
+	thresholdColors = map[string]color.Color{
+		"warn": color.NRGBA{0xff, 0x99, 0x00, 0xff},
+		"crit": color.NRGBA{0xd9, 0x1e, 0x18, 0xff},
+	}
+)
+
+// defaultLegendWidth is the number of characters legend entries are
+// shortened to if GraphOptions.LegendWidth is not set.
+const defaultLegendWidth = 60
+
+// GraphOptions sets how the results of an expression are graphed.
+type GraphOptions struct {
+	// Unit labels the y axis.
+	Unit string
+	// Stacked draws the series as stacked areas instead of lines.
+	Stacked bool
+	// Thresholds are drawn as horizontal dashed lines.
+	Thresholds []Threshold
+	// Annotations are drawn as shaded spans, or as vertical lines if they
+	// have no duration.
+	Annotations annotate.Annotations
+	// Axis2 are series drawn against a second y axis on the right, labeled
+	// Unit2.
+	Axis2 []*expr.Result
+	Unit2 string
+	// LegendWidth is the number of characters legend entries are shortened
+	// to. Tags shared by all series are shown once as the title instead.
+	LegendWidth int
+}
+
+// Threshold is a horizontal line of a graph, such as the warn or crit
+// threshold of an alert.
+type Threshold struct {
+	Name  string
+	Value float64
+}
 
-func (s *Schedule) ExprSVG(t miniprofiler.Timer, w io.Writer, width, height int, unit string, res []*expr.Result) error {
-	ch, err := s.ExprGraph(t, unit, res)
+// fillFunc fills the polygon of the given screen coordinates with c.
+type fillFunc func(x, y []int, c color.Color)
+
+func (s *Schedule) ExprSVG(t miniprofiler.Timer, w io.Writer, width, height int, res []*expr.Result, opts GraphOptions) error {
+	ch, err := s.ExprGraph(t, res, opts)
 	if err != nil {
 		return err
 	}
@@ -52,40 +104,429 @@ func (s *Schedule) ExprSVG(t miniprofiler.Timer, w io.Writer, width, height int,
 	g.Rect(0, 0, width, height, "fill: #ffffff")
 	sgr := svgg.AddTo(g, 0, 0, width, height, "", 12, white)
 	ch.Plot(sgr)
+	g.Gstyle("font-family: Helvetica; font-size: 12")
+	ch.plotExtras(sgr, func(x, y []int, c color.Color) {
+		r, gr, b, a := c.RGBA()
+		g.Polygon(x, y, fmt.Sprintf("fill: #%02x%02x%02x; fill-opacity: %.2f; stroke: none", r>>8, gr>>8, b>>8, float64(a)/0xffff))
+	})
+	g.Gend()
 	g.End()
 	return nil
 }
 
-func (s *Schedule) ExprPNG(t miniprofiler.Timer, w io.Writer, width, height int, unit string, res []*expr.Result) error {
-	ch, err := s.ExprGraph(t, unit, res)
+func (s *Schedule) ExprPNG(t miniprofiler.Timer, w io.Writer, width, height int, res []*expr.Result, opts GraphOptions) error {
+	ch, err := s.ExprGraph(t, res, opts)
 	if err != nil {
 		return err
 	}
 	g := image.NewRGBA(image.Rectangle{Min: image.ZP, Max: image.Pt(width, height)})
 	sgr := imgg.AddTo(g, 0, 0, width, height, white, nil, nil)
 	ch.Plot(sgr)
+	ch.plotExtras(sgr, func(x, y []int, c color.Color) {
+		gc := draw2dimg.NewGraphicContext(g)
+		gc.SetFillColor(c)
+		gc.MoveTo(float64(x[0]), float64(y[0]))
+		for i := 1; i < len(x); i++ {
+			gc.LineTo(float64(x[i]), float64(y[i]))
+		}
+		gc.Close()
+		gc.Fill()
+	})
 	return png.Encode(w, g)
 }
 
-func (s *Schedule) ExprGraph(t miniprofiler.Timer, unit string, res []*expr.Result) (chart.Chart, error) {
-	c := chart.ScatterChart{
-		Key:    chart.Key{Pos: "itl"},
-		YRange: chart.Range{Label: unit},
+// exprChart is a scatter chart with the stacked areas, second axis and
+// annotations of its GraphOptions, which the chart package does not
+// support and are drawn over the plotted chart.
+type exprChart struct {
+	chart.ScatterChart
+	opts GraphOptions
+	// layers are the stacked series, from the bottom.
+	layers [][]chart.EPoint
+	// axis2 maps values of the second axis onto the first.
+	axis2 *axisMap
+}
+
+// axisMap linearly maps the range [From0, From1] onto [To0, To1].
+type axisMap struct {
+	From0, From1, To0, To1 float64
+}
+
+func (m *axisMap) Map(v float64) float64 {
+	return m.To0 + (v-m.From0)*(m.To1-m.To0)/(m.From1-m.From0)
+}
+
+func (m *axisMap) Unmap(v float64) float64 {
+	return m.From0 + (v-m.To0)*(m.From1-m.From0)/(m.To1-m.To0)
+}
+
+func (s *Schedule) ExprGraph(t miniprofiler.Timer, res []*expr.Result, opts GraphOptions) (*exprChart, error) {
+	c := &exprChart{
+		ScatterChart: chart.ScatterChart{
+			Key:    chart.Key{Pos: "itl"},
+			YRange: chart.Range{Label: opts.Unit},
+		},
+		opts: opts,
 	}
 	c.XRange.Time = true
-	for ri, r := range res {
-		rv := r.Value.(expr.Series)
-		pts := make([]chart.EPoint, len(rv))
-		idx := 0
-		for k, v := range rv {
-			pts[idx].X = float64(k.Unix())
-			pts[idx].Y = v
-			idx++
-		}
-		slice.Sort(pts, func(i, j int) bool {
-			return pts[i].X < pts[j].X
-		})
-		c.AddData(r.Group.String(), pts, chart.PlotStyleLinesPoints, Autostyle(ri))
-	}
-	return &c, nil
+	groups := make([]opentsdb.TagSet, 0, len(res)+len(opts.Axis2))
+	for _, r := range res {
+		groups = append(groups, r.Group)
+	}
+	for _, r := range opts.Axis2 {
+		groups = append(groups, r.Group)
+	}
+	names, common := legendNames(groups, opts.LegendWidth)
+	if len(groups) > 1 && len(common) > 0 {
+		c.Title = common.String()
+	}
+
+	series := make([][]chart.EPoint, len(res))
+	for i, r := range res {
+		rv, ok := r.Value.(expr.Series)
+		if !ok {
+			return nil, fmt.Errorf("need a series, got %T", r.Value)
+		}
+		series[i] = seriesPoints(rv)
+	}
+	if opts.Stacked {
+		series = stack(series)
+		c.layers = series
+	}
+	plotStyle := chart.PlotStyleLinesPoints
+	if opts.Stacked {
+		plotStyle = chart.PlotStyleLines
+	}
+	for i, pts := range series {
+		c.AddData(names[i], pts, plotStyle, Autostyle(i))
+	}
+
+	xmin, xmax, ymin, ymax := bounds(series)
+	if len(opts.Axis2) > 0 {
+		series2 := make([][]chart.EPoint, len(opts.Axis2))
+		for i, r := range opts.Axis2 {
+			rv, ok := r.Value.(expr.Series)
+			if !ok {
+				return nil, fmt.Errorf("need a series for the second axis, got %T", r.Value)
+			}
+			series2[i] = seriesPoints(rv)
+		}
+		xmin2, xmax2, ymin2, ymax2 := bounds(series2)
+		if math.IsInf(ymin, 1) {
+			ymin, ymax = ymin2, ymax2
+		}
+		if ymax == ymin {
+			ymax = ymin + 1
+		}
+		if ymax2 == ymin2 {
+			ymax2 = ymin2 + 1
+		}
+		c.axis2 = &axisMap{ymin2, ymax2, ymin, ymax}
+		for i, pts := range series2 {
+			for j := range pts {
+				pts[j].Y = c.axis2.Map(pts[j].Y)
+			}
+			style := Autostyle(len(res) + i)
+			style.LineStyle = chart.DashedLine
+			c.AddData(names[len(res)+i]+" (right)", pts, chart.PlotStyleLinesPoints, style)
+		}
+		xmin, xmax = math.Min(xmin, xmin2), math.Max(xmax, xmax2)
+	}
+
+	if !math.IsInf(xmin, 1) {
+		for _, th := range opts.Thresholds {
+			style := Autostyle(0)
+			style.LineColor = thresholdColors[th.Name]
+			if style.LineColor == nil {
+				style.LineColor = chartColors[len(chartColors)-2]
+			}
+			style.LineStyle = chart.DashedLine
+			style.LineWidth = 2
+			pts := []chart.EPoint{{X: xmin, Y: th.Value}, {X: xmax, Y: th.Value}}
+			c.AddData(fmt.Sprintf("%s %v", th.Name, th.Value), pts, chart.PlotStyleLines, style)
+		}
+	}
+	return c, nil
+}
+
+// plotExtras draws the stacked areas, the second axis and the annotations of
+// c onto g, which c has been plotted to.
+func (c *exprChart) plotExtras(g chart.Graphics, fill fillFunc) {
+	if c.XRange.Data2Screen == nil || c.YRange.Data2Screen == nil {
+		return
+	}
+	xf, yf := c.XRange.Data2Screen, c.YRange.Data2Screen
+	right := xf(c.XRange.Max)
+	top, bottom := yf(c.YRange.Max), yf(c.YRange.Min)
+	clampY := func(y float64) int {
+		return yf(math.Max(c.YRange.Min, math.Min(c.YRange.Max, y)))
+	}
+
+	for i, layer := range c.layers {
+		if len(layer) == 0 {
+			continue
+		}
+		var x, y []int
+		for _, p := range layer {
+			x = append(x, xf(p.X))
+			y = append(y, clampY(p.Y))
+		}
+		if i == 0 {
+			x = append(x, xf(layer[len(layer)-1].X), xf(layer[0].X))
+			y = append(y, clampY(0), clampY(0))
+		} else {
+			below := c.layers[i-1]
+			for j := len(below) - 1; j >= 0; j-- {
+				x = append(x, xf(below[j].X))
+				y = append(y, clampY(below[j].Y))
+			}
+		}
+		r, gr, b, _ := chartColors[i%len(chartColors)].RGBA()
+		fill(x, y, color.NRGBA{uint8(r >> 8), uint8(gr >> 8), uint8(b >> 8), 0x60})
+	}
+
+	font := chart.Font{Size: chart.SmallFontSize}
+	for _, a := range c.opts.Annotations {
+		start := float64(a.StartDate.Unix())
+		end := start
+		if !a.EndDate.IsZero() {
+			end = float64(a.EndDate.Unix())
+		}
+		if end < c.XRange.Min || start > c.XRange.Max {
+			continue
+		}
+		x0 := xf(math.Max(start, c.XRange.Min))
+		x1 := xf(math.Min(end, c.XRange.Max))
+		if x1-x0 < 2 {
+			g.Line(x0, top, x0, bottom, chart.Style{LineColor: annotationColor, LineWidth: 2, LineStyle: chart.SolidLine})
+		} else {
+			g.Rect(x0, top, x1-x0, bottom-top, chart.Style{LineColor: annotationColor, LineWidth: 1, FillColor: annotationColor})
+		}
+		if msg := shorten(a.Message, 30); msg != "" {
+			g.Text(x0+2, top+2, msg, "tl", 0, font)
+		}
+	}
+
+	if c.axis2 != nil {
+		ticks := 5
+		style := chart.Style{LineColor: color.Black, LineWidth: 1, LineStyle: chart.SolidLine}
+		g.Line(right, top, right, bottom, style)
+		for i := 0; i < ticks; i++ {
+			v := c.YRange.Min + float64(i)*(c.YRange.Max-c.YRange.Min)/float64(ticks-1)
+			y := yf(v)
+			g.Line(right-4, y, right, y, style)
+			g.Text(right-6, y, strconv.FormatFloat(c.axis2.Unmap(v), 'g', 4, 64), "cr", 0, font)
+		}
+		if c.opts.Unit2 != "" {
+			g.Text(right, top-4, c.opts.Unit2, "br", 0, font)
+		}
+	}
+}
+
+// seriesPoints returns the points of s ordered by time.
+func seriesPoints(s expr.Series) []chart.EPoint {
+	pts := make([]chart.EPoint, 0, len(s))
+	for k, v := range s {
+		pts = append(pts, chart.EPoint{X: float64(k.Unix()), Y: v})
+	}
+	slice.Sort(pts, func(i, j int) bool {
+		return pts[i].X < pts[j].X
+	})
+	return pts
+}
+
+// stack returns the cumulative sums of series at the union of their
+// timestamps. A series missing a timestamp counts with its previous value,
+// or 0 before its first point.
+func stack(series [][]chart.EPoint) [][]chart.EPoint {
+	seen := make(map[float64]bool)
+	var xs []float64
+	for _, pts := range series {
+		for _, p := range pts {
+			if !seen[p.X] {
+				seen[p.X] = true
+				xs = append(xs, p.X)
+			}
+		}
+	}
+	sort.Float64s(xs)
+	sums := make([]float64, len(xs))
+	stacked := make([][]chart.EPoint, len(series))
+	for i, pts := range series {
+		layer := make([]chart.EPoint, len(xs))
+		j, last := 0, 0.0
+		for k, x := range xs {
+			for j < len(pts) && pts[j].X <= x {
+				last = pts[j].Y
+				j++
+			}
+			sums[k] += last
+			layer[k] = chart.EPoint{X: x, Y: sums[k]}
+		}
+		stacked[i] = layer
+	}
+	return stacked
+}
+
+// bounds returns the smallest and largest x and y of series, which are
+// infinite if series have no points.
+func bounds(series [][]chart.EPoint) (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	for _, pts := range series {
+		for _, p := range pts {
+			xmin, xmax = math.Min(xmin, p.X), math.Max(xmax, p.X)
+			ymin, ymax = math.Min(ymin, p.Y), math.Max(ymax, p.Y)
+		}
+	}
+	return
+}
+
+// legendNames returns the legend entries of series with the given groups.
+// When there are several series, the tags they all share are left out of
+// their entries and returned as common. Entries longer than width are
+// shortened by eliding the middle of their longest tag values, then cut.
+func legendNames(groups []opentsdb.TagSet, width int) (names []string, common opentsdb.TagSet) {
+	if width <= 0 {
+		width = defaultLegendWidth
+	}
+	common = make(opentsdb.TagSet)
+	if len(groups) > 1 {
+		for k, v := range groups[0] {
+			shared := true
+			for _, g := range groups[1:] {
+				if gv, ok := g[k]; !ok || gv != v {
+					shared = false
+					break
+				}
+			}
+			if shared {
+				common[k] = v
+			}
+		}
+	}
+	names = make([]string, len(groups))
+	for i, g := range groups {
+		tags := make(opentsdb.TagSet)
+		for k, v := range g {
+			if _, ok := common[k]; !ok {
+				tags[k] = v
+			}
+		}
+		names[i] = legendName(tags, width)
+	}
+	return names, common
+}
+
+// minLegendValue is the length tag values are not elided below.
+const minLegendValue = 6
+
+func legendName(tags opentsdb.TagSet, width int) string {
+	name := tags.String()
+	for length := utf8.RuneCountInString(name); length > width; {
+		var longest string
+		var longestLen int
+		for _, v := range tags {
+			n := utf8.RuneCountInString(v)
+			if n > longestLen || n == longestLen && v < longest {
+				longest, longestLen = v, n
+			}
+		}
+		if longestLen <= minLegendValue {
+			break
+		}
+		n := longestLen - (length - width)
+		if n < minLegendValue {
+			n = minLegendValue
+		}
+		short := elide(longest, n)
+		for k, v := range tags {
+			if v == longest {
+				tags[k] = short
+			}
+		}
+		name = tags.String()
+		prev := length
+		if length = utf8.RuneCountInString(name); length >= prev {
+			break
+		}
+	}
+	return shorten(name, width)
+}
+
+// elide returns s shortened to n characters by replacing its middle with
+// "..".
+func elide(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	head := (n - 1) / 2
+	tail := n - 2 - head
+	return string(r[:head]) + ".." + string(r[len(r)-tail:])
+}
+
+// shorten cuts s to n characters, ending with ".." if it was cut.
+func shorten(s string, n int) string {
+	s = strings.TrimSpace(s)
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	if n <= 2 {
+		return string(r[:n])
+	}
+	return string(r[:n-2]) + ".."
+}
+
+// annotations returns the annotations between start and end that match
+// filter, if it is set.
+func (s *Schedule) annotations(start, end time.Time, filter string) (annotate.Annotations, error) {
+	if s.annotate == nil {
+		return nil, fmt.Errorf("graph: annotations are not enabled")
+	}
+	all, err := s.annotate.GetAnnotations(&start, &end)
+	if err != nil {
+		return nil, err
+	}
+	if filter == "" {
+		return all, nil
+	}
+	t, err := boolq.Parse(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse annotation filter: %v", err)
+	}
+	var annotations annotate.Annotations
+	for _, a := range all {
+		match, err := boolq.AskParsedExpr(t, a)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			annotations = append(annotations, a)
+		}
+	}
+	return annotations, nil
+}
+
+// graphRange returns the time span of the series of res.
+func graphRange(res ...[]*expr.Result) (start, end time.Time, ok bool) {
+	for _, rs := range res {
+		for _, r := range rs {
+			s, isSeries := r.Value.(expr.Series)
+			if !isSeries {
+				continue
+			}
+			for t := range s {
+				if !ok || t.Before(start) {
+					start = t
+				}
+				if !ok || t.After(end) {
+					end = t
+				}
+				ok = true
+			}
+		}
+	}
+	return
 }
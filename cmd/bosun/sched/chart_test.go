@@ -0,0 +1,146 @@
+package sched
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leapar/annotate"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/vdobler/chart"
+)
+
+func TestLegendNames(t *testing.T) {
+	groups := []opentsdb.TagSet{
+		{"host": "ny-web01", "dc": "ny", "service": "a-very-long-service-name-that-goes-on"},
+		{"host": "ny-web02", "dc": "ny", "service": "a-very-long-service-name-that-goes-on"},
+	}
+	names, common := legendNames(groups, 0)
+	if common.String() != "{dc=ny,service=a-very-long-service-name-that-goes-on}" {
+		t.Errorf("bad common tags: %s", common)
+	}
+	if names[0] != "{host=ny-web01}" || names[1] != "{host=ny-web02}" {
+		t.Errorf("bad names: %v", names)
+	}
+
+	names, common = legendNames(groups[:1], 30)
+	if len(common) != 0 {
+		t.Errorf("expected no common tags for a single series, got %s", common)
+	}
+	if names[0] != "{dc=ny,host=ny..01,service=a.." {
+		t.Errorf("expected the tag values to be elided: %s", names[0])
+	}
+
+	names, _ = legendNames([]opentsdb.TagSet{{"a": "1", "b": "2", "c": "3", "d": "4"}}, 10)
+	if names[0] != "{a=1,b=2.." {
+		t.Errorf("expected name to be cut: %s", names[0])
+	}
+
+	names, _ = legendNames([]opentsdb.TagSet{{"host": "東京-web01", "service": "サービス-のながいなまえ"}}, 30)
+	if names[0] != "{host=東京-web01,service=サー..まえ}" {
+		t.Errorf("expected the tag values to be elided by character: %s", names[0])
+	}
+}
+
+func TestStack(t *testing.T) {
+	stacked := stack([][]chart.EPoint{
+		{{X: 1, Y: 1}, {X: 3, Y: 2}},
+		{{X: 2, Y: 10}, {X: 3, Y: 20}},
+	})
+	want := [][]chart.EPoint{
+		{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 2}},
+		{{X: 1, Y: 1}, {X: 2, Y: 11}, {X: 3, Y: 22}},
+	}
+	for i := range want {
+		for j := range want[i] {
+			if stacked[i][j].X != want[i][j].X || stacked[i][j].Y != want[i][j].Y {
+				t.Fatalf("layer %d: got %v, want %v", i, stacked[i], want[i])
+			}
+		}
+	}
+}
+
+func TestThresholdNode(t *testing.T) {
+	tests := map[string]string{
+		`avg(q("avg:m", "1h", "")) > 90`:                        "90",
+		`5 >= avg(q("avg:m", "1h", ""))`:                        "5",
+		`avg(q("avg:m", "1h", "")) < -5`:                        "-5",
+		`avg(q("avg:m", "1h", "")) == 1`:                        "",
+		`avg(q("avg:m", "1h", "")) > avg(q("avg:n", "1h", ""))`: "",
+	}
+	for text, want := range tests {
+		e, err := expr.New(text, expr.TSDB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got string
+		if n := thresholdNode(e); n != nil {
+			got = n.String()
+		}
+		if got != want {
+			t.Errorf("%s: got %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestExprGraph(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	series := func(vals ...float64) expr.Series {
+		s := make(expr.Series)
+		for i, v := range vals {
+			s[now.Add(time.Duration(i)*time.Minute)] = v
+		}
+		return s
+	}
+	res := []*expr.Result{
+		{Group: opentsdb.TagSet{"host": "a"}, Value: series(1, 2, 3)},
+		{Group: opentsdb.TagSet{"host": "b"}, Value: series(4, 5, 6)},
+	}
+	opts := GraphOptions{
+		Unit:       "requests",
+		Stacked:    true,
+		Thresholds: []Threshold{{Name: "crit", Value: 12}},
+		Annotations: annotate.Annotations{{
+			Message:   "deploy",
+			StartDate: annotate.Time{Time: now.Add(time.Minute)},
+		}},
+		Axis2: []*expr.Result{{Group: opentsdb.TagSet{"host": "a"}, Value: series(100, 200, 300)}},
+		Unit2: "ms",
+	}
+	s := new(Schedule)
+	ch, err := s.ExprGraph(nil, res, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, e := range ch.Key.Entries {
+		keys = append(keys, e.Text)
+	}
+	if got := strings.Join(keys, "|"); got != "{host=a}|{host=b}|{host=a} (right)|crit 12" {
+		t.Errorf("bad legend: %s", got)
+	}
+	if ch.axis2 == nil || ch.axis2.Map(300) != 9 {
+		t.Errorf("expected the second axis to map onto the stacked range, got %+v", ch.axis2)
+	}
+	var buf bytes.Buffer
+	if err := s.ExprSVG(nil, &buf, 800, 600, res, opts); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<polygon", "deploy", ">ms<"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("svg is missing %s", want)
+		}
+	}
+	buf.Reset()
+	if err := s.ExprPNG(nil, &buf, 400, 300, res, opts); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == 0 {
+		t.Error("empty png")
+	}
+	if _, err := s.ExprGraph(nil, []*expr.Result{{Value: expr.Number(1)}}, GraphOptions{}); err == nil {
+		t.Error("expected error graphing a number")
+	}
+}
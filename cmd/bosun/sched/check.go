@@ -90,12 +90,10 @@ func (s *Schedule) RunHistory(r *RunHistory) {
 			slog.Errorf("Error in runHistory for %s. %s.", ak, err)
 		}
 	}
-	if checkNotify && s.nc != nil {
-		select {
-		case s.nc <- true:
-		default:
-		}
+	if checkNotify {
+		s.signalNotifications()
 	}
+	s.sendAlertMetrics(r)
 }
 
 // RunHistory for a single alert key. Returns true if notifications were altered.
@@ -123,10 +121,16 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		return
 	}
 
+	// previous is the status of the incident before this run, if it
+	// existed, and is compared to its status after to publish changes.
+	previous := models.StNone
 	defer func() {
 		// save unless incident is new and closed (log alert)
 		if incident != nil && (incident.Id != 0 || incident.Open) {
 			_, err = data.UpdateIncidentState(incident)
+			if err == nil && incident.CurrentStatus != previous {
+				s.publishIncident(incident, previous)
+			}
 			err = data.SetRenderedTemplates(incident.Id, rt)
 		} else {
 			err = data.SetUnevaluated(ak, event.Unevaluated) // if nothing to save, at least store the unevaluated state
@@ -136,6 +140,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		}
 	}()
 	if incident != nil {
+		previous = incident.CurrentStatus
 		rt, err = data.GetRenderedTemplates(incident.Id)
 		if err != nil {
 			return
@@ -291,6 +296,10 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		case models.StWarning:
 			notify(a.WarnNotification)
 		}
+		if a.Escalation != nil && !a.Log {
+			s.startEscalation(a.Escalation, incident, rt)
+			checkNotify = true
+		}
 	}
 
 	// lock while we change notifications.
@@ -625,6 +634,7 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 		return true
 	}
 	unevalCount, unknownCount := markDependenciesUnevaluated(r.Events, deps, a.Name)
+	unevalCount += s.markTopologyUnevaluated(r.Events, a.Name)
 	if err != nil {
 		slog.Errorf("Error checking alert %s: %s", a.Name, err.Error())
 		removeUnknownEvents(r.Events, a.Name)
@@ -695,6 +705,9 @@ func (s *Schedule) executeExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Ale
 		Squelched: s.RuleConf.AlertSquelched(a),
 		History:   s,
 		Annotate:  s.annotate,
+
+		QueryCache: s.QueryCache,
+		Inventory:  s.DataAccess.Inventory(),
 	}
 	results, _, err := e.Execute(rh.Backends, providers, T, rh.Start, 0, a.UnjoinedOK)
 	return results, err
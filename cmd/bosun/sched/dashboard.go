@@ -0,0 +1,145 @@
+package sched
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+// Dashboards returns the dashboards of the rule configuration and those
+// stored through the API, ordered by name. A stored dashboard is hidden by a
+// dashboard of the configuration with the same name.
+func (s *Schedule) Dashboards() ([]*models.Dashboard, error) {
+	stored, err := s.DataAccess.Dashboards().GetDashboards()
+	if err != nil {
+		return nil, err
+	}
+	defined := s.RuleConf.GetDashboards()
+	dashboards := make([]*models.Dashboard, 0, len(defined)+len(stored))
+	for _, d := range defined {
+		dashboards = append(dashboards, &d.Dashboard)
+	}
+	for _, d := range stored {
+		if _, ok := defined[d.Name]; !ok {
+			dashboards = append(dashboards, d)
+		}
+	}
+	sort.Slice(dashboards, func(i, j int) bool { return dashboards[i].Name < dashboards[j].Name })
+	return dashboards, nil
+}
+
+// Dashboard returns the dashboard with the given name, or nil if there is
+// none.
+func (s *Schedule) Dashboard(name string) (*models.Dashboard, error) {
+	if d := s.RuleConf.GetDashboard(name); d != nil {
+		return &d.Dashboard, nil
+	}
+	return s.DataAccess.Dashboards().GetDashboard(name)
+}
+
+// PutDashboard validates and stores d. Dashboards of the rule configuration
+// can only be changed by editing it.
+func (s *Schedule) PutDashboard(d *models.Dashboard) error {
+	if s.RuleConf.GetDashboard(d.Name) != nil {
+		return fmt.Errorf("dashboard %s is defined in the rule configuration", d.Name)
+	}
+	d.Source = models.DashboardAPI
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	for _, p := range d.Panels {
+		text, err := s.DashboardExpr(d, p, nil)
+		if err != nil {
+			return err
+		}
+		e, err := expr.New(text, s.RuleConf.GetFuncs(s.SystemConf.EnabledBackends()))
+		if err != nil {
+			return fmt.Errorf("panel %s: %v", p.Name, err)
+		}
+		if err := p.CheckReturn(e.Root.Return()); err != nil {
+			return err
+		}
+	}
+	return s.DataAccess.Dashboards().PutDashboard(d)
+}
+
+// DeleteDashboard removes the stored dashboard with the given name.
+func (s *Schedule) DeleteDashboard(name string) error {
+	if s.RuleConf.GetDashboard(name) != nil {
+		return fmt.Errorf("dashboard %s is defined in the rule configuration", name)
+	}
+	return s.DataAccess.Dashboards().DeleteDashboard(name)
+}
+
+// DashboardExpr returns the expression of panel p of d with the variables of
+// d set to values, or to their defaults if missing. Values are tag values,
+// tag value globs or alternatives separated by |; anything else is an error.
+func (s *Schedule) DashboardExpr(d *models.Dashboard, p *models.DashboardPanel, values map[string]string) (text string, err error) {
+	for _, v := range d.Variables {
+		if val, ok := values[v.Name]; ok && val != "" && !validDashboardValue(val) {
+			return "", fmt.Errorf("dashboard %s: invalid value for variable %s: %q", d.Name, v.Name, val)
+		}
+	}
+	// Expand panics on unknown variables.
+	defer func() {
+		if pan := recover(); pan != nil {
+			err = fmt.Errorf("panel %s: %v", p.Name, pan)
+		}
+	}()
+	return s.RuleConf.Expand(p.Expr, d.Vars(values), false), nil
+}
+
+// validDashboardValue returns whether val can be substituted in a tag
+// filter: each of its |-separated alternatives is a valid tag value once its
+// * wildcards are removed.
+func validDashboardValue(val string) bool {
+	for _, alt := range strings.Split(val, "|") {
+		alt = strings.Replace(alt, "*", "", -1)
+		if alt != "" && !opentsdb.ValidTSDBString(alt) {
+			return false
+		}
+	}
+	return true
+}
+
+// DashboardValues returns the values of each variable of d: the values of
+// its tag key seen within since by the search index.
+func (s *Schedule) DashboardValues(d *models.Dashboard, uid string, since time.Duration) (map[string][]string, error) {
+	values := make(map[string][]string, len(d.Variables))
+	for _, v := range d.Variables {
+		var vals []string
+		var err error
+		if v.Metric != "" {
+			vals, err = s.Search.TagValuesByMetricTagKey(v.Metric, v.TagKey, uid, since)
+		} else {
+			vals, err = s.Search.TagValuesByTagKey(v.TagKey, uid, since)
+		}
+		if err != nil {
+			return nil, err
+		}
+		values[v.Name] = vals
+	}
+	return values, nil
+}
+
+// DashboardLink returns the permalink of d with its variables set to values,
+// viewed at now.
+func DashboardLink(sc conf.SystemConfProvider, d *models.Dashboard, values map[string]string, now time.Time) string {
+	p := url.Values{}
+	p.Add("name", d.Name)
+	for _, v := range d.Variables {
+		if val, ok := values[v.Name]; ok {
+			p.Add("var-"+v.Name, val)
+		}
+	}
+	p.Add("date", now.Format(`2006-01-02`))
+	p.Add("time", now.Format(`15:04:05`))
+	return sc.MakeLink("/dashboards", &p)
+}
@@ -0,0 +1,120 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/models"
+)
+
+func TestDashboards(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		dashboard web {
+			variable host {
+				metric = os.cpu
+			}
+			panel cpu {
+				expr = q("avg:os.cpu{host=$host}", "1h", "")
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{
+		CheckFrequency:  conf.Duration{Duration: time.Minute},
+		DefaultRunEvery: 1,
+		OpenTSDBConf:    conf.OpenTSDBConf{Host: "localhost:4242"},
+	}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	panel := []*models.DashboardPanel{{Name: "load", Type: "table", Expr: `avg(q("avg:os.load{host=$host}", "1h", ""))`}}
+	if err := s.PutDashboard(&models.Dashboard{Name: "web", Panels: panel}); err == nil {
+		t.Error("expected error replacing a dashboard of the configuration")
+	}
+	bad := []*models.DashboardPanel{{Name: "load", Type: "graph", Expr: `avg(q("avg:os.load", "1h", ""))`}}
+	if err := s.PutDashboard(&models.Dashboard{Name: "db", Panels: bad}); err == nil {
+		t.Error("expected error for a graph of numbers")
+	}
+	if err := s.PutDashboard(&models.Dashboard{Name: "db", Panels: panel}); err == nil {
+		t.Error("expected error for an unknown variable")
+	}
+	db := &models.Dashboard{
+		Name:      "db",
+		Variables: []*models.DashboardVariable{{Name: "host", TagKey: "host"}},
+		Panels:    panel,
+	}
+	if err := s.PutDashboard(db); err != nil {
+		t.Fatal(err)
+	}
+	all, err := s.Dashboards()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Name != "db" || all[0].Source != models.DashboardAPI || all[1].Name != "web" || all[1].Source != models.DashboardConfig {
+		t.Fatalf("bad dashboards: %+v", all)
+	}
+
+	web, err := s.Dashboard("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := s.DashboardExpr(web, web.Panels[0], map[string]string{"host": "ny-web01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `q("avg:os.cpu{host=ny-web01}", "1h", "")`; text != want {
+		t.Errorf("got expression %s, expected %s", text, want)
+	}
+	for _, val := range []string{"ny-web*", "ny-web01|ny-db01", "*"} {
+		if _, err := s.DashboardExpr(web, web.Panels[0], map[string]string{"host": val}); err != nil {
+			t.Errorf("%s: %v", val, err)
+		}
+	}
+	for _, val := range []string{`a}", "1h", ""), q("sum:os.mem{host=*`, `x")`, "a b", "a,b=c"} {
+		if _, err := s.DashboardExpr(web, web.Panels[0], map[string]string{"host": val}); err == nil {
+			t.Errorf("%s: expected error", val)
+		}
+	}
+	now := time.Now().Unix()
+	sd := s.DataAccess.Search()
+	for _, err := range []error{
+		sd.AddTagValue("os.cpu", "host", "ny-web01", "", now),
+		sd.AddTagValue("os.mem", "host", "ny-db01", "", now),
+		sd.AddTagValue(database.Search_All, "host", "ny-web01", "", now),
+		sd.AddTagValue(database.Search_All, "host", "ny-db01", "", now),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	values, err := s.DashboardValues(web, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values["host"]) != 1 || values["host"][0] != "ny-web01" {
+		t.Errorf("bad values: %v", values)
+	}
+	values, err = s.DashboardValues(db, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values["host"]) != 2 {
+		t.Errorf("expected values of all metrics, got %v", values)
+	}
+
+	if err := s.DeleteDashboard("web"); err == nil {
+		t.Error("expected error deleting a dashboard of the configuration")
+	}
+	if err := s.DeleteDashboard("db"); err != nil {
+		t.Fatal(err)
+	}
+	if d, err := s.Dashboard("db"); err != nil || d != nil {
+		t.Errorf("expected db to be deleted, got %v, %v", d, err)
+	}
+}
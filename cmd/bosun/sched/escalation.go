@@ -0,0 +1,96 @@
+package sched
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+// escalationPrefix starts the names of queued escalations in the notification
+// queue. It cannot appear in notification names, so the two never collide.
+const escalationPrefix = "@escalate"
+
+func escalationQueueName(level int) string {
+	return fmt.Sprintf("%s%d", escalationPrefix, level)
+}
+
+// parseEscalationQueueName returns the level of a queued escalation, or false
+// if name is that of a notification.
+func parseEscalationQueueName(name string) (int, bool) {
+	if !strings.HasPrefix(name, escalationPrefix) {
+		return 0, false
+	}
+	level, err := strconv.Atoi(name[len(escalationPrefix):])
+	return level, err == nil
+}
+
+// queueEscalation persists the escalation of ak to level at the given time.
+func (s *Schedule) queueEscalation(ak models.AlertKey, level int, at time.Time) error {
+	return s.DataAccess.Notifications().InsertNotification(ak, escalationQueueName(level), at)
+}
+
+// startEscalation notifies the first level of the escalation policy of a new
+// or worsened incident and queues the second. The caller saves the incident.
+func (s *Schedule) startEscalation(e *conf.Escalation, st *models.IncidentState, rt *models.RenderedTemplates) {
+	if st.Team == "" {
+		st.Team = e.Team
+	}
+	st.EscalationLevel = 1
+	for _, n := range e.Level(1) {
+		s.Notify(st, rt, n)
+	}
+	if len(e.Levels) > 1 {
+		if err := s.queueEscalation(st.AlertKey, 2, utcNow().Add(e.Timeout)); err != nil {
+			slog.Errorf("queueing escalation of %s: %v", st.AlertKey, err)
+		}
+	}
+}
+
+// escalate notifies a queued escalation level of the incident of ak, unless
+// the incident has since been acknowledged or closed, and queues the next
+// level.
+func (s *Schedule) escalate(ak models.AlertKey, level int) error {
+	alert := s.RuleConf.GetAlert(ak.Name())
+	if alert == nil || alert.Escalation == nil {
+		return nil
+	}
+	e := alert.Escalation
+	notifications := e.Level(level)
+	if notifications == nil {
+		return nil
+	}
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		return err
+	}
+	if st == nil || !st.Open || !st.NeedAck {
+		return nil
+	}
+	rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
+	if err != nil {
+		return err
+	}
+	now := utcNow()
+	st.EscalationLevel = level
+	st.Actions = append(st.Actions, models.Action{
+		User:    "bosun",
+		Message: fmt.Sprintf("escalated to level %d of %s", level, e.Name),
+		Time:    now,
+		Type:    models.ActionEscalate,
+	})
+	if _, err := s.DataAccess.State().UpdateIncidentState(st); err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		s.Notify(st, rt, n)
+	}
+	if level < len(e.Levels) {
+		return s.queueEscalation(ak, level+1, now.Add(e.Timeout))
+	}
+	return nil
+}
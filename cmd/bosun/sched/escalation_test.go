@@ -0,0 +1,120 @@
+package sched
+
+import (
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+)
+
+func TestEscalation(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		template t {
+			subject = s
+		}
+		notification n1 {
+			print = true
+		}
+		notification n2 {
+			print = true
+		}
+		escalation e {
+			team = ops
+			timeout = 5m
+			level1 = n1
+			level2 = n2
+		}
+		alert a {
+			template = t
+			crit = 1
+			escalation = e
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("a{host=x}")
+	da := s.DataAccess.State()
+	id, err := da.UpdateIncidentState(&models.IncidentState{AlertKey: ak, Alert: ak.Name(), Tags: ak.Group().Tags(), Open: true, NeedAck: true, EscalationLevel: 1, WorstStatus: models.StCritical, Events: []models.Event{{Status: models.StCritical}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := da.SetRenderedTemplates(id, &models.RenderedTemplates{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.AssignByIncidentId("u", "", "", "", id); err == nil {
+		t.Error("expected error assigning to nobody")
+	}
+	if _, err := s.AssignByIncidentId("u", "mine", "alice", "dev", id); err != nil {
+		t.Fatal(err)
+	}
+	st, _ := da.GetIncidentState(id)
+	if st.Assignee != "alice" || st.Team != "dev" {
+		t.Errorf("bad assignment: %q %q", st.Assignee, st.Team)
+	}
+	if a := st.Actions[len(st.Actions)-1]; a.Type != models.ActionAssign || a.Assignee != "alice" {
+		t.Errorf("bad assign action: %+v", a)
+	}
+	if _, err := s.ActionByIncidentId("u", "", models.ActionUnassign, nil, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ActionByIncidentId("u", "", models.ActionUnassign, nil, id); err == nil {
+		t.Error("expected error unassigning unassigned incident")
+	}
+
+	// Manual escalation queues the next level now.
+	if _, err := s.ActionByIncidentId("u", "help", models.ActionEscalate, nil, id); err != nil {
+		t.Fatal(err)
+	}
+	due, err := s.DataAccess.Notifications().GetDueNotifications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := due[ak][escalationQueueName(2)]; !ok {
+		t.Fatalf("expected level 2 to be queued, got %v", due)
+	}
+	if err := s.escalate(ak, 2); err != nil {
+		t.Fatal(err)
+	}
+	st, _ = da.GetIncidentState(id)
+	if st.EscalationLevel != 2 {
+		t.Errorf("expected escalation level 2, got %d", st.EscalationLevel)
+	}
+	if len(s.pendingNotifications[c.Notifications["n2"]]) != 1 {
+		t.Errorf("expected n2 to be notified, got %v", s.pendingNotifications)
+	}
+	if _, err := s.ActionByIncidentId("u", "", models.ActionEscalate, nil, id); err == nil {
+		t.Error("expected error escalating past the last level")
+	}
+
+	// Acknowledged incidents are not escalated.
+	s.pendingNotifications = nil
+	if _, err := s.ActionByIncidentId("u", "", models.ActionAcknowledge, nil, id); err != nil {
+		t.Fatal(err)
+	}
+	st, _ = da.GetIncidentState(id)
+	st.EscalationLevel = 1
+	da.UpdateIncidentState(st)
+	if err := s.escalate(ak, 2); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.pendingNotifications) != 0 {
+		t.Errorf("expected no notifications, got %v", s.pendingNotifications)
+	}
+}
+
+func TestEscalationQueueName(t *testing.T) {
+	if level, ok := parseEscalationQueueName(escalationQueueName(3)); !ok || level != 3 {
+		t.Errorf("got %d %v", level, ok)
+	}
+	if _, ok := parseEscalationQueueName("n1"); ok {
+		t.Error("notification parsed as escalation")
+	}
+}
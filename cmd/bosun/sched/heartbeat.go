@@ -0,0 +1,100 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+// Stale is a group of tags of a heartbeat whose metric has not been seen for
+// longer than the threshold, but not yet for longer than forget.
+type Stale struct {
+	Heartbeat string
+	Metric    string
+	Tags      opentsdb.TagSet
+	LastSeen  time.Time
+	// Incident is the id of the open incident of the group, or 0 if there
+	// is none.
+	Incident int64 `json:",omitempty"`
+}
+
+// StaleGroups returns the stale groups of all heartbeats as of now, ordered
+// by heartbeat and then by when they were last seen.
+func (s *Schedule) StaleGroups(now time.Time) ([]*Stale, error) {
+	stale := []*Stale{}
+	for _, a := range s.RuleConf.GetAlerts() {
+		hb := a.Heartbeat
+		if hb == nil {
+			continue
+		}
+		for _, seen := range s.Search.LastSeen(hb.Metric, hb.Tags) {
+			age := now.Sub(time.Unix(seen.Timestamp, 0))
+			if age <= hb.Threshold || (hb.Forget > 0 && age > hb.Forget) {
+				continue
+			}
+			st := &Stale{
+				Heartbeat: a.Name,
+				Metric:    hb.Metric,
+				Tags:      seen.Group,
+				LastSeen:  time.Unix(seen.Timestamp, 0).UTC(),
+			}
+			inc, err := s.DataAccess.State().GetOpenIncident(models.NewAlertKey(a.Name, seen.Group))
+			if err != nil {
+				return nil, err
+			}
+			if inc != nil {
+				st.Incident = inc.Id
+			}
+			stale = append(stale, st)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].Heartbeat != stale[j].Heartbeat {
+			return stale[i].Heartbeat < stale[j].Heartbeat
+		}
+		return stale[i].LastSeen.Before(stale[j].LastSeen)
+	})
+	return stale, nil
+}
+
+// forgetHeartbeat forgets the alert keys of the heartbeat a whose group has
+// not been seen for longer than forget as of now, so that decommissioned
+// hosts no longer have incidents or states.
+func (s *Schedule) forgetHeartbeat(a *conf.Alert, now time.Time) {
+	hb := a.Heartbeat
+	if hb == nil || hb.Forget == 0 {
+		return
+	}
+	seen := make(map[string]int64)
+	for _, g := range s.Search.LastSeen(hb.Metric, hb.Tags) {
+		seen[g.Group.String()] = g.Timestamp
+	}
+	data := s.DataAccess.State()
+	keys, err := data.GetUntouchedSince(a.Name, utcNow().Unix())
+	if err != nil {
+		slog.Errorf("Error finding alert keys of heartbeat %s: %s.", a.Name, err)
+		return
+	}
+	for _, ak := range keys {
+		if ts, ok := seen[ak.Group().String()]; ok && now.Sub(time.Unix(ts, 0)) <= hb.Forget {
+			continue
+		}
+		st, err := data.GetLatestIncident(ak)
+		if err == nil {
+			if st == nil {
+				err = data.Forget(ak)
+			} else {
+				msg := fmt.Sprintf("forgotten because %s has not been seen for longer than %v", hb.Metric, hb.Forget)
+				_, err = s.action("bosun", msg, models.ActionPurge, nil, st)
+			}
+		}
+		if err != nil {
+			slog.Errorf("Error forgetting %s: %s.", ak, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestHeartbeat(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		heartbeat hb {
+			metric = os.cpu
+			threshold = 10m
+			forget = 1d
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{CheckFrequency: conf.Duration{Duration: time.Minute}, DefaultRunEvery: 1}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := queryTime.Unix()
+	s.Search.Index(opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Timestamp: now - 60, Value: 1, Tags: opentsdb.TagSet{"host": "web01"}},
+		{Metric: "os.cpu", Timestamp: now - 3600, Value: 1, Tags: opentsdb.TagSet{"host": "web02"}},
+		{Metric: "os.cpu", Timestamp: now - 2*86400, Value: 1, Tags: opentsdb.TagSet{"host": "db01"}},
+	})
+	gone := models.NewAlertKey("hb", opentsdb.TagSet{"host": "db01"})
+	if err := s.DataAccess.State().TouchAlertKey(gone, queryTime.Add(-48*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	check(s, queryTime)
+
+	stale, err := s.StaleGroups(queryTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stale) != 1 || stale[0].Tags["host"] != "web02" || stale[0].Incident == 0 {
+		t.Fatalf("expected web02 to be stale with an incident, got %+v", stale)
+	}
+	inc, err := s.DataAccess.State().GetOpenIncident(models.NewAlertKey("hb", opentsdb.TagSet{"host": "web01"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inc != nil {
+		t.Errorf("expected no incident for web01, got %v", inc.Id)
+	}
+	keys, err := s.DataAccess.State().GetUntouchedSince("hb", utcNow().Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ak := range keys {
+		if ak == gone {
+			t.Errorf("expected %s to be forgotten", gone)
+		}
+	}
+}
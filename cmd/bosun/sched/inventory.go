@@ -0,0 +1,126 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+)
+
+// runInventory snapshots the inventory of hosts every interval of the
+// InventoryConf.
+func (s *Schedule) runInventory() {
+	interval, retention := s.SystemConf.GetInventoryConf()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+		}
+		now := utcNow()
+		if err := s.SnapshotInventory(now); err != nil {
+			slog.Errorf("failed to snapshot host inventory: %v", err)
+		}
+		if retention > 0 {
+			if err := s.DataAccess.Inventory().ClearInventoryChangesBefore(now.Add(-retention)); err != nil {
+				slog.Errorf("failed to clear old inventory changes: %v", err)
+			}
+		}
+	}
+}
+
+// SnapshotInventory stores the inventory of every host as of now, and
+// records how it differs from the previous snapshot. The first snapshot of
+// a host records no changes.
+func (s *Schedule) SnapshotInventory(now time.Time) error {
+	hosts, err := s.Host("", s.SystemConf.GetUid())
+	if err != nil {
+		return err
+	}
+	data := s.DataAccess.Inventory()
+	for name, h := range hosts {
+		inv := &models.Inventory{Host: name, Time: now, Fields: inventoryFields(h)}
+		old, err := data.GetInventory(name)
+		if err != nil {
+			return err
+		}
+		var changes []*models.InventoryChange
+		if old != nil {
+			changes = inv.Diff(old)
+		}
+		if old != nil && len(changes) == 0 {
+			continue
+		}
+		if err := data.SetInventory(inv, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inventoryFields returns the fields of h that describe the hardware and
+// software of the host, leaving out usage and status that change on their
+// own.
+func inventoryFields(h *HostData) map[string]string {
+	f := make(map[string]string)
+	set := func(name string, v interface{}) {
+		s := fmt.Sprint(v)
+		if s != "" && s != "0" {
+			f[name] = s
+		}
+	}
+	list := func(name string, vs []string) {
+		vs = append([]string(nil), vs...)
+		sort.Strings(vs)
+		set(name, strings.Join(vs, ","))
+	}
+	set("cpu.logical", h.CPU.Logical)
+	set("cpu.physical", h.CPU.Physical)
+	for k, v := range h.CPU.Processors {
+		set("cpu.processor."+k, v)
+	}
+	set("memory.total", int64(h.Memory.TotalBytes))
+	for k, d := range h.Disks {
+		set("disk."+k+".size", d.TotalBytes)
+		set("disk."+k+".label", d.Label)
+	}
+	for k, i := range h.Interfaces {
+		set("interface."+k+".name", i.Name)
+		set("interface."+k+".mac", i.MAC)
+		list("interface."+k+".ips", i.IPAddresses)
+		set("interface."+k+".speed", i.LinkSpeed)
+		set("interface."+k+".master", i.Master)
+	}
+	set("manufacturer", h.Manufacturer)
+	set("model", h.Model)
+	set("serial", h.SerialNumber)
+	set("os.caption", h.OS.Caption)
+	set("os.version", h.OS.Version)
+	if h.Hardware != nil {
+		for k, m := range h.Hardware.Memory {
+			set("hardware.memory."+k+".size", m.Size)
+		}
+		for k, d := range h.Hardware.Storage.PhysicalDisks {
+			set("hardware.disk."+k+".capacity", d.Capacity)
+			set("hardware.disk."+k+".product", d.ProductId)
+			set("hardware.disk."+k+".serial", d.Serial)
+		}
+		for k, c := range h.Hardware.Storage.Controllers {
+			set("hardware.controller."+k+".firmware", c.FirmwareVersion)
+			set("hardware.controller."+k+".driver", c.DriverVersion)
+		}
+		for k, p := range h.Hardware.PowerSupplies {
+			set("hardware.powersupply."+k+".watts", p.RatedOutputWattage)
+		}
+	}
+	if h.VM != nil {
+		set("vm.host", h.VM.Host)
+	}
+	list("guests", h.Guests)
+	return f
+}
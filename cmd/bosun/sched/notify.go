@@ -74,6 +74,12 @@ func (s *Schedule) CheckNotifications() time.Time {
 			continue
 		}
 		for name, t := range ns {
+			if level, ok := parseEscalationQueueName(name); ok {
+				if err := s.escalate(ak, level); err != nil {
+					slog.Errorf("escalating %s: %v", ak, err)
+				}
+				continue
+			}
 			n := s.RuleConf.GetNotification(name)
 			if n == nil {
 				continue
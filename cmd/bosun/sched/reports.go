@@ -0,0 +1,341 @@
+package sched
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jordan-wright/email"
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/slog"
+	"github.com/leapar/bosun/util"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.reports.digest_sent", metadata.Counter, metadata.Count,
+		"The number of weekly report digests sent by Bosun.")
+	metadata.AddMetricMeta("bosun.reports.digest_failed", metadata.Counter, metadata.Count,
+		"The number of weekly report digests that Bosun failed to send.")
+}
+
+// Report summarizes the incidents that started in a time window.
+type Report struct {
+	Start, End    time.Time
+	Alerts        []*ReportStats
+	Notifications []*ReportStats
+}
+
+// ReportStats are statistics about the incidents of an alert, or of the
+// incidents whose alerts use a notification.
+type ReportStats struct {
+	Name      string
+	Incidents int
+	// Escalations is the number of times an incident's severity rose above
+	// its previous worst, which is when notifications are sent.
+	Escalations int
+	// Flaps is the number of changes between normal and abnormal states
+	// within incidents, and FlapScore the fraction of consecutive events
+	// that were such a change.
+	Flaps        int
+	FlapScore    float64
+	Acknowledged int
+	Closed       int
+	// NeverActedOn is the number of closed incidents that no user acted on.
+	NeverActedOn int
+	TimeToAck    Percentiles // from start to first acknowledgement by a user
+	TimeToClose  Percentiles // from start to end, of closed incidents
+
+	transitions  int
+	ackSeconds   []float64
+	closeSeconds []float64
+}
+
+// Percentiles summarizes durations in seconds.
+type Percentiles struct {
+	Count int
+	Mean  float64
+	P50   float64
+	P90   float64
+	P99   float64
+}
+
+// reportUser is the user of actions that bosun takes on its own.
+const reportUser = "bosun"
+
+// MakeReport computes the report of incidents between start and end.
+// Notification statistics use the current rule configuration, so incidents
+// of alerts that no longer exist are only counted in the alert statistics.
+func MakeReport(c conf.RuleConfProvider, incidents []*models.IncidentState, start, end time.Time) *Report {
+	alerts := make(map[string]*ReportStats)
+	notifications := make(map[string]*ReportStats)
+	get := func(m map[string]*ReportStats, name string) *ReportStats {
+		s := m[name]
+		if s == nil {
+			s = &ReportStats{Name: name}
+			m[name] = s
+		}
+		return s
+	}
+	for _, is := range incidents {
+		name := is.AlertKey.Name()
+		get(alerts, name).add(is)
+		alert := c.GetAlert(name)
+		if alert == nil {
+			continue
+		}
+		var ns *conf.Notifications
+		switch is.WorstStatus {
+		case models.StWarning:
+			ns = alert.WarnNotification
+		case models.StCritical, models.StUnknown:
+			ns = alert.CritNotification
+		}
+		if ns == nil {
+			continue
+		}
+		for n := range ns.Get(c, is.AlertKey.Group()) {
+			get(notifications, n).add(is)
+		}
+	}
+	return &Report{
+		Start:         start,
+		End:           end,
+		Alerts:        finishStats(alerts),
+		Notifications: finishStats(notifications),
+	}
+}
+
+func (s *ReportStats) add(is *models.IncidentState) {
+	s.Incidents++
+	worst := models.StNone
+	for i, e := range is.Events {
+		if e.Status > worst {
+			if e.Status > models.StNormal {
+				s.Escalations++
+			}
+			worst = e.Status
+		}
+		if i > 0 {
+			s.transitions++
+			if e.Status.IsNormal() != is.Events[i-1].Status.IsNormal() {
+				s.Flaps++
+			}
+		}
+	}
+	actedOn := false
+	acked := false
+	for _, a := range is.Actions {
+		if a.User == reportUser {
+			continue
+		}
+		actedOn = true
+		if a.Type == models.ActionAcknowledge && !acked {
+			acked = true
+			s.Acknowledged++
+			s.ackSeconds = append(s.ackSeconds, a.Time.Sub(is.Start).Seconds())
+		}
+	}
+	if is.End != nil {
+		s.Closed++
+		s.closeSeconds = append(s.closeSeconds, is.End.Sub(is.Start).Seconds())
+		if !actedOn {
+			s.NeverActedOn++
+		}
+	}
+}
+
+// finishStats computes the summaries of the stats and returns them sorted by
+// the number of escalations.
+func finishStats(m map[string]*ReportStats) []*ReportStats {
+	stats := make([]*ReportStats, 0, len(m))
+	for _, s := range m {
+		if s.transitions > 0 {
+			s.FlapScore = float64(s.Flaps) / float64(s.transitions)
+		}
+		s.TimeToAck = makePercentiles(s.ackSeconds)
+		s.TimeToClose = makePercentiles(s.closeSeconds)
+		stats = append(stats, s)
+	}
+	SortReportStats(stats, "escalations")
+	return stats
+}
+
+func makePercentiles(values []float64) Percentiles {
+	p := Percentiles{Count: len(values)}
+	if len(values) == 0 {
+		return p
+	}
+	sort.Float64s(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	p.Mean = sum / float64(len(values))
+	// nearest rank
+	rank := func(q float64) float64 {
+		i := int(math.Ceil(q*float64(len(values)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return values[i]
+	}
+	p.P50 = rank(.5)
+	p.P90 = rank(.9)
+	p.P99 = rank(.99)
+	return p
+}
+
+var reportSorts = map[string]func(a, b *ReportStats) bool{
+	"incidents":    func(a, b *ReportStats) bool { return a.Incidents > b.Incidents },
+	"escalations":  func(a, b *ReportStats) bool { return a.Escalations > b.Escalations },
+	"flapping":     func(a, b *ReportStats) bool { return a.FlapScore > b.FlapScore },
+	"neverActedOn": func(a, b *ReportStats) bool { return a.NeverActedOn > b.NeverActedOn },
+	"timeToAck":    func(a, b *ReportStats) bool { return a.TimeToAck.Mean > b.TimeToAck.Mean },
+	"timeToClose":  func(a, b *ReportStats) bool { return a.TimeToClose.Mean > b.TimeToClose.Mean },
+}
+
+// SortReportStats sorts stats in descending order of the named field, which
+// is one of incidents, escalations, flapping, neverActedOn, timeToAck or
+// timeToClose. Ties are sorted by name.
+func SortReportStats(stats []*ReportStats, by string) error {
+	less, ok := reportSorts[by]
+	if !ok {
+		return fmt.Errorf("unknown report sort: %s", by)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if less(stats[i], stats[j]) {
+			return true
+		}
+		if less(stats[j], stats[i]) {
+			return false
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	return nil
+}
+
+// Report returns the report of incidents that started between start and end.
+func (s *Schedule) Report(start, end time.Time) (*Report, error) {
+	incidents, _, err := s.DataAccess.State().SearchIncidents(&database.IncidentQuery{Start: start, End: end}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return MakeReport(s.RuleConf, incidents, start, end), nil
+}
+
+var digestTemplate = template.Must(template.New("digest").Funcs(template.FuncMap{
+	"seconds": func(s float64) string {
+		if s == 0 {
+			return "-"
+		}
+		return time.Duration(s * float64(time.Second)).String()
+	},
+}).Parse(`
+<h2>Bosun alert report: {{.Start.Format "2006-01-02"}} to {{.End.Format "2006-01-02"}}</h2>
+{{define "table"}}
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Alert</th><th>Incidents</th><th>Escalations</th><th>Flap score</th><th>Never acted on</th><th>Median time to ack</th><th>Median time to close</th></tr>
+{{range .}}<tr><td>{{.Name}}</td><td>{{.Incidents}}</td><td>{{.Escalations}}</td><td>{{printf "%.2f" .FlapScore}}</td><td>{{.NeverActedOn}}</td><td>{{seconds .TimeToAck.P50}}</td><td>{{seconds .TimeToClose.P50}}</td></tr>
+{{end}}</table>
+{{end}}
+<h3>Noisiest alerts</h3>
+{{template "table" .Noisiest}}
+<h3>Most flapping alerts</h3>
+{{template "table" .Flapping}}
+<h3>Alerts most often never acted on</h3>
+{{template "table" .NeverActedOn}}
+`))
+
+// top returns the first n stats when sorted by the given field, leaving out
+// stats whose field is zero.
+func top(stats []*ReportStats, by string, n int, nonZero func(*ReportStats) bool) []*ReportStats {
+	sorted := make([]*ReportStats, 0, len(stats))
+	for _, s := range stats {
+		if nonZero(s) {
+			sorted = append(sorted, s)
+		}
+	}
+	SortReportStats(sorted, by)
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// RenderReportDigest renders the HTML digest of r, listing the top n alerts
+// of each section.
+func RenderReportDigest(r *Report, n int) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	err := digestTemplate.Execute(buf, struct {
+		*Report
+		Noisiest, Flapping, NeverActedOn []*ReportStats
+	}{
+		r,
+		top(r.Alerts, "escalations", n, func(s *ReportStats) bool { return s.Escalations > 0 }),
+		top(r.Alerts, "flapping", n, func(s *ReportStats) bool { return s.Flaps > 0 }),
+		top(r.Alerts, "neverActedOn", n, func(s *ReportStats) bool { return s.NeverActedOn > 0 }),
+	})
+	return buf.Bytes(), err
+}
+
+// SendReportDigest emails the digest of the report of the week before now to
+// the configured digest addresses.
+func (s *Schedule) SendReportDigest(now time.Time) error {
+	to := s.SystemConf.GetReportDigestTo()
+	if len(to) == 0 {
+		return fmt.Errorf("no report digest addresses configured")
+	}
+	r, err := s.Report(now.Add(-7*24*time.Hour), now)
+	if err != nil {
+		return err
+	}
+	body, err := RenderReportDigest(r, s.SystemConf.GetReportDigestTop())
+	if err != nil {
+		return err
+	}
+	e := email.NewEmail()
+	e.From = s.SystemConf.GetEmailFrom()
+	e.To = to
+	e.Subject = fmt.Sprintf("Bosun alert report for the week to %s", now.Format("2006-01-02"))
+	e.HTML = body
+	e.Headers.Add("X-Bosun-Server", util.Hostname)
+	if err := conf.Send(e, s.SystemConf.GetSMTPHost(), s.SystemConf.GetSMTPUsername(), s.SystemConf.GetSMTPPassword()); err != nil {
+		collect.Add("reports.digest_failed", nil, 1)
+		return err
+	}
+	collect.Add("reports.digest_sent", nil, 1)
+	return nil
+}
+
+// runReportDigest sends the report digest once a week at the configured
+// time until the schedule is stopped.
+func (s *Schedule) runReportDigest() {
+	var last time.Time
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+		}
+		now := utcNow()
+		day, hour := s.SystemConf.GetReportDigestTime()
+		if now.Weekday() != day || now.Hour() != hour || now.Sub(last) < 24*time.Hour {
+			continue
+		}
+		last = now
+		if err := s.SendReportDigest(now); err != nil {
+			slog.Errorf("failed to send report digest: %v", err)
+			continue
+		}
+		slog.Infof("sent report digest to %v", s.SystemConf.GetReportDigestTo())
+	}
+}
@@ -0,0 +1,121 @@
+package sched
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestMakeReport(t *testing.T) {
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		template t {
+			subject = s
+		}
+		notification ops {
+			print = true
+		}
+		notification dev {
+			print = true
+		}
+		alert a {
+			warn = 1
+			crit = 1
+			warnNotification = dev
+			critNotification = ops
+			template = t
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(m int) time.Time { return start.Add(time.Duration(m) * time.Minute) }
+	end := func(m int) *time.Time { t := at(m); return &t }
+	events := func(statuses ...models.Status) []models.Event {
+		var e []models.Event
+		for i, s := range statuses {
+			e = append(e, models.Event{Status: s, Time: at(i)})
+		}
+		return e
+	}
+	ak := models.NewAlertKey("a", opentsdb.TagSet{"host": "h"})
+	incidents := []*models.IncidentState{
+		{
+			// Acked after 10m and closed after 60m.
+			AlertKey:    ak,
+			Start:       at(0),
+			End:         end(60),
+			WorstStatus: models.StCritical,
+			Events:      events(models.StWarning, models.StCritical, models.StNormal),
+			Actions: []models.Action{
+				{User: "alice", Type: models.ActionAcknowledge, Time: at(10)},
+				{User: "alice", Type: models.ActionClose, Time: at(60)},
+			},
+		},
+		{
+			// Flapping, and closed by bosun without anyone acting on it.
+			AlertKey:    ak,
+			Start:       at(100),
+			End:         end(120),
+			WorstStatus: models.StWarning,
+			Events:      events(models.StWarning, models.StNormal, models.StWarning, models.StNormal),
+			Actions:     []models.Action{{User: "bosun", Type: models.ActionClose, Time: at(120)}},
+		},
+		{
+			// Of an alert that has since been removed.
+			AlertKey:    models.NewAlertKey("gone", nil),
+			Start:       at(200),
+			WorstStatus: models.StCritical,
+			Events:      events(models.StCritical),
+		},
+	}
+	r := MakeReport(c, incidents, start, at(300))
+
+	a := &ReportStats{
+		Name:         "a",
+		Incidents:    2,
+		Escalations:  3,
+		Flaps:        4,
+		FlapScore:    4.0 / 5,
+		Acknowledged: 1,
+		Closed:       2,
+		NeverActedOn: 1,
+		TimeToAck:    Percentiles{Count: 1, Mean: 600, P50: 600, P90: 600, P99: 600},
+		TimeToClose:  Percentiles{Count: 2, Mean: 2400, P50: 1200, P90: 3600, P99: 3600},
+	}
+	gone := &ReportStats{
+		Name:        "gone",
+		Incidents:   1,
+		Escalations: 1,
+	}
+	for _, s := range r.Alerts {
+		s.transitions, s.ackSeconds, s.closeSeconds = 0, nil, nil
+	}
+	if want := []*ReportStats{a, gone}; !reflect.DeepEqual(r.Alerts, want) {
+		t.Errorf("alerts:\ngot  %+v\nwant %+v", r.Alerts, want)
+	}
+	var names []string
+	for _, s := range r.Notifications {
+		names = append(names, s.Name)
+	}
+	if want := []string{"ops", "dev"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got notifications %v, want %v", names, want)
+	}
+}
+
+func TestMakePercentiles(t *testing.T) {
+	var values []float64
+	for i := 100; i > 0; i-- {
+		values = append(values, float64(i))
+	}
+	got := makePercentiles(values)
+	want := Percentiles{Count: 100, Mean: 50.5, P50: 50, P90: 90, P99: 99}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/leapar/bosun/cmd/bosun/cache"
 	"github.com/leapar/bosun/cmd/bosun/conf"
 	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/cmd/bosun/expr"
 	"github.com/leapar/bosun/cmd/bosun/search"
 	"github.com/leapar/bosun/collect"
 	"github.com/leapar/bosun/metadata"
@@ -43,8 +44,23 @@ type Schedule struct {
 
 	Search *search.Search
 
+	// QueryCache shares backend query results between alert checks and
+	// the web UI.
+	QueryCache *expr.QueryCache
+
 	annotate backend.Backend
 
+	// Stream publishes changes to incidents and silences. It is nil in
+	// schedules that only test rules.
+	Stream *Stream
+
+	// Topology relates tags to their upstream tags, to suppress alerts
+	// behind critical ones. It is nil if not configured.
+	Topology *Topology
+
+	// Catalog validates indexed data points against the metric catalogue.
+	Catalog *Catalog
+
 	skipLast bool
 	quiet    bool
 
@@ -59,6 +75,12 @@ type Schedule struct {
 	lastLogTimes map[models.AlertKey]time.Time
 	LastCheck    time.Time
 
+	// alertMetrics limits the alert keys whose results are sent as data
+	// points.
+	alertMetrics *alertMetrics
+
+	sloStatuses sloStatuses
+
 	ctx *checkContext
 
 	DataAccess database.DataAccess
@@ -87,6 +109,7 @@ func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleCo
 	s.annotate = annotate
 	s.pendingUnknowns = make(map[*conf.Notification][]*IncidentWithTemplates)
 	s.lastLogTimes = make(map[models.AlertKey]time.Time)
+	s.alertMetrics = new(alertMetrics)
 	s.LastCheck = utcNow()
 	s.ctx = &checkContext{utcNow(), cache.New(0)}
 	s.DataAccess = dataAccess
@@ -97,6 +120,20 @@ func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleCo
 	if s.Search == nil {
 		s.Search = search.NewSearch(s.DataAccess, skipLast)
 	}
+	if s.Catalog == nil && s.DataAccess != nil {
+		s.Catalog = NewCatalog(s.DataAccess.Metadata())
+	}
+	if s.QueryCache == nil {
+		s.QueryCache = expr.NewQueryCache(systemConf.GetQueryCacheMaxEntries(), systemConf.GetQueryCacheTTLs())
+	}
+	if s.Topology == nil {
+		t, err := NewTopology(systemConf.GetTopologyConf())
+		if err != nil {
+			return fmt.Errorf("topology: %v", err)
+		}
+		s.Topology = t
+		s.refreshTopology()
+	}
 	return nil
 }
 
@@ -496,6 +533,7 @@ var DefaultSched = &Schedule{}
 
 // Load loads a configuration into the default schedule.
 func Load(systemConf conf.SystemConfProvider, ruleConf conf.RuleConfProvider, dataAccess database.DataAccess, annotate backend.Backend, skipLast, quiet bool) error {
+	DefaultSched.Stream = DefaultStream
 	return DefaultSched.Init(systemConf, ruleConf, dataAccess, annotate, skipLast, quiet)
 }
 
@@ -648,22 +686,113 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 		if err := s.DataAccess.Notifications().ClearNotifications(st.AlertKey); err != nil {
 			return "", err
 		}
-		return st.AlertKey, s.DataAccess.State().Forget(st.AlertKey)
+		if err := s.DataAccess.State().Forget(st.AlertKey); err != nil {
+			return "", err
+		}
+		s.publishAction(st, action)
+		return st.AlertKey, nil
 	case models.ActionNote:
 		// pass
+	case models.ActionAssign:
+		return "", fmt.Errorf("assign needs an assignee or team")
+	case models.ActionUnassign:
+		if st.Assignee == "" && st.Team == "" {
+			return "", fmt.Errorf("incident %v is not assigned", st.Id)
+		}
+		st.Assignee, st.Team = "", ""
+	case models.ActionEscalate:
+		if !st.Open {
+			return "", fmt.Errorf("cannot escalate closed alert")
+		}
+		alert := s.RuleConf.GetAlert(st.AlertKey.Name())
+		if alert == nil || alert.Escalation == nil {
+			return "", fmt.Errorf("alert %s has no escalation policy", st.AlertKey.Name())
+		}
+		next := st.EscalationLevel + 1
+		if alert.Escalation.Level(next) == nil {
+			return "", fmt.Errorf("incident %v is already at the last escalation level", st.Id)
+		}
+		// Escalation stops at acknowledgement, so it needs one again.
+		st.NeedAck = true
+		if err := s.queueEscalation(st.AlertKey, next, timestamp); err != nil {
+			return "", err
+		}
+		defer s.signalNotifications()
 	default:
 		return "", fmt.Errorf("unknown action type: %v", t)
 	}
+	return st.AlertKey, s.recordAction(st, action)
+}
+
+// AssignByAlertKey assigns the latest incident of ak to assignee, team or
+// both.
+func (s *Schedule) AssignByAlertKey(user, message, assignee, team string, ak models.AlertKey) error {
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		return err
+	}
+	if st == nil {
+		return fmt.Errorf("no such alert key: %v", ak)
+	}
+	_, err = s.assign(user, message, assignee, team, st)
+	return err
+}
+
+// AssignByIncidentId assigns an incident to assignee, team or both. An empty
+// team leaves the incident's team unchanged.
+func (s *Schedule) AssignByIncidentId(user, message, assignee, team string, id int64) (models.AlertKey, error) {
+	st, err := s.DataAccess.State().GetIncidentState(id)
+	if err != nil {
+		return "", err
+	}
+	if st == nil {
+		return "", fmt.Errorf("no incident with id: %v", id)
+	}
+	return s.assign(user, message, assignee, team, st)
+}
 
+func (s *Schedule) assign(user, message, assignee, team string, st *models.IncidentState) (models.AlertKey, error) {
+	if assignee == "" && team == "" {
+		return "", fmt.Errorf("assign needs an assignee or team")
+	}
+	st.Assignee = assignee
+	if team != "" {
+		st.Team = team
+	}
+	action := models.Action{
+		Message:  message,
+		Time:     utcNow(),
+		Type:     models.ActionAssign,
+		User:     user,
+		Assignee: assignee,
+		Team:     team,
+	}
+	return st.AlertKey, s.recordAction(st, action)
+}
+
+// recordAction appends action to the incident and saves it.
+func (s *Schedule) recordAction(st *models.IncidentState, action models.Action) error {
 	st.Actions = append(st.Actions, action)
 	_, err := s.DataAccess.State().UpdateIncidentState(st)
 	if err != nil {
-		return "", err
+		return err
 	}
-	if err := collect.Add("actions", opentsdb.TagSet{"user": user, "alert": st.AlertKey.Name(), "type": t.String()}, 1); err != nil {
+	if err := collect.Add("actions", opentsdb.TagSet{"user": action.User, "alert": st.AlertKey.Name(), "type": action.Type.String()}, 1); err != nil {
 		slog.Errorln(err)
 	}
-	return st.AlertKey, nil
+	s.publishAction(st, action)
+	return nil
+}
+
+// signalNotifications wakes the notification dispatcher without waiting.
+func (s *Schedule) signalNotifications() {
+	if s.nc == nil {
+		return
+	}
+	select {
+	case s.nc <- true:
+	default:
+	}
 }
 
 type IncidentStatus struct {
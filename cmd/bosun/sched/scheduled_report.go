@@ -0,0 +1,153 @@
+package sched
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aymerick/douceur/inliner"
+	"github.com/leapar/bosun/cmd/bosun/cache"
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.reports.sent", metadata.Counter, metadata.Count,
+		"The number of scheduled reports sent by Bosun.")
+	metadata.AddMetricMeta("bosun.reports.failed", metadata.Counter, metadata.Count,
+		"The number of scheduled reports that Bosun failed to render.")
+}
+
+// ReportRun is the rendering of the template of a report section.
+type ReportRun struct {
+	Name    string
+	Time    time.Time
+	Subject string
+	Body    string
+	// Errors are the errors of the template functions, such as .Eval,
+	// whose output shows the error instead.
+	Errors []string `json:",omitempty"`
+	// Notifications are the notifications the report was sent to, if it
+	// was sent.
+	Notifications []string `json:",omitempty"`
+	// Next is the next time the report is scheduled to be sent.
+	Next time.Time
+
+	emailSubject []byte
+	emailBody    []byte
+	attachments  []*models.Attachment
+}
+
+// reportAlert returns the alert a report is rendered as: the templates of
+// reports have the same functions as those of alerts, but with no tags.
+func reportAlert(r *conf.Report) (*conf.Alert, *models.IncidentState) {
+	a := &conf.Alert{
+		Name:     r.Name,
+		Vars:     r.Vars,
+		Template: r.Template,
+	}
+	st := &models.IncidentState{
+		AlertKey: models.NewAlertKey(r.Name, opentsdb.TagSet{}),
+		Alert:    r.Name,
+	}
+	return a, st
+}
+
+// RenderReport renders the template of r as of now.
+func (s *Schedule) RenderReport(r *conf.Report, now time.Time) (*ReportRun, error) {
+	a, st := reportAlert(r)
+	st.Start = now
+	rh := s.NewRunHistory(now, cache.New(0))
+	run := &ReportRun{
+		Name: r.Name,
+		Time: now,
+		Next: r.Cron.Next(now.In(r.Location)),
+	}
+	render := func(isEmail bool) (subject, body []byte, c *Context, err error) {
+		c = s.Data(rh, st, a, isEmail)
+		buf := new(bytes.Buffer)
+		if r.Template.Subject != nil {
+			if err := r.Template.Subject.Execute(buf, c); err != nil {
+				return nil, nil, nil, err
+			}
+			subject = bytes.Join(bytes.Fields(buf.Bytes()), []byte(" "))
+		}
+		buf.Reset()
+		if err := r.Template.Body.Execute(buf, c); err != nil {
+			return nil, nil, nil, err
+		}
+		if inline, err := inliner.Inline(buf.String()); err == nil {
+			buf = bytes.NewBufferString(inline)
+		} else {
+			slog.Errorln(err)
+		}
+		return subject, buf.Bytes(), c, nil
+	}
+	subject, body, c, err := render(false)
+	if err != nil {
+		return nil, fmt.Errorf("report %s: %v", r.Name, err)
+	}
+	run.Subject, run.Body, run.Errors = string(subject), string(body), c.Errors
+	if run.emailSubject, run.emailBody, c, err = render(true); err != nil {
+		return nil, fmt.Errorf("report %s: %v", r.Name, err)
+	}
+	run.attachments = c.Attachments
+	if run.Subject == "" {
+		run.Subject = fmt.Sprintf("Bosun report %s", r.Name)
+		run.emailSubject = []byte(run.Subject)
+	}
+	return run, nil
+}
+
+// SendReport renders the template of r as of now and sends it to the
+// notifications of r.
+func (s *Schedule) SendReport(r *conf.Report, now time.Time) (*ReportRun, error) {
+	run, err := s.RenderReport(r, now)
+	if err != nil {
+		collect.Add("reports.failed", opentsdb.TagSet{"report": r.Name}, 1)
+		return nil, err
+	}
+	for name, n := range r.Notifications.Notifications {
+		n.Notify(run.Subject, run.Body, run.emailSubject, run.emailBody, s.SystemConf, r.Name, run.attachments...)
+		run.Notifications = append(run.Notifications, name)
+	}
+	sort.Strings(run.Notifications)
+	collect.Add("reports.sent", opentsdb.TagSet{"report": r.Name}, 1)
+	return run, nil
+}
+
+// runReports sends each report of the rule configuration on its cron
+// schedule until the schedule is stopped.
+func (s *Schedule) runReports() {
+	next := make(map[string]time.Time)
+	for name, r := range s.RuleConf.GetReports() {
+		next[name] = r.Cron.Next(utcNow().In(r.Location))
+	}
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+		}
+		now := utcNow()
+		for name, r := range s.RuleConf.GetReports() {
+			at, ok := next[name]
+			if !ok || at.IsZero() || now.Before(at) {
+				continue
+			}
+			next[name] = r.Cron.Next(now.In(r.Location))
+			if _, err := s.SendReport(r, now); err != nil {
+				slog.Errorf("failed to send report: %v", err)
+				continue
+			}
+			slog.Infof("sent report %s", name)
+		}
+	}
+}
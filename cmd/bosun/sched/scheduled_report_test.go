@@ -0,0 +1,64 @@
+package sched
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+)
+
+func TestRenderReport(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		template capacity {
+			subject = Capacity of {{.Alert.Name}}
+			body = `+"`"+`<p>Free: {{.Eval .Alert.Vars.free}}</p>{{.Graph .Alert.Vars.used "unit=GB"}}`+"`"+`
+		}
+		notification ops {
+			print = true
+		}
+		report disks {
+			$free = 40 + 2
+			$used = merge(series("host=a", 0, 1, 60, 2), series("host=b", 0, 3, 60, 4))
+			cron = 0 9 * * mon
+			template = capacity
+			notification = ops
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{
+		CheckFrequency:  conf.Duration{Duration: time.Minute},
+		DefaultRunEvery: 1,
+	}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2017-01-04 is a Wednesday.
+	now := time.Date(2017, 1, 4, 12, 0, 0, 0, time.UTC)
+	run, err := s.SendReport(c.Reports["disks"], now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(run.Errors) > 0 {
+		t.Fatal(run.Errors)
+	}
+	if run.Subject != "Capacity of disks" {
+		t.Errorf("bad subject: %s", run.Subject)
+	}
+	if !strings.Contains(run.Body, "Free: 42") || !strings.Contains(run.Body, "<svg") {
+		t.Errorf("bad body: %s", run.Body)
+	}
+	if len(run.attachments) != 1 || !strings.Contains(string(run.emailBody), "cid:1.png") {
+		t.Errorf("expected the email to embed the graph, got %d attachments", len(run.attachments))
+	}
+	if len(run.Notifications) != 1 || run.Notifications[0] != "ops" {
+		t.Errorf("bad notifications: %v", run.Notifications)
+	}
+	if want := time.Date(2017, 1, 9, 9, 0, 0, 0, time.UTC); !run.Next.Equal(want) {
+		t.Errorf("got next run %v, want %v", run.Next, want)
+	}
+}
@@ -68,7 +68,7 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 	}
 	if confirm {
 		if edit != "" {
-			if err := s.DataAccess.Silence().DeleteSilence(edit); err != nil {
+			if err := s.ClearSilence(edit); err != nil {
 				return nil, err
 			}
 		}
@@ -78,6 +78,7 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 		if err := s.DataAccess.Silence().AddSilence(si); err != nil {
 			return nil, err
 		}
+		s.publishSilence(si.ID(), si, false)
 		return nil, nil
 	}
 	aks := make(map[models.AlertKey]bool)
@@ -94,5 +95,17 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 }
 
 func (s *Schedule) ClearSilence(id string) error {
-	return s.DataAccess.Silence().DeleteSilence(id)
+	var si *models.Silence
+	if s.Stream != nil {
+		silences, err := s.DataAccess.Silence().ListSilences(0)
+		if err != nil {
+			return err
+		}
+		si = silences[id]
+	}
+	if err := s.DataAccess.Silence().DeleteSilence(id); err != nil {
+		return err
+	}
+	s.publishSilence(id, si, true)
+	return nil
 }
@@ -0,0 +1,179 @@
+package sched
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/cache"
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+// SLOStatus is the state of an slo section.
+type SLOStatus struct {
+	Name     string
+	Target   float64
+	Window   string
+	CritBurn []conf.BurnRate `json:",omitempty"`
+	WarnBurn []conf.BurnRate `json:",omitempty"`
+	Groups   []*SLOGroupStatus
+	Time     time.Time
+}
+
+// SLOGroupStatus is the state of an SLO for one group of its queries.
+type SLOGroupStatus struct {
+	Group opentsdb.TagSet
+	// Compliance is the percentage of good events over the window, or null
+	// if there were no events.
+	Compliance models.Float
+	// Budget is the percentage of the error budget left over the window. It
+	// is negative once the budget is spent.
+	Budget models.Float
+	// BurnRates are the rates the budget burns at over the windows of the
+	// burn rate alerts, by window.
+	BurnRates map[string]models.Float
+	// Status and Incident are those of the open incident of the alert of
+	// the SLO for the group, if any.
+	Status   models.Status `json:",omitempty"`
+	Incident int64         `json:",omitempty"`
+}
+
+// sloStatuses holds the status of each SLO as of the last check of its
+// alert, so that the API and templates do not query its whole window again.
+type sloStatuses struct {
+	sync.Mutex
+	m map[string]*SLOStatus
+}
+
+// checkSLO computes and stores the status of the SLO of a, if it has one, as
+// of now. Its queries share c with the check of a.
+func (s *Schedule) checkSLO(a *conf.Alert, now time.Time, c *cache.Cache) {
+	if a.SLO == nil {
+		return
+	}
+	st, err := s.computeSLOStatus(a, now, c)
+	if err != nil {
+		slog.Errorf("slo %s: %v", a.SLO.Name, err)
+		return
+	}
+	s.sloStatuses.Lock()
+	if s.sloStatuses.m == nil {
+		s.sloStatuses.m = make(map[string]*SLOStatus)
+	}
+	s.sloStatuses.m[a.SLO.Name] = st
+	s.sloStatuses.Unlock()
+}
+
+// computeSLOStatus computes the status of the SLO of a as of now, without
+// the incidents of its groups.
+func (s *Schedule) computeSLOStatus(a *conf.Alert, now time.Time, c *cache.Cache) (*SLOStatus, error) {
+	slo := a.SLO
+	st := &SLOStatus{
+		Name:     slo.Name,
+		Target:   slo.Target,
+		Window:   slo.Window,
+		CritBurn: slo.CritBurn,
+		WarnBurn: slo.WarnBurn,
+		Time:     now,
+	}
+	rh := s.NewRunHistory(now, c)
+	groups := make(map[string]*SLOGroupStatus)
+	group := func(tags opentsdb.TagSet) *SLOGroupStatus {
+		g, ok := groups[tags.String()]
+		if !ok {
+			g = &SLOGroupStatus{
+				Group:      tags,
+				Compliance: models.Float(math.NaN()),
+				Budget:     models.Float(math.NaN()),
+				BurnRates:  make(map[string]models.Float),
+			}
+			groups[tags.String()] = g
+			st.Groups = append(st.Groups, g)
+		}
+		return g
+	}
+	values := append([]string{"compliance", "budget"}, slo.BurnWindows()...)
+	for _, v := range values {
+		text, err := slo.Expr(v)
+		if err != nil {
+			return nil, err
+		}
+		e, err := expr.New(text, s.RuleConf.GetFuncs(s.SystemConf.EnabledBackends()))
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.executeExpr(nil, rh, a, e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", v, err)
+		}
+		for _, r := range res.Results {
+			n, ok := r.Value.(expr.Number)
+			if !ok {
+				continue
+			}
+			g := group(r.Group)
+			switch v {
+			case "compliance":
+				g.Compliance = models.Float(n)
+			case "budget":
+				g.Budget = models.Float(n)
+			default:
+				g.BurnRates[v] = models.Float(n)
+			}
+		}
+	}
+	sort.Slice(st.Groups, func(i, j int) bool {
+		return st.Groups[i].Group.String() < st.Groups[j].Group.String()
+	})
+	return st, nil
+}
+
+// SLOStatus returns the status of the named SLO as of the last check of its
+// alert, with the open incidents of its groups, or nil if it has not been
+// checked yet.
+func (s *Schedule) SLOStatus(name string) (*SLOStatus, error) {
+	s.sloStatuses.Lock()
+	stored := s.sloStatuses.m[name]
+	s.sloStatuses.Unlock()
+	if stored == nil {
+		return nil, nil
+	}
+	st := *stored
+	st.Groups = make([]*SLOGroupStatus, len(stored.Groups))
+	for i, g := range stored.Groups {
+		g := *g
+		incident, err := s.DataAccess.State().GetOpenIncident(models.NewAlertKey(name, g.Group))
+		if err != nil {
+			return nil, err
+		}
+		if incident != nil {
+			g.Status = incident.CurrentStatus
+			g.Incident = incident.Id
+		}
+		st.Groups[i] = &g
+	}
+	return &st, nil
+}
+
+// SLOStatuses returns the status of each SLO of the rule configuration that
+// has been checked, sorted by name.
+func (s *Schedule) SLOStatuses() ([]*SLOStatus, error) {
+	statuses := []*SLOStatus{}
+	for name := range s.RuleConf.GetSLOs() {
+		st, err := s.SLOStatus(name)
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			statuses = append(statuses, st)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
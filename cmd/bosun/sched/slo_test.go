@@ -0,0 +1,93 @@
+package sched
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestSLOStatus(t *testing.T) {
+	defer setup()()
+	// One in a hundred requests fails, ten times the budget of 99.9%.
+	values := map[string]opentsdb.Point{"http.ok": 99, "http.total": 100}
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req opentsdb.Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		var resp opentsdb.ResponseSet
+		for _, q := range req.Queries {
+			resp = append(resp, &opentsdb.Response{
+				Metric: q.Metric,
+				Tags:   opentsdb.TagSet{"host": "a"},
+				DPS:    map[string]opentsdb.Point{"1483228800": values[q.Metric]},
+			})
+		}
+		json.NewEncoder(w).Encode(&resp)
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		slo api {
+			good = sum:http.ok{host=*}
+			total = sum:http.total{host=*}
+			target = 99.9
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{
+		CheckFrequency:  conf.Duration{Duration: time.Minute},
+		DefaultRunEvery: 1,
+		OpenTSDBConf:    conf.OpenTSDBConf{Host: u.Host, ResponseLimit: 1 << 20},
+	}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	if st, err := s.SLOStatus("api"); err != nil || st != nil {
+		t.Fatalf("expected no status before the first check, got %v, %v", st, err)
+	}
+	check(s, now)
+	queries := requests
+	st, err := s.SLOStatus("api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SLOStatuses(); err != nil || requests != queries {
+		t.Errorf("expected the statuses of the check to be used, got %d more queries, %v", requests-queries, err)
+	}
+	if len(st.Groups) != 1 {
+		t.Fatalf("expected one group, got %d", len(st.Groups))
+	}
+	g := st.Groups[0]
+	near := func(a models.Float, b float64) bool {
+		d := float64(a) - b
+		return d < 1e-6 && d > -1e-6
+	}
+	if g.Group.String() != "{host=a}" || !near(g.Compliance, 99) || !near(g.Budget, -900) {
+		t.Errorf("bad status: %+v", g)
+	}
+	if len(g.BurnRates) != 7 || !near(g.BurnRates["1h"], 10) {
+		t.Errorf("bad burn rates: %v", g.BurnRates)
+	}
+	// A burn rate of 10 exceeds 6 but not 14.4.
+	if g.Status != models.StCritical || g.Incident == 0 {
+		t.Errorf("expected a critical incident, got %v (%d)", g.Status, g.Incident)
+	}
+}
@@ -0,0 +1,192 @@
+package sched
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/models"
+)
+
+// Types of stream events.
+const (
+	StreamIncident = "incident" // an incident was created or changed status
+	StreamAction   = "action"   // an action was taken on an incident
+	StreamSilence  = "silence"  // a silence was added or removed
+)
+
+// StreamEvent is a change to incidents or silences.
+type StreamEvent struct {
+	// Cursor identifies the event. Reading the stream from a cursor
+	// resumes it after the event.
+	Cursor string
+	Type   string
+	Time   time.Time
+
+	IncidentId     int64           `json:",omitempty"`
+	AlertKey       models.AlertKey `json:",omitempty"`
+	Status         models.Status   `json:",omitempty"`
+	PreviousStatus models.Status   `json:",omitempty"`
+	Action         *models.Action  `json:",omitempty"`
+
+	SilenceId string          `json:",omitempty"`
+	Silence   *models.Silence `json:",omitempty"`
+	// Removed is set on silence events when the silence was removed.
+	Removed bool `json:",omitempty"`
+
+	seq   uint64
+	state *models.IncidentState
+}
+
+// State returns the incident after the change of incident and action
+// events, or nil. It must not be modified.
+func (e *StreamEvent) State() *models.IncidentState {
+	return e.state
+}
+
+// Stream keeps the most recent events and wakes subscribers when there are
+// new ones. Subscribers read events with Since, so slow subscribers never
+// block publishers; they miss events only if they fall behind by more than
+// the kept events, which Since reports.
+type Stream struct {
+	mu     sync.Mutex
+	epoch  string
+	seq    uint64
+	size   int
+	events []*StreamEvent
+	subs   map[chan struct{}]bool
+}
+
+// NewStream returns a stream that keeps the last size events.
+func NewStream(size int) *Stream {
+	return &Stream{
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		size:  size,
+		subs:  make(map[chan struct{}]bool),
+	}
+}
+
+// DefaultStream is the stream of the default schedule. It outlives reloads,
+// so that subscribers are not disconnected by them.
+var DefaultStream = NewStream(10000)
+
+// Publish adds an event to the stream. It does nothing on a nil stream,
+// which is that of schedules that only test rules.
+func (s *Stream) Publish(e *StreamEvent) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	e.seq = s.seq
+	e.Cursor = s.cursor(s.seq)
+	if e.Time.IsZero() {
+		e.Time = utcNow()
+	}
+	s.events = append(s.events, e)
+	if len(s.events) > 2*s.size {
+		s.events = append([]*StreamEvent(nil), s.events[len(s.events)-s.size:]...)
+	}
+	for ch := range s.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *Stream) cursor(seq uint64) string {
+	return fmt.Sprintf("%s-%d", s.epoch, seq)
+}
+
+// Subscribe returns a channel that receives a value when there are new
+// events, and a function to call when done.
+func (s *Stream) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.subs[ch] = true
+	s.mu.Unlock()
+	return ch, func() {
+		s.mu.Lock()
+		delete(s.subs, ch)
+		s.mu.Unlock()
+	}
+}
+
+// Cursor returns the cursor of the latest event, from which reading returns
+// only new events.
+func (s *Stream) Cursor() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursor(s.seq)
+}
+
+// Since returns the events after cursor, and the cursor to read from next.
+// ok is false if cursor is not from this stream, such as from before a
+// restart, or if events after it are no longer kept. Then no events are
+// returned, and the caller should reload its state before reading on.
+func (s *Stream) Since(cursor string) (events []*StreamEvent, next string, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next = s.cursor(s.seq)
+	sp := strings.SplitN(cursor, "-", 2)
+	if len(sp) != 2 || sp[0] != s.epoch {
+		return nil, next, false
+	}
+	seq, err := strconv.ParseUint(sp[1], 10, 64)
+	if err != nil || seq > s.seq {
+		return nil, next, false
+	}
+	if seq == s.seq {
+		return nil, next, true
+	}
+	// Events are kept in order of seq without gaps.
+	first := s.seq - uint64(len(s.events)) + 1
+	if seq+1 < first {
+		return nil, next, false
+	}
+	kept := s.events[seq+1-first:]
+	if len(kept) > s.size {
+		// Only the last size events are promised.
+		return nil, next, false
+	}
+	return append([]*StreamEvent(nil), kept...), next, true
+}
+
+// publishIncident publishes a change of the status of an incident.
+func (s *Schedule) publishIncident(st *models.IncidentState, previous models.Status) {
+	s.Stream.Publish(&StreamEvent{
+		Type:           StreamIncident,
+		IncidentId:     st.Id,
+		AlertKey:       st.AlertKey,
+		Status:         st.CurrentStatus,
+		PreviousStatus: previous,
+		state:          st,
+	})
+}
+
+// publishAction publishes an action taken on an incident.
+func (s *Schedule) publishAction(st *models.IncidentState, a models.Action) {
+	s.Stream.Publish(&StreamEvent{
+		Type:       StreamAction,
+		Time:       a.Time,
+		IncidentId: st.Id,
+		AlertKey:   st.AlertKey,
+		Status:     st.CurrentStatus,
+		Action:     &a,
+		state:      st,
+	})
+}
+
+// publishSilence publishes the addition or removal of a silence.
+func (s *Schedule) publishSilence(id string, si *models.Silence, removed bool) {
+	s.Stream.Publish(&StreamEvent{
+		Type:      StreamSilence,
+		SilenceId: id,
+		Silence:   si,
+		Removed:   removed,
+	})
+}
@@ -0,0 +1,55 @@
+package sched
+
+import (
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	s := NewStream(3)
+	start := s.Cursor()
+	wake, cancel := s.Subscribe()
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		s.Publish(&StreamEvent{Type: StreamIncident, IncidentId: int64(i)})
+	}
+	select {
+	case <-wake:
+	default:
+		t.Fatal("subscriber not woken")
+	}
+	events, next, ok := s.Since(start)
+	if !ok || len(events) != 2 || events[0].IncidentId != 0 || events[1].Cursor != next {
+		t.Fatalf("bad events since start: %v %v %v", events, next, ok)
+	}
+	events, _, ok = s.Since(events[0].Cursor)
+	if !ok || len(events) != 1 || events[0].IncidentId != 1 {
+		t.Fatalf("bad events since first: %v %v", events, ok)
+	}
+	if events, _, ok = s.Since(next); !ok || len(events) != 0 {
+		t.Fatalf("expected no new events: %v %v", events, ok)
+	}
+
+	// Falling behind by more than the kept events needs a reset.
+	for i := 2; i < 10; i++ {
+		s.Publish(&StreamEvent{Type: StreamIncident, IncidentId: int64(i)})
+	}
+	if _, _, ok = s.Since(start); ok {
+		t.Error("expected expired cursor")
+	}
+	if _, _, ok = s.Since(s.cursor(6)); ok {
+		t.Error("expected cursor of four events ago to be expired")
+	}
+	events, _, ok = s.Since(s.cursor(7))
+	if !ok || len(events) != 3 || events[0].IncidentId != 7 {
+		t.Fatalf("bad events since 7: %v %v", events, ok)
+	}
+	for _, bad := range []string{"", "x-1", start + "0", NewStream(3).Cursor()} {
+		if _, _, ok := s.Since(bad); ok {
+			t.Errorf("expected cursor %q to be rejected", bad)
+		}
+	}
+
+	// Publishing on a nil stream does nothing.
+	var nilStream *Stream
+	nilStream.Publish(&StreamEvent{})
+}
@@ -11,11 +11,13 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/leapar/bosun/cmd/bosun/conf"
 	"github.com/leapar/bosun/cmd/bosun/expr"
+	"github.com/leapar/bosun/cmd/bosun/expr/parse"
 	"github.com/leapar/bosun/models"
 	"github.com/leapar/bosun/opentsdb"
 	"github.com/leapar/bosun/slog"
@@ -220,6 +222,9 @@ func (c *Context) evalExpr(e *expr.Expr, filter bool, series bool, autods int) (
 		Search:    c.schedule.Search,
 		Squelched: c.schedule.RuleConf.AlertSquelched(c.Alert),
 		History:   c.schedule,
+
+		QueryCache: c.schedule.QueryCache,
+		Inventory:  c.schedule.DataAccess.Inventory(),
 	}
 	res, _, err := e.Execute(c.runHistory.Backends, providers, nil, c.runHistory.Start, autods, c.Alert.UnjoinedOK)
 	if err != nil {
@@ -341,7 +346,7 @@ func (c *Context) EvalAll(v interface{}) interface{} {
 	return res
 }
 
-func (c *Context) graph(v interface{}, unit string, filter bool) (val interface{}) {
+func (c *Context) graph(v interface{}, filter bool, args []string) (val interface{}) {
 	defer func() {
 		if p := recover(); p != nil {
 			err := fmt.Errorf("panic rendering graph %v", p)
@@ -355,6 +360,11 @@ func (c *Context) graph(v interface{}, unit string, filter bool) (val interface{
 		c.addError(err)
 		return err.Error()
 	}
+	opts, err := c.graphOptions(res, filter, args)
+	if err != nil {
+		c.addError(err)
+		return err.Error()
+	}
 	var buf bytes.Buffer
 	const width = 800
 	const height = 600
@@ -362,7 +372,7 @@ func (c *Context) graph(v interface{}, unit string, filter bool) (val interface{
 		template.HTMLEscapeString(exprText),
 		c.runHistory.Start.Format(time.RFC3339))
 	if c.IsEmail {
-		err := c.schedule.ExprPNG(nil, &buf, width, height, unit, res)
+		err := c.schedule.ExprPNG(nil, &buf, width, height, res, opts)
 		if err != nil {
 			c.addError(err)
 			return err.Error()
@@ -381,7 +391,7 @@ func (c *Context) graph(v interface{}, unit string, filter bool) (val interface{
 		))
 	}
 	buf.WriteString(fmt.Sprintf(`<a href="%s" style="text-decoration: none">`, c.GraphLink(exprText)))
-	if err := c.schedule.ExprSVG(nil, &buf, width, height, unit, res); err != nil {
+	if err := c.schedule.ExprSVG(nil, &buf, width, height, res, opts); err != nil {
 		c.addError(err)
 		return err.Error()
 	}
@@ -390,23 +400,244 @@ func (c *Context) graph(v interface{}, unit string, filter bool) (val interface{
 	return template.HTML(buf.String())
 }
 
+// graphOptions returns the options of a graph of res set by the arguments
+// of Graph or GraphAll. An argument is either a flag (stacked, thresholds,
+// annotations), a key=value option (unit, unit2, y2, annotations, legend,
+// warn, crit), or else the unit.
+func (c *Context) graphOptions(res expr.ResultSlice, filter bool, args []string) (GraphOptions, error) {
+	var opts GraphOptions
+	var annotations bool
+	var annotationFilter string
+	for _, arg := range args {
+		key, value := arg, ""
+		if i := strings.Index(arg, "="); i >= 0 {
+			key, value = arg[:i], arg[i+1:]
+		}
+		switch {
+		case arg == "stacked":
+			opts.Stacked = true
+		case arg == "thresholds":
+			opts.Thresholds = append(opts.Thresholds, c.alertThresholds()...)
+		case arg == "annotations":
+			annotations = true
+		case key == "annotations":
+			annotations = true
+			annotationFilter = value
+		case key == "unit":
+			opts.Unit = value
+		case key == "unit2":
+			opts.Unit2 = value
+		case key == "y2":
+			res2, _, err := c.eval(value, filter, true, 1000)
+			if err != nil {
+				return opts, err
+			}
+			opts.Axis2 = res2
+		case key == "legend":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return opts, fmt.Errorf("graph: invalid legend width %q", value)
+			}
+			opts.LegendWidth = n
+		case key == "warn" || key == "crit":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return opts, fmt.Errorf("graph: invalid %s threshold %q", key, value)
+			}
+			opts.Thresholds = append(opts.Thresholds, Threshold{Name: key, Value: f})
+		case !strings.Contains(arg, "="):
+			opts.Unit = arg
+		default:
+			return opts, fmt.Errorf("graph: unknown option %q", arg)
+		}
+	}
+	if annotations {
+		start, end, ok := graphRange(res, opts.Axis2)
+		if ok {
+			a, err := c.schedule.annotations(start, end, annotationFilter)
+			if err != nil {
+				return opts, err
+			}
+			opts.Annotations = a
+		}
+	}
+	return opts, nil
+}
+
+// alertThresholds returns the thresholds of the warn and crit expressions
+// of the context's alert that compare against a number, such as 90 in
+// avg(q(...)) > 90.
+func (c *Context) alertThresholds() []Threshold {
+	if c.Alert == nil {
+		return nil
+	}
+	var thresholds []Threshold
+	for _, a := range []struct {
+		name string
+		e    *expr.Expr
+	}{{"warn", c.Alert.Warn}, {"crit", c.Alert.Crit}} {
+		n := thresholdNode(a.e)
+		if n == nil {
+			continue
+		}
+		if num, ok := n.(*parse.NumberNode); ok {
+			thresholds = append(thresholds, Threshold{Name: a.name, Value: num.Float64})
+			continue
+		}
+		res, _, err := c.eval(n.String(), true, false, 0)
+		if err != nil {
+			c.addError(err)
+			continue
+		}
+		if len(res) == 0 {
+			continue
+		}
+		switch v := res[0].Value.(type) {
+		case expr.Scalar:
+			thresholds = append(thresholds, Threshold{Name: a.name, Value: float64(v)})
+		case expr.Number:
+			thresholds = append(thresholds, Threshold{Name: a.name, Value: float64(v)})
+		}
+	}
+	return thresholds
+}
+
+// thresholdNode returns the scalar side of the comparison at the root of e,
+// or nil if e is not such a comparison.
+func thresholdNode(e *expr.Expr) parse.Node {
+	if e == nil || e.Tree == nil {
+		return nil
+	}
+	b, ok := e.Root.(*parse.BinaryNode)
+	if !ok {
+		return nil
+	}
+	switch b.OpStr {
+	case ">", ">=", "<", "<=":
+	default:
+		return nil
+	}
+	for _, n := range b.Args {
+		if _, ok := n.(*parse.NumberNode); ok {
+			return n
+		}
+	}
+	for _, n := range b.Args {
+		if n.Return() == models.TypeScalar {
+			return n
+		}
+	}
+	return nil
+}
+
 // Graph returns an SVG for the given result (or expression, for which it gets the result)
-// with same tags as the context's tags.
+// with same tags as the context's tags. The arguments set the unit of the
+// graph and its options; see graphOptions.
 func (c *Context) Graph(v interface{}, args ...string) interface{} {
-	var unit string
-	if len(args) > 0 {
-		unit = args[0]
-	}
-	return c.graph(v, unit, true)
+	return c.graph(v, true, args)
 }
 
 // GraphAll returns an SVG for the given result (or expression, for which it gets the result).
 func (c *Context) GraphAll(v interface{}, args ...string) interface{} {
-	var unit string
-	if len(args) > 0 {
-		unit = args[0]
+	return c.graph(v, false, args)
+}
+
+// dashboard returns the dashboard with the given name and the values of its
+// variables whose tag keys are in the context's tags.
+func (c *Context) dashboard(name string) (*models.Dashboard, map[string]string, error) {
+	d, err := c.schedule.Dashboard(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d == nil {
+		return nil, nil, fmt.Errorf("unknown dashboard %v", name)
+	}
+	group := c.AlertKey.Group()
+	values := make(map[string]string)
+	for _, v := range d.Variables {
+		if val, ok := group[v.TagKey]; ok {
+			values[v.Name] = val
+		}
+	}
+	return d, values, nil
+}
+
+// DashboardLink returns the permalink of the named dashboard with its
+// variables set to the context's tags, at the time of the check.
+func (c *Context) DashboardLink(name string) string {
+	d, values, err := c.dashboard(name)
+	if err != nil {
+		c.addError(err)
+		return err.Error()
+	}
+	return DashboardLink(c.schedule.SystemConf, d, values, c.runHistory.Start)
+}
+
+// Dashboard renders the graph panels of the named dashboard with its
+// variables set to the context's tags, linked to its permalink. Emails embed
+// the graphs as PNG snapshots.
+func (c *Context) Dashboard(name string) interface{} {
+	d, values, err := c.dashboard(name)
+	if err != nil {
+		c.addError(err)
+		return err.Error()
+	}
+	title := d.Title
+	if title == "" {
+		title = d.Name
 	}
-	return c.graph(v, unit, false)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<h3><a href="%s">%s</a></h3>`,
+		template.HTMLEscapeString(DashboardLink(c.schedule.SystemConf, d, values, c.runHistory.Start)),
+		template.HTMLEscapeString(title))
+	for _, p := range d.Panels {
+		if p.Type != "graph" {
+			continue
+		}
+		title := p.Title
+		if title == "" {
+			title = p.Name
+		}
+		fmt.Fprintf(&buf, `<h4>%s</h4>`, template.HTMLEscapeString(title))
+		text, err := c.schedule.DashboardExpr(d, p, values)
+		if err != nil {
+			c.addError(err)
+			buf.WriteString(template.HTMLEscapeString(err.Error()))
+			continue
+		}
+		switch g := c.graph(text, false, []string{"unit=" + p.Unit}).(type) {
+		case template.HTML:
+			buf.WriteString(string(g))
+		default:
+			buf.WriteString(template.HTMLEscapeString(fmt.Sprint(g)))
+		}
+	}
+	return template.HTML(buf.String())
+}
+
+// SLO returns the status of the named slo section for the group whose tags
+// are in the context's tags, as of the last check of the slo, or nil if
+// there is none.
+func (c *Context) SLO(name string) interface{} {
+	if c.schedule.RuleConf.GetSLO(name) == nil {
+		c.addError(fmt.Errorf("unknown slo %v", name))
+		return nil
+	}
+	st, err := c.schedule.SLOStatus(name)
+	if err != nil {
+		c.addError(err)
+		return nil
+	}
+	if st == nil {
+		return nil
+	}
+	group := c.AlertKey.Group()
+	for _, g := range st.Groups {
+		if g.Group.Subset(group) {
+			return g
+		}
+	}
+	return nil
 }
 
 // GetMeta fetches either metric metadata (if a metric name is provided)
@@ -0,0 +1,410 @@
+package sched
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/cmd/bosun/expr/parse"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+	"github.com/ryanuber/go-glob"
+)
+
+// maxTopologyDepth bounds walks upstream, in case the topology has a cycle.
+const maxTopologyDepth = 32
+
+// Topology relates tags to the tags upstream of them, such as a host to the
+// switch it is behind. Tags are written as tagk=tagv. Alert keys with a tag
+// that has an upstream tag in a critical incident are unevaluated, so that
+// only the root cause of an outage notifies.
+type Topology struct {
+	conf conf.TopologyConf
+
+	mu       sync.Mutex
+	modTime  time.Time
+	edges    map[string]string // from the file
+	globs    []topologyGlob    // from the file, in order
+	meta     map[string]string // metadata lookups since the last refresh
+	critical map[string]models.AlertKeys
+}
+
+type topologyGlob struct {
+	child, parent string
+}
+
+// NewTopology returns the topology of c, or nil if c reads it from nowhere.
+func NewTopology(c conf.TopologyConf) (*Topology, error) {
+	if c.File == "" && c.Metadata == "" {
+		return nil, nil
+	}
+	t := &Topology{
+		conf:     c,
+		edges:    make(map[string]string),
+		meta:     make(map[string]string),
+		critical: make(map[string]models.AlertKeys),
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// load reads the file of t if it changed since it was last read.
+func (t *Topology) load() error {
+	if t.conf.File == "" {
+		return nil
+	}
+	fi, err := os.Stat(t.conf.File)
+	if err != nil {
+		return err
+	}
+	if fi.ModTime().Equal(t.modTime) {
+		return nil
+	}
+	f, err := os.Open(t.conf.File)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	edges, globs, err := parseTopology(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", t.conf.File, err)
+	}
+	t.mu.Lock()
+	t.edges, t.globs, t.modTime = edges, globs, fi.ModTime()
+	t.mu.Unlock()
+	return nil
+}
+
+// parseTopology parses lines of a child tag and its parent tag, separated
+// by white space. Lines starting with # are comments.
+func parseTopology(r io.Reader) (map[string]string, []topologyGlob, error) {
+	edges := make(map[string]string)
+	var globs []topologyGlob
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) != 2 {
+			return nil, nil, fmt.Errorf("line %d: expected a child and a parent tag", n)
+		}
+		child, parent := f[0], f[1]
+		for _, tag := range f {
+			if _, _, err := splitTopologyTag(tag); err != nil {
+				return nil, nil, fmt.Errorf("line %d: %v", n, err)
+			}
+		}
+		if strings.Contains(parent, "*") {
+			return nil, nil, fmt.Errorf("line %d: parent %s may not be a glob", n, parent)
+		}
+		if strings.Contains(child, "*") {
+			globs = append(globs, topologyGlob{child, parent})
+		} else {
+			edges[child] = parent
+		}
+	}
+	return edges, globs, sc.Err()
+}
+
+func splitTopologyTag(tag string) (k, v string, err error) {
+	sp := strings.SplitN(tag, "=", 2)
+	if len(sp) != 2 || sp[0] == "" || sp[1] == "" {
+		return "", "", fmt.Errorf("bad tag %q, expected tagk=tagv", tag)
+	}
+	return sp[0], sp[1], nil
+}
+
+// upstream returns the parent of tag, or "" if it has none. Metadata is
+// looked up without holding t.mu.
+func (t *Topology) upstream(tag string, md database.MetadataDataAccess) string {
+	t.mu.Lock()
+	p, ok := t.edges[tag]
+	if !ok {
+		for _, g := range t.globs {
+			if glob.Glob(g.child, tag) {
+				p, ok = g.parent, true
+				break
+			}
+		}
+	}
+	if !ok {
+		p, ok = t.meta[tag]
+	}
+	t.mu.Unlock()
+	if ok || t.conf.Metadata == "" || md == nil {
+		return p
+	}
+	k, v, _ := splitTopologyTag(tag)
+	meta, err := md.GetTagMetadata(opentsdb.TagSet{k: v}, t.conf.Metadata)
+	if err != nil {
+		slog.Errorf("topology: looking up %s of %s: %v", t.conf.Metadata, tag, err)
+	}
+	for _, m := range meta {
+		if len(m.Tags) != 1 || m.Name != t.conf.Metadata || m.Value == "" {
+			continue
+		}
+		p = m.Value
+		if !strings.Contains(p, "=") {
+			p = k + "=" + p
+		}
+	}
+	t.mu.Lock()
+	t.meta[tag] = p
+	t.mu.Unlock()
+	return p
+}
+
+// ancestors returns the tags upstream of tag, nearest first. t.mu must not
+// be held.
+func (t *Topology) ancestors(tag string, md database.MetadataDataAccess) []string {
+	var up []string
+	seen := map[string]bool{tag: true}
+	for i := 0; i < maxTopologyDepth; i++ {
+		tag = t.upstream(tag, md)
+		if tag == "" || seen[tag] {
+			break
+		}
+		seen[tag] = true
+		up = append(up, tag)
+	}
+	return up
+}
+
+// criticalTags returns the critical alert keys by the tag they represent as
+// of the last refresh. The map is replaced, not changed, on refresh.
+func (t *Topology) criticalTags() map[string]models.AlertKeys {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.critical
+}
+
+// refreshTopology rereads the topology file if it changed, forgets
+// metadata lookups, and notes the tags of critical incidents: those whose
+// group is a single tag, such as a host down alert of host=ny-sw01, so that
+// an incident of one disk of a host does not suppress what is behind it. It
+// is done before each check, so alerts are suppressed from the check after
+// their upstream became critical.
+func (s *Schedule) refreshTopology() {
+	t := s.Topology
+	if t == nil {
+		return
+	}
+	if err := t.load(); err != nil {
+		slog.Errorf("topology: %v", err)
+	}
+	critical := make(map[string]models.AlertKeys)
+	incidents, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		slog.Errorf("topology: %v", err)
+		return
+	}
+	for _, inc := range incidents {
+		if inc.CurrentStatus != models.StCritical {
+			continue
+		}
+		group := inc.AlertKey.Group()
+		if len(group) != 1 {
+			continue
+		}
+		for k, v := range group {
+			tag := k + "=" + v
+			critical[tag] = append(critical[tag], inc.AlertKey)
+		}
+	}
+	t.mu.Lock()
+	t.critical = critical
+	t.meta = make(map[string]string)
+	t.mu.Unlock()
+}
+
+// suppressedBy returns a critical alert key with a tag upstream of ak, or
+// "" if there is none.
+func (s *Schedule) suppressedBy(ak models.AlertKey) models.AlertKey {
+	t := s.Topology
+	if t == nil {
+		return ""
+	}
+	critical := t.criticalTags()
+	if len(critical) == 0 {
+		return ""
+	}
+	md := s.DataAccess.Metadata()
+	for k, v := range ak.Group() {
+		for _, up := range t.ancestors(k+"="+v, md) {
+			for _, c := range critical[up] {
+				if c != ak {
+					return c
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// markTopologyUnevaluated marks the events of alert whose upstream is
+// critical as unevaluated, and returns how many it marked.
+func (s *Schedule) markTopologyUnevaluated(events map[models.AlertKey]*models.Event, alert string) (count int) {
+	if s.Topology == nil {
+		return 0
+	}
+	for ak, ev := range events {
+		if ak.Name() != alert || ev.Unevaluated {
+			continue
+		}
+		if by := s.suppressedBy(ak); by != "" {
+			slog.Infof("%s suppressed by upstream %s", ak, by)
+			ev.Unevaluated = true
+			count++
+		}
+	}
+	return count
+}
+
+// Types of nodes and edges of the dependency graph.
+const (
+	DependencyAlert    = "alert"    // alert nodes, and edges from depends
+	DependencyTag      = "tag"      // tag nodes
+	DependencyUpstream = "upstream" // edges from the topology
+)
+
+// DependencyGraph is the graph of alerts that depend on other alerts, and
+// of tags and their upstream tags.
+type DependencyGraph struct {
+	Nodes []*DependencyNode
+	Edges []*DependencyEdge
+}
+
+// DependencyNode is an alert or a tag.
+type DependencyNode struct {
+	Id   string // type:name, such as alert:host.down or tag:host=ny-web01
+	Type string
+	Name string
+	// Status is the worst current status of the open incidents of the alert
+	// or with the tag.
+	Status    models.Status
+	Incidents int
+	// SuppressedBy is the critical alert key upstream of a tag, if any.
+	SuppressedBy models.AlertKey `json:",omitempty"`
+}
+
+// DependencyEdge is an edge from a node to a node it depends on.
+type DependencyEdge struct {
+	Source, Target string
+	Type           string
+}
+
+// Dependencies returns the graph of alerts that depend on other alerts with
+// the alert function in their depends expression, and of the tags of open
+// incidents and in the topology file with the tags upstream of them.
+func (s *Schedule) Dependencies() (*DependencyGraph, error) {
+	incidents, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		return nil, err
+	}
+	g := &DependencyGraph{}
+	nodes := make(map[string]*DependencyNode)
+	node := func(typ, name string) *DependencyNode {
+		id := typ + ":" + name
+		n := nodes[id]
+		if n == nil {
+			n = &DependencyNode{Id: id, Type: typ, Name: name}
+			nodes[id] = n
+		}
+		return n
+	}
+	edges := make(map[DependencyEdge]bool)
+	edge := func(source, target *DependencyNode, typ string) {
+		edges[DependencyEdge{source.Id, target.Id, typ}] = true
+	}
+	for name, a := range s.RuleConf.GetAlerts() {
+		if a.Depends == nil {
+			continue
+		}
+		parse.Walk(a.Depends.Root, func(n parse.Node) {
+			f, ok := n.(*parse.FuncNode)
+			if !ok || f.Name != "alert" || len(f.Args) == 0 {
+				return
+			}
+			if dep, ok := f.Args[0].(*parse.StringNode); ok {
+				edge(node(DependencyAlert, name), node(DependencyAlert, dep.Text), DependencyAlert)
+			}
+		})
+	}
+	status := func(n *DependencyNode, st models.Status) {
+		n.Incidents++
+		if st > n.Status {
+			n.Status = st
+		}
+	}
+	for _, inc := range incidents {
+		status(node(DependencyAlert, inc.AlertKey.Name()), inc.CurrentStatus)
+	}
+	if t := s.Topology; t != nil {
+		md := s.DataAccess.Metadata()
+		t.mu.Lock()
+		fileEdges := t.edges
+		t.mu.Unlock()
+		critical := t.criticalTags()
+		for child, parent := range fileEdges {
+			edge(node(DependencyTag, child), node(DependencyTag, parent), DependencyUpstream)
+		}
+		for _, inc := range incidents {
+			for k, v := range inc.AlertKey.Group() {
+				tag := k + "=" + v
+				status(node(DependencyTag, tag), inc.CurrentStatus)
+				child := tag
+				for _, up := range t.ancestors(tag, md) {
+					edge(node(DependencyTag, child), node(DependencyTag, up), DependencyUpstream)
+					child = up
+				}
+			}
+		}
+		// Tags of incidents are only nodes if they are in the topology.
+		linked := make(map[string]bool)
+		for e := range edges {
+			linked[e.Source], linked[e.Target] = true, true
+		}
+		for id, n := range nodes {
+			if n.Type != DependencyTag {
+				continue
+			}
+			if !linked[id] {
+				delete(nodes, id)
+				continue
+			}
+			for _, up := range t.ancestors(n.Name, md) {
+				if c := critical[up]; len(c) > 0 {
+					n.SuppressedBy = c[0]
+					break
+				}
+			}
+		}
+	}
+	for _, n := range nodes {
+		g.Nodes = append(g.Nodes, n)
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].Id < g.Nodes[j].Id })
+	for e := range edges {
+		e := e
+		g.Edges = append(g.Edges, &e)
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].Source != g.Edges[j].Source {
+			return g.Edges[i].Source < g.Edges[j].Source
+		}
+		return g.Edges[i].Target < g.Edges[j].Target
+	})
+	return g, nil
+}
@@ -0,0 +1,140 @@
+package sched
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+)
+
+func TestParseTopology(t *testing.T) {
+	edges, globs, err := parseTopology(strings.NewReader(`
+		# hosts behind switches
+		host=ny-web01 host=ny-sw01
+		host=ny-*     host=ny-sw02
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(edges, map[string]string{"host=ny-web01": "host=ny-sw01"}) {
+		t.Errorf("bad edges: %v", edges)
+	}
+	if !reflect.DeepEqual(globs, []topologyGlob{{"host=ny-*", "host=ny-sw02"}}) {
+		t.Errorf("bad globs: %v", globs)
+	}
+	for _, bad := range []string{
+		"host=a",
+		"host=a host=b host=c",
+		"host host=b",
+		"host=a host=*",
+	} {
+		if _, _, err := parseTopology(strings.NewReader(bad)); err == nil {
+			t.Errorf("expected error parsing %q", bad)
+		}
+	}
+}
+
+func TestTopology(t *testing.T) {
+	defer setup()()
+	dir, err := ioutil.TempDir("", "topology")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "topology")
+	err = ioutil.WriteFile(file, []byte(`
+		host=ny-web* host=ny-sw01
+		host=ny-sw01 host=ny-router
+		host=ny-router host=ny-sw01
+		host=la-web* host=la-sw01
+	`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		alert a {
+			crit = avg(q("avg:m{host=*}", "1h", ""))
+		}
+		alert b {
+			depends = alert("a", "crit")
+			crit = avg(q("avg:m{host=*}", "1h", ""))
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{TopologyConf: conf.TopologyConf{File: file, Metadata: "upstream"}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DataAccess.Metadata().PutTagMetadata(opentsdb.TagSet{"host": "ny-db01"}, "upstream", "ny-web01", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	router := models.AlertKey("a{host=ny-router}")
+	// An incident of a disk of la-sw01 is not an incident of la-sw01.
+	disk := models.AlertKey("b{disk=sda,host=la-sw01}")
+	for _, ak := range []models.AlertKey{router, disk} {
+		_, err = s.DataAccess.State().UpdateIncidentState(&models.IncidentState{AlertKey: ak, Alert: ak.Name(), Tags: ak.Group().Tags(), Open: true, CurrentStatus: models.StCritical, WorstStatus: models.StCritical})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.refreshTopology()
+
+	events := map[models.AlertKey]*models.Event{
+		router:                        {Status: models.StCritical},
+		"b{host=ny-db01}":             {Status: models.StCritical},
+		"b{host=ny-web02,service=db}": {Status: models.StWarning},
+		"b{host=la-web01}":            {Status: models.StCritical},
+		"a{host=ny-web03}":            {Status: models.StCritical},
+	}
+	if n := s.markTopologyUnevaluated(events, "b"); n != 2 {
+		t.Errorf("expected 2 suppressed events of b, got %d", n)
+	}
+	if n := s.markTopologyUnevaluated(events, "a"); n != 1 {
+		t.Errorf("expected 1 suppressed event of a, got %d", n)
+	}
+	for ak, ev := range events {
+		want := ak != router && ak != "b{host=la-web01}"
+		if ev.Unevaluated != want {
+			t.Errorf("%s: expected unevaluated %v", ak, want)
+		}
+	}
+
+	g, err := s.Dependencies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	edges := make(map[string]string)
+	for _, e := range g.Edges {
+		edges[e.Source] = e.Target
+	}
+	want := map[string]string{
+		"alert:b":            "alert:a",
+		"tag:host=ny-sw01":   "tag:host=ny-router",
+		"tag:host=ny-router": "tag:host=ny-sw01",
+	}
+	if !reflect.DeepEqual(edges, want) {
+		t.Errorf("bad edges: %v", edges)
+	}
+	for _, n := range g.Nodes {
+		switch n.Id {
+		case "alert:a", "tag:host=ny-router":
+			if n.Status != models.StCritical || n.Incidents != 1 {
+				t.Errorf("%s: bad status %v of %d incidents", n.Id, n.Status, n.Incidents)
+			}
+		case "tag:host=ny-sw01":
+			if n.SuppressedBy != router {
+				t.Errorf("%s: expected to be suppressed by %s, is by %q", n.Id, router, n.SuppressedBy)
+			}
+		}
+	}
+}
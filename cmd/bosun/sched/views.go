@@ -27,18 +27,22 @@ func MakeEventSummary(e models.Event) (EventSummary, bool) {
 }
 
 type EpochAction struct {
-	User    string
-	Message string
-	Time    int64
-	Type    models.ActionType
+	User     string
+	Message  string
+	Time     int64
+	Type     models.ActionType
+	Assignee string `json:",omitempty"`
+	Team     string `json:",omitempty"`
 }
 
 func MakeEpochAction(a models.Action) EpochAction {
 	return EpochAction{
-		User:    a.User,
-		Message: a.Message,
-		Time:    a.Time.UTC().Unix(),
-		Type:    a.Type,
+		User:     a.User,
+		Message:  a.Message,
+		Time:     a.Time.UTC().Unix(),
+		Type:     a.Type,
+		Assignee: a.Assignee,
+		Team:     a.Team,
 	}
 }
 
@@ -56,6 +60,9 @@ type IncidentSummaryView struct {
 	Unevaluated            bool
 	NeedAck                bool
 	Silenced               bool
+	Assignee               string
+	Team                   string
+	EscalationLevel        int
 	Actions                []EpochAction
 	Events                 []EventSummary
 	WarnNotificationChains [][]string
@@ -67,8 +74,22 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 	if alert == nil {
 		return nil, fmt.Errorf("alert %v does not exist in the configuration", is.AlertKey.Name())
 	}
-	warnNotifications := alert.WarnNotification.Get(c, is.AlertKey.Group())
-	critNotifications := alert.CritNotification.Get(c, is.AlertKey.Group())
+	return makeIncidentSummary(c, alert, s, is), nil
+}
+
+// MakeHistoricIncidentSummary is like MakeIncidentSummary, but allows the
+// alert of the incident to have been removed from the configuration since.
+// The notification chains of such incidents are empty.
+func MakeHistoricIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.IncidentState) *IncidentSummaryView {
+	return makeIncidentSummary(c, c.GetAlert(is.AlertKey.Name()), s, is)
+}
+
+func makeIncidentSummary(c conf.RuleConfProvider, alert *conf.Alert, s SilenceTester, is *models.IncidentState) *IncidentSummaryView {
+	warnChains, critChains := [][]string{}, [][]string{}
+	if alert != nil {
+		warnChains = conf.GetNotificationChains(c, alert.WarnNotification.Get(c, is.AlertKey.Group()))
+		critChains = conf.GetNotificationChains(c, alert.CritNotification.Get(c, is.AlertKey.Group()))
+	}
 	eventSummaries := []EventSummary{}
 	nonNormalNonUnknownCount := 0
 	for _, event := range is.Events {
@@ -103,11 +124,14 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 		Unevaluated:            is.Unevaluated,
 		NeedAck:                is.NeedAck,
 		Silenced:               s(is.AlertKey) != nil,
+		Assignee:               is.Assignee,
+		Team:                   is.Team,
+		EscalationLevel:        is.EscalationLevel,
 		Actions:                actions,
 		Events:                 eventSummaries,
-		WarnNotificationChains: conf.GetNotificationChains(c, warnNotifications),
-		CritNotificationChains: conf.GetNotificationChains(c, critNotifications),
-	}, nil
+		WarnNotificationChains: warnChains,
+		CritNotificationChains: critChains,
+	}
 }
 
 func (is IncidentSummaryView) Ask(filter string) (bool, error) {
@@ -237,6 +261,17 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 		return is.LastAbnormalStatus.String() == value, nil
 	case "subject":
 		return glob.Glob(value, is.Subject), nil
+	case "assignee":
+		// An empty value matches unassigned incidents.
+		if value == "" {
+			return is.Assignee == "", nil
+		}
+		return glob.Glob(value, is.Assignee), nil
+	case "team":
+		if value == "" {
+			return is.Team == "", nil
+		}
+		return glob.Glob(value, is.Team), nil
 	}
 	return false, nil
 }
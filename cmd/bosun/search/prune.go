@@ -0,0 +1,158 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.search.pruned", metadata.Counter, metadata.Count, "Number of search index entries removed for not being seen within the retention")
+}
+
+// PruneStatus is the progress of the latest pruning of the search index.
+type PruneStatus struct {
+	Running bool
+	// Before is the time entries must have been seen since to be kept.
+	Before   time.Time
+	Started  time.Time
+	Finished *time.Time `json:",omitempty"`
+	// Metrics is the number of metrics pruned so far, out of Total.
+	Metrics int
+	Total   int
+	Removed int
+	Error   string `json:",omitempty"`
+}
+
+type pruner struct {
+	sync.Mutex
+	status PruneStatus
+}
+
+// PruneStatus returns the progress of the latest pruning of the index, or
+// the zero PruneStatus if it has never been pruned.
+func (s *Search) PruneStatus() PruneStatus {
+	s.pruner.Lock()
+	defer s.pruner.Unlock()
+	return s.pruner.status
+}
+
+// Prune removes the metrics, tag keys, tag values and tag sets of uid that
+// have not been seen since before, along with their last data points. No
+// more than rate metrics are pruned each second. It returns an error if the
+// index is already being pruned.
+func (s *Search) Prune(uid string, before time.Time, rate int) error {
+	s.pruner.Lock()
+	if s.pruner.status.Running {
+		s.pruner.Unlock()
+		return fmt.Errorf("search index is already being pruned")
+	}
+	s.pruner.status = PruneStatus{Running: true, Before: before, Started: time.Now().UTC()}
+	s.pruner.Unlock()
+
+	err := s.prune(uid, before, rate)
+	s.pruner.Lock()
+	now := time.Now().UTC()
+	s.pruner.status.Running = false
+	s.pruner.status.Finished = &now
+	if err != nil {
+		s.pruner.status.Error = err.Error()
+	}
+	s.pruner.Unlock()
+	return err
+}
+
+func (s *Search) prune(uid string, before time.Time, rate int) error {
+	data := s.DataAccess.Search()
+	metrics, err := data.GetAllMetrics(uid)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(metrics))
+	for m := range metrics {
+		names = append(names, m)
+	}
+	sort.Strings(names)
+	s.pruner.Lock()
+	s.pruner.status.Total = len(names)
+	s.pruner.Unlock()
+	if rate < 1 {
+		rate = 1
+	}
+	tick := time.NewTicker(time.Second / time.Duration(rate))
+	defer tick.Stop()
+	t := before.Unix()
+	tagKeys := make(map[string]bool)
+	for _, m := range names {
+		<-tick.C
+		keys, err := data.GetTagKeysForMetric(m, uid)
+		if err != nil {
+			return err
+		}
+		for k := range keys {
+			tagKeys[k] = true
+		}
+		n, err := data.PruneMetric(m, uid, t)
+		s.pruned(n)
+		if err != nil {
+			return err
+		}
+		s.pruner.Lock()
+		s.pruner.status.Metrics++
+		s.pruner.Unlock()
+	}
+	for k := range tagKeys {
+		n, err := data.PruneTagValues(database.Search_All, k, uid, t)
+		s.pruned(n)
+		if err != nil {
+			return err
+		}
+	}
+	s.Lock()
+	for m, mmap := range s.last {
+		for tags, p := range mmap {
+			if p.Timestamp < t {
+				delete(mmap, tags)
+			}
+		}
+		if len(mmap) == 0 {
+			delete(s.last, m)
+		}
+	}
+	s.Unlock()
+	return nil
+}
+
+func (s *Search) pruned(n int) {
+	if n == 0 {
+		return
+	}
+	s.pruner.Lock()
+	s.pruner.status.Removed += n
+	s.pruner.Unlock()
+	collect.Add("search.pruned", opentsdb.TagSet{}, int64(n))
+}
+
+// RunPrune prunes the index of uid every interval, removing entries not seen
+// within retention, until done is closed.
+func (s *Search) RunPrune(uid string, interval, retention time.Duration, rate int, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if err := s.Prune(uid, time.Now().Add(-retention), rate); err != nil {
+			slog.Errorf("failed to prune search index: %v", err)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/leapar/bosun/metadata"
 	"github.com/leapar/bosun/opentsdb"
 	"github.com/leapar/bosun/slog"
+	"github.com/leapar/bosun/util"
 )
 
 // Search is a struct to hold indexed data about OpenTSDB metric and tag data.
@@ -29,6 +30,8 @@ type Search struct {
 
 	indexQueue chan *opentsdb.DataPoint
 	sync.RWMutex
+
+	pruner pruner
 }
 
 func init() {
@@ -189,6 +192,60 @@ func (s *Search) GetLastInt64(metric, tags string, diff bool) (int64, int64, err
 	return int64(v), t, err
 }
 
+// Seen is the time of the most recent data point of a group of series.
+type Seen struct {
+	Group     opentsdb.TagSet
+	Timestamp int64
+}
+
+// LastSeen returns when metric was last seen for each group of tags, ordered
+// by group. Series are included if their tag values match the glob patterns
+// of tags, and grouped by the keys of tags.
+func (s *Search) LastSeen(metric string, tags opentsdb.TagSet) []*Seen {
+	s.RLock()
+	groups := make(map[string]*Seen)
+Series:
+	for k, p := range s.last[metric] {
+		ts := opentsdb.TagSet{}
+		if k != "{}" {
+			var err error
+			if ts, err = opentsdb.ParseTags(strings.TrimSuffix(strings.TrimPrefix(k, "{"), "}")); err != nil {
+				continue
+			}
+		}
+		group := make(opentsdb.TagSet, len(tags))
+		for tagk, pattern := range tags {
+			v, ok := ts[tagk]
+			if !ok {
+				continue Series
+			}
+			if m, _ := util.Match(pattern, v); !m {
+				continue Series
+			}
+			group[tagk] = v
+		}
+		g := groups[group.String()]
+		if g == nil {
+			g = &Seen{Group: group}
+			groups[group.String()] = g
+		}
+		if p.Timestamp > g.Timestamp {
+			g.Timestamp = p.Timestamp
+		}
+	}
+	s.RUnlock()
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	r := make([]*Seen, len(keys))
+	for i, k := range keys {
+		r[i] = groups[k]
+	}
+	return r
+}
+
 // load stored last data from redis
 func (s *Search) loadLast() {
 	s.Lock()
@@ -0,0 +1,177 @@
+package web
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/database"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/captncraig/easyauth"
+	"github.com/gorilla/mux"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.audit.errors", metadata.Counter, metadata.Error,
+		"The number of audit entries that could not be recorded.")
+}
+
+// auditSink appends audit entries to a file as JSON lines, if configured.
+var auditSink *auditFile
+
+type auditFile struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func initAudit(file string) error {
+	auditSink = nil
+	if file == "" {
+		return nil
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return fmt.Errorf("AuditConf.File: %v", err)
+	}
+	auditSink = &auditFile{f: f}
+	return nil
+}
+
+func (a *auditFile) write(e *models.AuditEntry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.f.Write(append(b, '\n'))
+	return err
+}
+
+// maxAuditBody bounds how much of a body the handler did not read is read
+// to digest it.
+const maxAuditBody = 10 << 20
+
+// auditWriter records the status of the response to an audited request,
+// and what the handler noted the request acted on.
+type auditWriter struct {
+	http.ResponseWriter
+	status int
+	target string
+	skip   bool
+}
+
+func (w *auditWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// auditTarget notes what an audited request acts on.
+func auditTarget(w http.ResponseWriter, format string, args ...interface{}) {
+	if aw, ok := w.(*auditWriter); ok {
+		aw.target = fmt.Sprintf(format, args...)
+	}
+}
+
+// auditSkip notes that an audited request changes nothing, such as a test
+// of a silence, and need not be recorded.
+func auditSkip(w http.ResponseWriter) {
+	if aw, ok := w.(*auditWriter); ok {
+		aw.skip = true
+	}
+}
+
+// audited records requests to h that may change state, which are those that
+// are not GET or HEAD requests, in the audit log. It must be inside the
+// auth middleware, to know the user.
+func audited(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			h.ServeHTTP(w, r)
+			return
+		}
+		e := &models.AuditEntry{
+			Time:         time.Now().UTC(),
+			RemoteAddr:   r.RemoteAddr,
+			ForwardedFor: r.Header.Get("X-Forwarded-For"),
+			Method:       r.Method,
+			Path:         r.URL.Path,
+		}
+		if route := mux.CurrentRoute(r); route != nil {
+			e.Route = route.GetName()
+		}
+		if u := easyauth.GetUser(r); u != nil {
+			e.User, e.AuthMethod = u.Username, u.Method
+		}
+		digest := sha256.New()
+		body := r.Body
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.TeeReader(body, digest), body}
+		aw := &auditWriter{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(aw, r)
+		if aw.skip {
+			return
+		}
+		// Digest all of the body, even if the handler stopped reading.
+		io.Copy(digest, io.LimitReader(body, maxAuditBody))
+		e.Digest = hex.EncodeToString(digest.Sum(nil))
+		e.Target = aw.target
+		e.Status = aw.status
+		recordAudit(e)
+	})
+}
+
+func recordAudit(e *models.AuditEntry) {
+	err := schedule.DataAccess.Audit().AddAuditEntry(e, schedule.SystemConf.GetAuditMaxEntries())
+	if err == nil && auditSink != nil {
+		err = auditSink.write(e)
+	}
+	if err != nil {
+		slog.Errorf("audit: recording %s %s by %s: %v", e.Method, e.Path, e.User, err)
+		collect.Add("audit.errors", opentsdb.TagSet{}, 1)
+	}
+}
+
+// Audit returns the entries of the audit log that match the user, route,
+// since, until and before parameters, newest first.
+func Audit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	q := database.AuditQuery{
+		User:  r.FormValue("user"),
+		Route: r.FormValue("route"),
+		Limit: 100,
+	}
+	now := time.Now().UTC()
+	var err error
+	if q.Since, err = parseSearchTime(r.FormValue("since"), now); err != nil {
+		return nil, err
+	}
+	if q.Until, err = parseSearchTime(r.FormValue("until"), now); err != nil {
+		return nil, err
+	}
+	if s := r.FormValue("before"); s != "" {
+		if q.Before, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("bad before: %s", s)
+		}
+	}
+	if s := r.FormValue("limit"); s != "" {
+		if q.Limit, err = strconv.Atoi(s); err != nil || q.Limit < 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+	}
+	return schedule.DataAccess.Audit().GetAuditEntries(q)
+}
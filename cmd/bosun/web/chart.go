@@ -238,32 +238,17 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 	} else if e.Root.Return() != models.TypeSeriesSet {
 		return nil, fmt.Errorf("egraph: requires an expression that returns a series")
 	}
-	// it may not strictly be necessary to recreate the contexts each time, but we do to be safe
-	backends := &expr.Backends{
-		TSDBContext:     schedule.SystemConf.GetTSDBContext(),
-		GraphiteContext: schedule.SystemConf.GetGraphiteContext(),
-		InfluxConfig:    schedule.SystemConf.GetInfluxContext(),
-		LogstashHosts:   schedule.SystemConf.GetLogstashContext(),
-		ElasticHosts:    schedule.SystemConf.GetElasticContext(),
-	}
-	providers := &expr.BosunProviders{
-		Cache:     cacheObj,
-		Search:    schedule.Search,
-		Annotate:  AnnotateBackend,
-		Squelched: nil,
-		History:   nil,
-	}
-	res, _, err := e.Execute(backends, providers, t, now, autods, false)
+	_, res, _, err := execute(t, q, now, autods)
 	if err != nil {
 		return nil, err
 	}
 	switch format {
 	case "svg":
-		if err := schedule.ExprSVG(t, w, 800, 600, "", res.Results); err != nil {
+		if err := schedule.ExprSVG(t, w, 800, 600, res.Results, sched.GraphOptions{}); err != nil {
 			return nil, err
 		}
 	case "png":
-		if err := schedule.ExprPNG(t, w, 800, 600, "", res.Results); err != nil {
+		if err := schedule.ExprPNG(t, w, 800, 600, res.Results, sched.GraphOptions{}); err != nil {
 			return nil, err
 		}
 	}
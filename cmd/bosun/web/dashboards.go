@@ -0,0 +1,189 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/sched"
+	"github.com/leapar/bosun/models"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+// Dashboards returns all dashboards, without their panels.
+func Dashboards(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	dashboards, err := schedule.Dashboards()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*models.Dashboard, len(dashboards))
+	for i, d := range dashboards {
+		c := *d
+		c.Panels = nil
+		list[i] = &c
+	}
+	return list, nil
+}
+
+// Dashboard returns a dashboard and the values of its variables.
+func Dashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	d, err := getDashboard(r)
+	if err != nil {
+		return nil, err
+	}
+	uid := r.FormValue("uid")
+	if uid == "" {
+		uid = schedule.SystemConf.GetUid()
+	}
+	values, err := schedule.DashboardValues(d, uid, schedule.SystemConf.GetSearchSince())
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		*models.Dashboard
+		Values map[string][]string
+	}{d, values}, nil
+}
+
+// PutDashboard stores a dashboard, replacing the stored dashboard of the same
+// name.
+func PutDashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var d models.Dashboard
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		return nil, err
+	}
+	auditTarget(w, "%s", d.Name)
+	d.User = getUsername(r)
+	d.Time = time.Now().UTC()
+	if err := schedule.PutDashboard(&d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeleteDashboard removes a stored dashboard.
+func DeleteDashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["name"]
+	auditTarget(w, "%s", name)
+	return nil, schedule.DeleteDashboard(name)
+}
+
+// DashboardPanel executes the expression of a panel of a dashboard with its
+// variables set by the var-{name} parameters, and returns the results as the
+// expression endpoint does.
+func DashboardPanel(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	d, p, text, err := dashboardPanel(r)
+	if err != nil {
+		return nil, err
+	}
+	now, err := getTime(r)
+	if err != nil {
+		return nil, err
+	}
+	autods := 0
+	if p.Type == "graph" {
+		autods = 1000
+	}
+	e, res, queries, err := execute(t, text, now, autods)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		*exprResult
+		Expr      string
+		Permalink string
+	}{exprResponse(e, res, queries), text, sched.DashboardLink(schedule.SystemConf, d, dashboardValues(r), now)}, nil
+}
+
+// DashboardPanelGraph renders a graph panel of a dashboard as an svg or png
+// image, with its variables set by the var-{name} parameters. The size of the
+// image is set by the width and height parameters.
+func DashboardPanelGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	_, p, text, err := dashboardPanel(r)
+	if err != nil {
+		return nil, err
+	}
+	if p.Type != "graph" {
+		return nil, fmt.Errorf("panel %s is not a graph", p.Name)
+	}
+	now, err := getTime(r)
+	if err != nil {
+		return nil, err
+	}
+	width, height := 800, 600
+	if v := r.FormValue("width"); v != "" {
+		if width, err = strconv.Atoi(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := r.FormValue("height"); v != "" {
+		if height, err = strconv.Atoi(v); err != nil {
+			return nil, err
+		}
+	}
+	if width < 1 || height < 1 || width > 4000 || height > 4000 {
+		return nil, fmt.Errorf("width and height must be between 1 and 4000")
+	}
+	_, res, _, err := execute(t, text, now, width)
+	if err != nil {
+		return nil, err
+	}
+	switch mux.Vars(r)["format"] {
+	case "svg":
+		w.Header().Set("Content-Type", "image/svg+xml")
+		err = schedule.ExprSVG(t, w, width, height, res.Results, sched.GraphOptions{Unit: p.Unit})
+	case "png":
+		w.Header().Set("Content-Type", "image/png")
+		err = schedule.ExprPNG(t, w, width, height, res.Results, sched.GraphOptions{Unit: p.Unit})
+	}
+	return nil, err
+}
+
+func getDashboard(r *http.Request) (*models.Dashboard, error) {
+	name := mux.Vars(r)["name"]
+	d, err := schedule.Dashboard(name)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("no dashboard named %s", name)
+	}
+	return d, nil
+}
+
+// dashboardPanel returns the dashboard and panel of the request, and the
+// expression of the panel with the variables of the request.
+func dashboardPanel(r *http.Request) (*models.Dashboard, *models.DashboardPanel, string, error) {
+	d, err := getDashboard(r)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	name := mux.Vars(r)["panel"]
+	p := d.Panel(name)
+	if p == nil {
+		return nil, nil, "", fmt.Errorf("dashboard %s has no panel %s", d.Name, name)
+	}
+	text, err := schedule.DashboardExpr(d, p, dashboardValues(r))
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return d, p, text, nil
+}
+
+// dashboardValues returns the values of dashboard variables set by the
+// var-{name} parameters of r.
+func dashboardValues(r *http.Request) map[string]string {
+	r.ParseForm()
+	values := make(map[string]string)
+	for k, v := range r.Form {
+		if strings.HasPrefix(k, "var-") && len(v) > 0 {
+			values[k[len("var-"):]] = v[0]
+		}
+	}
+	return values
+}
@@ -15,7 +15,6 @@ import (
 
 	"github.com/leapar/bosun/cmd/bosun/cache"
 	"github.com/leapar/bosun/cmd/bosun/conf"
-	"github.com/leapar/bosun/cmd/bosun/conf/rule"
 	"github.com/leapar/bosun/cmd/bosun/expr"
 	"github.com/leapar/bosun/cmd/bosun/sched"
 	"github.com/leapar/bosun/models"
@@ -28,7 +27,7 @@ import (
 // Matt and I decided not to expire the cache at given points (such as reloading rule page), but I forgot why. ?
 // the only risk is that if you query your store for data -5m to now and your store doesn't have the latest points up to date,
 // and then 5m from now you query -10min to -5m you'll get the same cached data, including the incomplete last points
-var cacheObj = cache.New(100)
+var cacheObj = cache.NewNamed("web", 100)
 
 func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v interface{}, err error) {
 	defer func() {
@@ -64,14 +63,50 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 			vars[name] = schedule.RuleConf.Expand(value, vars, false)
 		}
 	}
-	e, err := expr.New(expression, schedule.RuleConf.GetFuncs(schedule.SystemConf.EnabledBackends()))
+	now, err := getTime(r)
 	if err != nil {
 		return nil, err
 	}
-	now, err := getTime(r)
+	e, res, queries, err := execute(t, expression, now, 0)
 	if err != nil {
 		return nil, err
 	}
+	return exprResponse(e, res, queries), nil
+}
+
+// exprResult is the response of the expression endpoint.
+type exprResult struct {
+	Type    string
+	Results []*expr.Result
+	Queries map[string]opentsdb.Request
+}
+
+func exprResponse(e *expr.Expr, res *expr.Results, queries []opentsdb.Request) *exprResult {
+	for _, r := range res.Results {
+		if r.Computations == nil {
+			r.Computations = make(models.Computations, 0)
+		}
+	}
+	ret := &exprResult{
+		e.Tree.Root.Return().String(),
+		res.Results,
+		make(map[string]opentsdb.Request),
+	}
+	for _, q := range queries {
+		if e, err := url.QueryUnescape(q.String()); err == nil {
+			ret.Queries[e] = q
+		}
+	}
+	return ret
+}
+
+// execute parses and executes expression at now with the backends and caches
+// of the web UI.
+func execute(t miniprofiler.Timer, expression string, now time.Time, autods int) (*expr.Expr, *expr.Results, []opentsdb.Request, error) {
+	e, err := expr.New(expression, schedule.RuleConf.GetFuncs(schedule.SystemConf.EnabledBackends()))
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	// it may not strictly be necessary to recreate the contexts each time, but we do to be safe
 	backends := &expr.Backends{
 		TSDBContext:     schedule.SystemConf.GetTSDBContext(),
@@ -86,31 +121,15 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		Squelched: nil,
 		History:   nil,
 		Annotate:  AnnotateBackend,
+
+		QueryCache: schedule.QueryCache,
+		Inventory:  schedule.DataAccess.Inventory(),
 	}
-	res, queries, err := e.Execute(backends, providers, t, now, 0, false)
+	res, queries, err := e.Execute(backends, providers, t, now, autods, false)
 	if err != nil {
-		return nil, err
-	}
-	for _, r := range res.Results {
-		if r.Computations == nil {
-			r.Computations = make(models.Computations, 0)
-		}
-	}
-	ret := struct {
-		Type    string
-		Results []*expr.Result
-		Queries map[string]opentsdb.Request
-	}{
-		e.Tree.Root.Return().String(),
-		res.Results,
-		make(map[string]opentsdb.Request),
-	}
-	for _, q := range queries {
-		if e, err := url.QueryUnescape(q.String()); err == nil {
-			ret.Queries[e] = q
-		}
+		return nil, nil, nil, err
 	}
-	return ret, nil
+	return e, res, queries, nil
 }
 
 func getTime(r *http.Request) (now time.Time, err error) {
@@ -137,6 +156,7 @@ type Res struct {
 func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Alert, now time.Time, summary bool, email string, template_group string) (*ruleResult, error) {
 	s := &sched.Schedule{}
 	s.Search = schedule.Search
+	s.QueryCache = schedule.QueryCache
 	if err := s.Init(schedule.SystemConf, ruleConf, schedule.DataAccess, AnnotateBackend, false, false); err != nil {
 		return nil, err
 	}
@@ -448,7 +468,7 @@ func buildConfig(r *http.Request) (c conf.RuleConfProvider, a *conf.Alert, hash
 	if err != nil {
 		return nil, nil, "", err
 	}
-	c, err = rule.NewConf("Test Config", schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), string(config))
+	c, err = parseRuleText("Test Config", r.FormValue("file"), string(config))
 	if err != nil {
 		return nil, nil, "", err
 	}
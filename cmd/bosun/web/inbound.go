@@ -0,0 +1,148 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/inbound"
+	"github.com/leapar/bosun/collect"
+	"github.com/leapar/bosun/metadata"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.inbound.requests", metadata.Counter, metadata.Request,
+		"The number of inbound webhook requests by provider and result.")
+}
+
+type inboundProvider struct {
+	conf   conf.InboundConf
+	parser inbound.Parser
+}
+
+// inboundProviders are the configured providers of inbound webhooks by name.
+var inboundProviders map[string]*inboundProvider
+
+func initInbound(providers map[string]conf.InboundConf) error {
+	inboundProviders = make(map[string]*inboundProvider)
+	for name, c := range providers {
+		p, err := inbound.New(c)
+		if err != nil {
+			return fmt.Errorf("InboundConf.%s: %v", name, err)
+		}
+		inboundProviders[name] = &inboundProvider{c, p}
+	}
+	return nil
+}
+
+// maxInboundBody is the largest inbound webhook body that is read.
+const maxInboundBody = 1 << 20
+
+// Inbound receives webhooks from external incident tools, and acknowledges,
+// closes or adds notes to the incidents they refer to. Every request is
+// recorded in the inbound audit log.
+func Inbound(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["provider"]
+	p, ok := inboundProviders[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown inbound provider: %s", name), http.StatusNotFound)
+		return nil, nil
+	}
+	audit := &models.InboundAudit{
+		Time:       time.Now().UTC(),
+		Provider:   name,
+		RemoteAddr: r.RemoteAddr,
+	}
+	result := "ok"
+	defer func() {
+		if err := schedule.DataAccess.Inbound().AddInboundAudit(audit); err != nil {
+			slog.Errorf("inbound %s: saving audit: %v", name, err)
+		}
+		collect.Add("inbound.requests", opentsdb.TagSet{"provider": name, "result": result}, 1)
+	}()
+	fail := func(code int, err error) (interface{}, error) {
+		audit.Error = err.Error()
+		result = http.StatusText(code)
+		slog.Warningf("inbound %s from %s: %v", name, r.RemoteAddr, err)
+		http.Error(w, err.Error(), code)
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxInboundBody))
+	if err != nil {
+		return fail(http.StatusBadRequest, err)
+	}
+	if err := inbound.Verify(p.conf, r, body); err != nil {
+		return fail(http.StatusUnauthorized, err)
+	}
+	audit.Verified = true
+	events, err := p.parser.Parse(body)
+	if err != nil {
+		return fail(http.StatusBadRequest, err)
+	}
+	for _, e := range events {
+		audit.Events = append(audit.Events, applyInboundEvent(name, e))
+	}
+	// Errors acting on single incidents, such as acknowledging an
+	// acknowledged incident, are only recorded, so that the tool does not
+	// retry the request.
+	return audit, nil
+}
+
+// applyInboundEvent links the ids of an event and takes its action.
+func applyInboundEvent(provider string, e *inbound.Event) *models.InboundEvent {
+	ie := &models.InboundEvent{
+		ExternalId: e.ExternalId,
+		IncidentId: e.IncidentId,
+		Action:     e.Action,
+		User:       e.User,
+		Message:    e.Message,
+	}
+	data := schedule.DataAccess.Inbound()
+	var err error
+	if ie.IncidentId == 0 {
+		ie.IncidentId, err = data.GetExternalIncident(provider, e.ExternalId)
+	} else if e.ExternalId != "" {
+		err = data.SetExternalIncident(provider, e.ExternalId, e.IncidentId)
+	}
+	switch {
+	case err != nil:
+	case ie.IncidentId == 0:
+		err = fmt.Errorf("no incident linked with %s %s", provider, e.ExternalId)
+	case e.Action != models.ActionNone:
+		if ie.User == "" {
+			ie.User = provider
+		}
+		message := ie.Message
+		if message == "" {
+			message = "via " + provider
+		}
+		_, err = schedule.ActionByIncidentId(ie.User, message, e.Action, nil, ie.IncidentId)
+	}
+	if err != nil {
+		ie.Error = err.Error()
+	}
+	return ie
+}
+
+// InboundAudit returns the most recent inbound webhook requests, optionally
+// only of a provider.
+func InboundAudit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	limit := 100
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit < 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+	}
+	return schedule.DataAccess.Inbound().GetInboundAudit(r.FormValue("provider"), limit)
+}
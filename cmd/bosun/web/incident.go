@@ -3,8 +3,13 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/leapar/bosun/cmd/bosun/database"
 	"github.com/leapar/bosun/cmd/bosun/sched"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
 
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/kylebrandt/boolq"
@@ -42,3 +47,131 @@ func ListOpenIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return summaries, nil
 }
+
+// IncidentSearchResult is a page of the incidents matching a search.
+type IncidentSearchResult struct {
+	Total     int
+	Offset    int
+	Limit     int
+	Incidents []*sched.IncidentSummaryView
+}
+
+const (
+	defaultIncidentSearchLimit = 100
+	maxIncidentSearchLimit     = 1000
+	// defaultIncidentSearchStart is how long before now start is by default.
+	defaultIncidentSearchStart = "30d"
+)
+
+// SearchIncidents searches open and closed incidents. The alert, tags,
+// start, end, worstStatus, ackUser, minDuration and maxDuration parameters
+// use the secondary indexes of the database, while filter is applied to the
+// summary of each remaining incident as with ListOpenIncidents. Without a
+// filter, only the incidents of the requested page are read. Start defaults
+// to 30 days ago.
+func SearchIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	q := &database.IncidentQuery{
+		Alert:   r.FormValue("alert"),
+		AckUser: r.FormValue("ackUser"),
+	}
+	var err error
+	if s := r.FormValue("tags"); s != "" {
+		if q.Tags, err = opentsdb.ParseTags(s); err != nil {
+			return nil, fmt.Errorf("bad tags: %v", err)
+		}
+	}
+	now := time.Now().UTC()
+	start := r.FormValue("start")
+	if start == "" {
+		start = defaultIncidentSearchStart
+	}
+	if q.Start, err = parseSearchTime(start, now); err != nil {
+		return nil, fmt.Errorf("bad start: %v", err)
+	}
+	if q.End, err = parseSearchTime(r.FormValue("end"), now); err != nil {
+		return nil, fmt.Errorf("bad end: %v", err)
+	}
+	if s := r.FormValue("worstStatus"); s != "" {
+		if err := q.WorstStatus.UnmarshalJSON([]byte(strconv.Quote(s))); err != nil || q.WorstStatus == models.StNone {
+			return nil, fmt.Errorf("bad worstStatus: %s", s)
+		}
+	}
+	for _, d := range []struct {
+		name string
+		v    *time.Duration
+	}{
+		{"minDuration", &q.MinDuration},
+		{"maxDuration", &q.MaxDuration},
+	} {
+		if s := r.FormValue(d.name); s != "" {
+			td, err := opentsdb.ParseDuration(s)
+			if err != nil {
+				return nil, fmt.Errorf("bad %s: %v", d.name, err)
+			}
+			*d.v = time.Duration(td)
+		}
+	}
+	offset, limit := 0, defaultIncidentSearchLimit
+	if s := r.FormValue("offset"); s != "" {
+		if offset, err = strconv.Atoi(s); err != nil || offset < 0 {
+			return nil, fmt.Errorf("bad offset: %s", s)
+		}
+	}
+	if s := r.FormValue("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+		if limit > maxIncidentSearchLimit {
+			limit = maxIncidentSearchLimit
+		}
+	}
+	suppressor := schedule.Silenced()
+	if suppressor == nil {
+		return nil, fmt.Errorf("failed to get silences")
+	}
+	if filter := r.FormValue("filter"); filter != "" {
+		parsedExpr, err := boolq.Parse(filter)
+		if err != nil {
+			return nil, fmt.Errorf("bad filter: %v", err)
+		}
+		q.Match = func(s *models.IncidentState) (bool, error) {
+			is := sched.MakeHistoricIncidentSummary(schedule.RuleConf, suppressor, s)
+			return boolq.AskParsedExpr(parsedExpr, is)
+		}
+	}
+	incidents, total, err := schedule.DataAccess.State().SearchIncidents(q, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	result := &IncidentSearchResult{
+		Total:     total,
+		Offset:    offset,
+		Limit:     limit,
+		Incidents: make([]*sched.IncidentSummaryView, len(incidents)),
+	}
+	for i, s := range incidents {
+		result.Incidents[i] = sched.MakeHistoricIncidentSummary(schedule.RuleConf, suppressor, s)
+	}
+	return result, nil
+}
+
+// parseSearchTime parses a unix timestamp, a duration before now such as
+// "1w", or a time in one of the silence layouts. The empty string is the
+// zero time.
+func parseSearchTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(ts, 0).UTC(), nil
+	}
+	if d, err := opentsdb.ParseDuration(s); err == nil {
+		return now.Add(-time.Duration(d)), nil
+	}
+	for _, layout := range silenceLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time format: %s", s)
+}
@@ -81,6 +81,9 @@ func buildAuth(cfg *conf.AuthConf) (easyauth.AuthManager, *token.TokenProvider,
 	} else {
 		authEnabled = true
 	}
+	if err := initScopes(cfg.Scopes); err != nil {
+		return nil, nil, err
+	}
 	if cfg.LDAP.LdapAddr != "" {
 		l, err := buildLDAPConfig(cfg.LDAP)
 		if err != nil {
@@ -0,0 +1,153 @@
+package web
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/sched"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+// reportKinds are the reports served at /api/reports/{kind}. Each is the
+// alert or notification statistics, filtered and in a default order.
+var reportKinds = map[string]struct {
+	notifications bool
+	sort          string
+	keep          func(*sched.ReportStats) bool
+}{
+	"alerts":        {sort: "escalations"},
+	"notifications": {notifications: true, sort: "escalations"},
+	"flapping":      {sort: "flapping", keep: func(s *sched.ReportStats) bool { return s.Flaps > 0 }},
+	"unacted":       {sort: "neverActedOn", keep: func(s *sched.ReportStats) bool { return s.NeverActedOn > 0 }},
+}
+
+// Reports serves statistics about the incidents that started between start
+// (default one week ago) and end (default now), as JSON or, with
+// format=csv, as CSV.
+func Reports(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	kind := mux.Vars(r)["kind"]
+	rk, ok := reportKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown report: %s", kind)
+	}
+	now := time.Now().UTC()
+	start, err := parseSearchTime(r.FormValue("start"), now)
+	if err != nil {
+		return nil, fmt.Errorf("bad start: %v", err)
+	}
+	if start.IsZero() {
+		start = now.Add(-7 * 24 * time.Hour)
+	}
+	end, err := parseSearchTime(r.FormValue("end"), now)
+	if err != nil {
+		return nil, fmt.Errorf("bad end: %v", err)
+	}
+	if end.IsZero() {
+		end = now
+	}
+	report, err := schedule.Report(start, end)
+	if err != nil {
+		return nil, err
+	}
+	stats := report.Alerts
+	if rk.notifications {
+		stats = report.Notifications
+	}
+	if rk.keep != nil {
+		kept := []*sched.ReportStats{}
+		for _, s := range stats {
+			if rk.keep(s) {
+				kept = append(kept, s)
+			}
+		}
+		stats = kept
+	}
+	by := rk.sort
+	if s := r.FormValue("sort"); s != "" {
+		by = s
+	}
+	if err := sched.SortReportStats(stats, by); err != nil {
+		return nil, err
+	}
+	if s := r.FormValue("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+		if limit < len(stats) {
+			stats = stats[:limit]
+		}
+	}
+	if r.FormValue("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.csv", kind, end.Format("2006-01-02")))
+		return nil, writeReportCSV(w, stats)
+	}
+	return stats, nil
+}
+
+func writeReportCSV(w http.ResponseWriter, stats []*sched.ReportStats) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"Name", "Incidents", "Escalations", "Flaps", "FlapScore",
+		"Acknowledged", "Closed", "NeverActedOn",
+		"TimeToAckMean", "TimeToAckP50", "TimeToAckP90", "TimeToAckP99",
+		"TimeToCloseMean", "TimeToCloseP50", "TimeToCloseP90", "TimeToCloseP99",
+	})
+	i := strconv.Itoa
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	for _, s := range stats {
+		cw.Write([]string{
+			s.Name, i(s.Incidents), i(s.Escalations), i(s.Flaps), f(s.FlapScore),
+			i(s.Acknowledged), i(s.Closed), i(s.NeverActedOn),
+			f(s.TimeToAck.Mean), f(s.TimeToAck.P50), f(s.TimeToAck.P90), f(s.TimeToAck.P99),
+			f(s.TimeToClose.Mean), f(s.TimeToClose.P50), f(s.TimeToClose.P90), f(s.TimeToClose.P99),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReportDigest sends the weekly report digest now.
+func ReportDigest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if err := schedule.SendReportDigest(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return "digest sent", nil
+}
+
+// RunReport renders the report section named by the name variable as of the
+// date and time parameters. A GET previews it, as JSON or, with format=html,
+// as the HTML body. A POST also sends it to its notifications.
+func RunReport(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["name"]
+	report := schedule.RuleConf.GetReport(name)
+	if report == nil {
+		return nil, fmt.Errorf("no report named %s", name)
+	}
+	now, err := getTime(r)
+	if err != nil {
+		return nil, err
+	}
+	var run *sched.ReportRun
+	if r.Method == http.MethodPost {
+		auditTarget(w, "%s", name)
+		run, err = schedule.SendReport(report, now)
+	} else {
+		run, err = schedule.RenderReport(report, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if r.FormValue("format") == "html" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, err := fmt.Fprint(w, run.Body)
+		return nil, err
+	}
+	return run, nil
+}
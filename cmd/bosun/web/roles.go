@@ -3,10 +3,14 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/captncraig/easyauth"
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/util"
 )
 
 const (
@@ -65,6 +69,7 @@ func parseRole(s string) (easyauth.Role, error) {
 	parts := strings.Split(s, ",")
 	perms := fullyOpen
 	for _, part := range parts {
+		part = strings.Replace(part, " ", "", -1)
 		this := fullyOpen
 		for _, perm := range roleDefs.Permissions {
 			pname := strings.Replace(strings.ToLower(perm.Name), " ", "", -1)
@@ -91,3 +96,211 @@ func parseRole(s string) (easyauth.Role, error) {
 func getRoleDefinitions(_ miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	return roleDefs, nil
 }
+
+// scopable are the permissions that can be limited to the alerts of a scope.
+const scopable = canViewDash | canPerformActions | canSilence | canSaveConfig
+
+// scopeBits are the role bits of scopes 1 to 15. The top bit is left
+// unused so the UI can do bit math on roles without going negative.
+const scopeBits easyauth.Role = 0x7FFF0000
+
+// A scope grants permissions on the alerts it matches. Each scope has a role
+// bit above the permission bits, which users and tokens are given like any
+// other permission.
+type scope struct {
+	Name   string
+	Bit    easyauth.Role
+	Role   easyauth.Role
+	Alerts []string
+	Tags   opentsdb.TagSet
+}
+
+var scopes []*scope
+
+// initScopes sets up the scopes of the auth config and adds them to the
+// permissions as "Scope: name".
+func initScopes(cfg map[string]conf.AuthScope) error {
+	scopes = nil
+	perms := roleDefs.Permissions[:0]
+	for _, p := range roleDefs.Permissions {
+		if p.Bits&scopeBits == 0 {
+			perms = append(perms, p)
+		}
+	}
+	roleDefs.Permissions = perms
+	seen := make(map[int]string)
+	for name, sc := range cfg {
+		if sc.Id < 1 || sc.Id > 15 {
+			return fmt.Errorf("scope %s: Id must be from 1 to 15", name)
+		}
+		if other, ok := seen[sc.Id]; ok {
+			return fmt.Errorf("scopes %s and %s have the same Id", name, other)
+		}
+		seen[sc.Id] = name
+		role, err := parseRole(sc.Role)
+		if err != nil {
+			return fmt.Errorf("scope %s: %v", name, err)
+		}
+		if role&^scopable != 0 {
+			return fmt.Errorf("scope %s: only Actions, Silence, Save Config and View Dashboard can be scoped", name)
+		}
+		s := &scope{
+			Name:   name,
+			Bit:    easyauth.Role(1) << uint(15+sc.Id),
+			Role:   role,
+			Alerts: sc.Alerts,
+		}
+		if len(sc.Alerts) == 0 && sc.Tags == "" {
+			return fmt.Errorf("scope %s: needs Alerts or Tags", name)
+		}
+		if sc.Tags != "" {
+			// Tag values are globs, but ParseTags only accepts * as a
+			// whole value.
+			if _, err := opentsdb.ParseTags(strings.Replace(sc.Tags, "*", "x", -1)); err != nil {
+				return fmt.Errorf("scope %s: %v", name, err)
+			}
+			s.Tags, _ = opentsdb.ParseTags(sc.Tags)
+		}
+		scopes = append(scopes, s)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].Bit < scopes[j].Bit })
+	for _, s := range scopes {
+		roleDefs.Permissions = append(roleDefs.Permissions, bitDesc{s.Bit, "Scope: " + s.Name, fmt.Sprintf("Scoped permissions on alerts matching %s %s", strings.Join(s.Alerts, ","), s.Tags)})
+	}
+	return nil
+}
+
+// matches returns true if the alert with tags is in the scope.
+func (s *scope) matches(alert string, tags opentsdb.TagSet) bool {
+	if len(s.Alerts) > 0 && !s.matchesName(alert) {
+		return false
+	}
+	for k, pattern := range s.Tags {
+		v, ok := tags[k]
+		if !ok {
+			return false
+		}
+		if m, _ := util.Match(pattern, v); !m {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *scope) matchesName(alert string) bool {
+	for _, pattern := range s.Alerts {
+		if m, _ := util.Match(pattern, alert); m {
+			return true
+		}
+	}
+	return false
+}
+
+// scoped returns perm with the bits of the scopes granting it, for routes
+// that check scopes themselves.
+func scoped(perm easyauth.Role) easyauth.Role {
+	for _, s := range scopes {
+		if s.Role&perm != 0 {
+			perm |= s.Bit
+		}
+	}
+	return perm
+}
+
+// userCan returns true if the user of r has perm on the alert with tags,
+// either everywhere or through a scope.
+func userCan(r *http.Request, perm easyauth.Role, alert string, tags opentsdb.TagSet) bool {
+	u := easyauth.GetUser(r)
+	if u == nil {
+		return false
+	}
+	if u.Access&perm != 0 {
+		return true
+	}
+	for _, s := range scopes {
+		if u.Access&s.Bit != 0 && s.Role&perm != 0 && s.matches(alert, tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// userHas returns true if the user of r has perm everywhere.
+func userHas(r *http.Request, perm easyauth.Role) bool {
+	u := easyauth.GetUser(r)
+	return u != nil && u.Access&perm != 0
+}
+
+// userCanSilence returns true if the user of r may add a silence of alert
+// and tags. Without the Silence permission everywhere, the silence must not
+// match any alert outside the user's scopes.
+func userCanSilence(r *http.Request, alert string, tags opentsdb.TagSet) bool {
+	if userHas(r, canSilence) {
+		return true
+	}
+	u := easyauth.GetUser(r)
+	if u == nil {
+		return false
+	}
+	for _, s := range scopes {
+		if u.Access&s.Bit == 0 || s.Role&canSilence == 0 {
+			continue
+		}
+		if s.within(alert, tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// within returns true if every alert a silence of alert and tags matches is
+// in the scope.
+func (s *scope) within(alert string, tags opentsdb.TagSet) bool {
+	if len(s.Alerts) > 0 && (alert == "" || !s.matchesName(alert)) {
+		return false
+	}
+	for k, pattern := range s.Tags {
+		v, ok := tags[k]
+		if !ok {
+			return false
+		}
+		for _, alt := range strings.Split(v, "|") {
+			if m, _ := util.Match(pattern, alt); !m {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// userCanEdit returns true if the user of r may change the definition of
+// alert. Without the Save Config permission everywhere, alert must match
+// the alert patterns of one of the user's scopes.
+func userCanEdit(r *http.Request, alert string) bool {
+	if userHas(r, canSaveConfig) {
+		return true
+	}
+	u := easyauth.GetUser(r)
+	if u == nil {
+		return false
+	}
+	for _, s := range scopes {
+		if u.Access&s.Bit != 0 && s.Role&canSaveConfig != 0 && len(s.Alerts) > 0 && s.matchesName(alert) {
+			return true
+		}
+	}
+	return false
+}
+
+// userScopes returns the permissions the user of r has through scopes.
+func userScopes(r *http.Request) easyauth.Role {
+	var role easyauth.Role
+	if u := easyauth.GetUser(r); u != nil {
+		for _, s := range scopes {
+			if u.Access&s.Bit != 0 {
+				role |= s.Role
+			}
+		}
+	}
+	return role
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/captncraig/easyauth"
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/opentsdb"
 )
 
 func TestWriterRole(t *testing.T) {
@@ -43,3 +45,64 @@ func TestRoleParse(t *testing.T) {
 		}
 	}
 }
+
+func TestScopes(t *testing.T) {
+	err := initScopes(map[string]conf.AuthScope{
+		"db":  {Id: 1, Role: "Actions,Silence", Alerts: []string{"db.*"}, Tags: "service=db*"},
+		"web": {Id: 2, Role: "Save Config", Alerts: []string{"web.*|http.*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, web := easyauth.Role(1<<16), easyauth.Role(1<<17)
+	found, err := parseRole("Reader, Scope: db")
+	if err != nil || found != roleReader|db {
+		t.Errorf("bad scope role: %d %v", found, err)
+	}
+	if scoped(canSilence) != canSilence|db || scoped(canSaveConfig) != canSaveConfig|web || scoped(canViewDash) != canViewDash {
+		t.Error("bad scoped permissions")
+	}
+	s := scopes[0]
+	tests := []struct {
+		alert   string
+		tags    string
+		matches bool
+		within  bool
+	}{
+		{"db.cpu", "service=db1,host=a", true, true},
+		{"db.cpu", "service=web1", false, false},
+		{"web.cpu", "service=db1", false, false},
+		{"db.cpu", "service=db*", true, true},
+		{"db.cpu", "service=db*|web*", true, false},
+		{"db.cpu", "host=a", false, false},
+		{"", "service=db1", false, false},
+	}
+	for _, test := range tests {
+		tags, _ := opentsdb.ParseTags(test.tags)
+		if m := s.matches(test.alert, tags); m != test.matches {
+			t.Errorf("%s %s: expected matches %v", test.alert, test.tags, test.matches)
+		}
+		if w := s.within(test.alert, tags); w != test.within {
+			t.Errorf("%s %s: expected within %v", test.alert, test.tags, test.within)
+		}
+	}
+	if !scopes[1].matchesName("http.latency") || scopes[1].matchesName("db.cpu") {
+		t.Error("bad alert patterns")
+	}
+	for _, bad := range []map[string]conf.AuthScope{
+		{"a": {Id: 0, Role: "Actions", Alerts: []string{"*"}}},
+		{"a": {Id: 1, Role: "Actions", Alerts: []string{"*"}}, "b": {Id: 1, Role: "Silence", Alerts: []string{"*"}}},
+		{"a": {Id: 1, Role: "Manage Tokens", Alerts: []string{"*"}}},
+		{"a": {Id: 1, Role: "Actions"}},
+		{"a": {Id: 1, Role: "Actions", Tags: "service=db*,host"}},
+		{"a": {Id: 1, Role: "Actions", Tags: "service=db*,host=a b"}},
+		{"a": {Id: 1, Role: "Actions", Tags: "service=db*,service=web*"}},
+	} {
+		if err := initScopes(bad); err == nil {
+			t.Errorf("expected error for %v", bad)
+		}
+	}
+	if err := initScopes(nil); err != nil || len(roleDefs.Permissions) != 11 {
+		t.Errorf("scopes not cleared: %v %d", err, len(roleDefs.Permissions))
+	}
+}
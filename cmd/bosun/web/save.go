@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
 	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
 )
 
 func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	data := struct {
+		File    string
 		Config  string
 		Diff    string
 		User    string
@@ -27,7 +31,27 @@ func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if data.User == "" {
 		data.User = getUsername(r)
 	}
-	err := schedule.RuleConf.SaveRawText(data.Config, data.Diff, data.User, data.Message, data.Other...)
+	auditTarget(w, "%s by %s: %s", data.File, data.User, data.Message)
+	if !userHas(r, canSaveConfig) {
+		alerts, other, err := schedule.RuleConf.ChangedAlerts(data.File, data.Config)
+		if err != nil {
+			return nil, err
+		}
+		if other {
+			http.Error(w, "Not authorized to change anything but alerts in your scopes", http.StatusForbidden)
+			return nil, nil
+		}
+		for _, name := range alerts {
+			if !userCanEdit(r, name) {
+				http.Error(w, fmt.Sprintf("Not authorized to change alert %s", name), http.StatusForbidden)
+				return nil, nil
+			}
+		}
+	}
+	if RuleRepo != nil && RuleRepo.Review {
+		return proposeConfig(w, data.File, data.Config, data.Diff, data.User, data.Message)
+	}
+	err := schedule.RuleConf.SaveRawText(data.File, data.Config, data.Diff, data.User, data.Message, data.Other...)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +61,7 @@ func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 
 func DiffConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	data := struct {
+		File    string
 		Config  string
 		Message string
 		User    string
@@ -52,7 +77,7 @@ func DiffConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if err := decoder.Decode(&data); err != nil {
 		return nil, err
 	}
-	diff, err := schedule.RuleConf.RawDiff(data.Config)
+	diff, err := schedule.RuleConf.RawDiff(data.File, data.Config)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +100,33 @@ func BulkEdit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (int
 	if err := decoder.Decode(&bulkEdit); err != nil {
 		return nil, err
 	}
+	auditTarget(w, "%d edits", len(bulkEdit))
+	if !userHas(r, canSaveConfig) {
+		for _, edit := range bulkEdit {
+			if edit.Type != "alert" || !userCanEdit(r, edit.Name) {
+				http.Error(w, fmt.Sprintf("Not authorized to change %s %s", edit.Type, edit.Name), http.StatusForbidden)
+				return nil, nil
+			}
+		}
+		// The text of an edit may rename the alert or add other sections.
+		alerts, other, err := schedule.RuleConf.BulkEditChanges(bulkEdit)
+		if err != nil {
+			return nil, err
+		}
+		if other {
+			http.Error(w, "Not authorized to change anything but alerts in your scopes", http.StatusForbidden)
+			return nil, nil
+		}
+		for _, name := range alerts {
+			if !userCanEdit(r, name) {
+				http.Error(w, fmt.Sprintf("Not authorized to change alert %s", name), http.StatusForbidden)
+				return nil, nil
+			}
+		}
+	}
+	if RuleRepo != nil && RuleRepo.Review {
+		return nil, fmt.Errorf("bulk edits are disabled because changes must be reviewed; save the config to propose them")
+	}
 	err := schedule.RuleConf.BulkEdit(bulkEdit)
 	if err != nil {
 		return nil, err
@@ -86,3 +138,127 @@ func BulkEdit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (int
 func SaveEnabled(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	return schedule.SystemConf.SaveEnabled(), nil
 }
+
+var errNoRuleRepo = fmt.Errorf("the rule file is not stored in git; enable GitConf")
+
+// proposeConfig stores a change to a rule file to be approved by another
+// user, for when GitConf requires review.
+func proposeConfig(w http.ResponseWriter, file, rawConf, diff, user, message string) (interface{}, error) {
+	if err := schedule.RuleConf.CheckRawText(file, rawConf, diff); err != nil {
+		return nil, err
+	}
+	if file == "" {
+		file = schedule.RuleConf.GetFiles()[0]
+	}
+	p, err := RuleRepo.Propose(file, rawConf, user, message)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(w, "Proposed change %s; it must be approved by another user", p.Id)
+	return nil, nil
+}
+
+// ConfigHistory returns the commits of the rule file, newest first.
+func ConfigHistory(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if RuleRepo == nil {
+		return nil, errNoRuleRepo
+	}
+	limit := 100
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+	}
+	return RuleRepo.History(limit)
+}
+
+// RevertConfig saves a rule file, the first if file is not set, as of a
+// commit, or proposes it if changes must be reviewed.
+func RevertConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if RuleRepo == nil {
+		return nil, errNoRuleRepo
+	}
+	rev := mux.Vars(r)["rev"]
+	file := r.FormValue("file")
+	if file == "" {
+		file = schedule.RuleConf.GetFiles()[0]
+	}
+	rawConf, err := RuleRepo.Show(rev, file)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := schedule.RuleConf.RawDiff(file, rawConf)
+	if err != nil {
+		return nil, err
+	}
+	user := getUsername(r)
+	message := fmt.Sprintf("Revert %s to %s", file, rev)
+	auditTarget(w, "%s to %s by %s", file, rev, user)
+	if RuleRepo.Review {
+		return proposeConfig(w, file, rawConf, diff, user, message)
+	}
+	if err := schedule.RuleConf.SaveRawText(file, rawConf, diff, user, message); err != nil {
+		return nil, err
+	}
+	fmt.Fprint(w, "revert successful")
+	return nil, nil
+}
+
+// ConfigProposals returns the changes to the rule file waiting for
+// approval, with their diffs against the running rule file.
+func ConfigProposals(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if RuleRepo == nil {
+		return nil, errNoRuleRepo
+	}
+	ps, err := RuleRepo.Proposals()
+	if err != nil {
+		return nil, err
+	}
+	type proposal struct {
+		*rule.Proposal
+		Diff string
+	}
+	proposals := make([]proposal, len(ps))
+	for i, p := range ps {
+		text, err := RuleRepo.ProposalText(p)
+		if err != nil {
+			return nil, err
+		}
+		file, err := RuleRepo.ProposalFile(schedule.RuleConf, p)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := schedule.RuleConf.RawDiff(file, text)
+		if err != nil {
+			return nil, err
+		}
+		proposals[i] = proposal{p, diff}
+	}
+	return proposals, nil
+}
+
+// ApproveConfig saves a proposed change to the rule file.
+func ApproveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if RuleRepo == nil {
+		return nil, errNoRuleRepo
+	}
+	id := mux.Vars(r)["id"]
+	user := getUsername(r)
+	auditTarget(w, "%s by %s", id, user)
+	if err := RuleRepo.Approve(schedule.RuleConf, id, user); err != nil {
+		return nil, err
+	}
+	fmt.Fprint(w, "approve successful")
+	return nil, nil
+}
+
+// RejectConfig removes a proposed change to the rule file.
+func RejectConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if RuleRepo == nil {
+		return nil, errNoRuleRepo
+	}
+	id := mux.Vars(r)["id"]
+	auditTarget(w, "%s by %s", id, getUsername(r))
+	return nil, RuleRepo.Reject(id)
+}
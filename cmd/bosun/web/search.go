@@ -1,6 +1,7 @@
 package web
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/gorilla/mux"
+	"github.com/leapar/bosun/cmd/bosun/database"
 	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
 )
 
 // UniqueMetrics returns a sorted list of available metrics.
@@ -164,3 +167,67 @@ func TagValuesByTagKey(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return schedule.Search.TagValuesByTagKey(tagk, uid, since)
 }
+
+// SearchPruneStatus returns the progress of the latest pruning of the search
+// index.
+func SearchPruneStatus(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Search.PruneStatus(), nil
+}
+
+// SearchPrune starts pruning the search index of entries not seen within the
+// retention parameter, or the SearchPruneConf retention if it is not set.
+func SearchPrune(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	retention, _, rate := schedule.SystemConf.GetSearchPruneConf()
+	if v := r.FormValue("retention"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		retention = time.Duration(d)
+	}
+	if retention <= 0 {
+		return nil, fmt.Errorf("no retention given or configured")
+	}
+	if schedule.Search.PruneStatus().Running {
+		return nil, fmt.Errorf("search index is already being pruned")
+	}
+	uid := r.FormValue("uid")
+	if uid == "" {
+		uid = schedule.SystemConf.GetUid()
+	}
+	before := time.Now().UTC().Add(-retention)
+	auditTarget(w, "%s before %s", uid, before.Format(time.RFC3339))
+	go func() {
+		if err := schedule.Search.Prune(uid, before, rate); err != nil {
+			slog.Errorf("failed to prune search index: %v", err)
+		}
+	}()
+	return fmt.Sprintf("pruning entries not seen since %s", before.Format(time.RFC3339)), nil
+}
+
+// SearchExport returns the search index, which can be imported into another
+// instance with SearchImport.
+func SearchExport(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	uid := r.FormValue("uid")
+	if uid == "" {
+		uid = schedule.SystemConf.GetUid()
+	}
+	return schedule.DataAccess.Search().ExportSearch(uid)
+}
+
+// SearchImport adds the entries of an exported search index to the index.
+func SearchImport(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	uid := r.FormValue("uid")
+	if uid == "" {
+		uid = schedule.SystemConf.GetUid()
+	}
+	var idx database.SearchIndex
+	if err := json.NewDecoder(r.Body).Decode(&idx); err != nil {
+		return nil, err
+	}
+	auditTarget(w, "%s: %d metrics", uid, len(idx.Metrics))
+	if err := schedule.DataAccess.Search().ImportSearch(uid, &idx); err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf("imported %d metrics", len(idx.Metrics)), nil
+}
@@ -0,0 +1,32 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+// SLOs serves the status of every slo section as of the last check of its
+// alert.
+func SLOs(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.SLOStatuses()
+}
+
+// SLO serves the status of the named slo section as of the last check of
+// its alert.
+func SLO(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["name"]
+	if schedule.RuleConf.GetSLO(name) == nil {
+		return nil, fmt.Errorf("unknown slo: %s", name)
+	}
+	st, err := schedule.SLOStatus(name)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, fmt.Errorf("slo %s has not been checked yet", name)
+	}
+	return st, nil
+}
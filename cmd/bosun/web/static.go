@@ -14090,75 +14090,75 @@ pnIBAgAA//8dC7O6NwAAAA==
 
 	"/js/0-bosun.ts": {
 		local:   "web/static/js/0-bosun.ts",
-		size:    14721,
+		size:    15027,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/9w7a3fbNrLf/Stm1QepWqZkp07vylFy3Dhtcu91H3Gy53Z9fLowOZIQUwANgFKUrv/7
-PQD4AElQcrr7af3BR5o3BjODGZAaj8fwTOAcBbIYISNqORsQtshTIqIkUnIA4+cHu6iOBM8VPpJWEkYV
-/bSP/JZzJZUg2R66D/c5iu0eopwlKGTMxT6tK55gKkuSgwTjlAiENRGQPJkCYduzgwP97ZbLnJ1nGcyg
-dNWKJ3mKYVCighFcHwAABGzxVjsoGNmvhuAlZ0rwNEUhS/hqEQskEVtc6XX7oZHiPFW0wrLFVeHPEpLT
-iMRY4+OUZreciCQYHdwMzw4OKFMo5iRGePOOqhSNbYAfFbJEAltEdjffWPgfRo7ShC+mIJWgbHF28HBw
-UC4zijmb00V4HXxpGH8RfE0TFMEIgi9THhNFOWsAl0plDmCes1jTQNgUMG3bUiJG0JFraN/8bws6goYu
-S/TagQyL5XXkRUu1Sk8veYKhpdB/yMhtiskUlMhxVIEF3udU4PdE4hTmJJVoUA/a1/qDDpfNEhnMIMxL
-F45ATF3/D2H2HGpVTU9Emj3MRyCGZ1a2lWzAwTgYOZxmp6YQXBC5LLe9wuEqS4nC9yKdQpARoShJ5Tgp
-Sc2qHfq4ilFX4EslSqKHoWsHVbiSXmPeWMw+Q4yAnUYYQb0G4MdMePW/+pgJlJJytt8ILWSnDVpYvwlC
-cOF3wqsCtdcAQ7fbBE3Sa8NCkGzpNeFHi9lngRGw0wAjqNeAJZfKq/81lwr+RnGz3wYtY6cJWpZjgc3E
-lJPkZ3aFRMTLIhl9Bkqa6qLvtfGqxO2zsBCy08hCWK+nbO302vHSoHJh6tJ+a6ykncZYiX/WZyS2hnhM
-PY8fZ6MVsdNGK6o/sqhUXGz9VqQoFLwuKfYGmCXcHWOWptecLPfH+QVRBF4x9Rg7snx3nP+Sq179hDGu
-SP++OOi9e1PR7t6fiqz/GGAxTZD5PfOmQu49DArK3edBQdRrjOJ3yPz1+DyOUUp4V1B07JnCP54Z9qOU
-SvX82dj58o9+XWOmy5tH30+4AVdnj0qGmyMj6fmzcf25qbDVHnC1RLGh0m1WBCZUYKze8SnoFqHqSQy/
-2xZFpiGMMVP62MlyuQydlux+CK5QlQvmAPRfoBsglCqYOq2cLUfDFmmxf6ZQIdE9+XXwf0eXlNFM8DlN
-UQQ3MINAN1jBWYe1UG8lNNEPtTMfzqq1PrQb3recq6uYZ41+942FOJ1u3ehqkFxyoVLK7qZwy3mKhJkG
-2BF7nqvlFYo1jUsxbxhVUwhJrpavyraxYB5BLlEwskKnGeQpyim85Sle4Fxakl9QrOQUWL661a3q7Dms
-OU2sTa+J1GhqmpophFpAKc6QVpZq6h9RvbUaQoMs9Vjs+8qcuj81ZK4TfkTl0HXQ1SLDrvbaUPkDF1MI
-b6lqrstKur6x9Nq6nYTN+UPkTA8fZQtvRgxR7nNzvihpmuNCsXMjqNmmTqhUQ0KFjb7kLCzGnZdLwhZ4
-lZvEbmjDNTI1gjgXwnzIBK4pz+Ww2eeXMk3gwaykj7608i38zMdRxSXM7LHdDvxqUHWGzb6BtTGPwnUr
-cfbnjUT1P7idQnin/5dxvSZpjmZubsXvwkNuSMyI3cyu77V9Te21PVa77izWOjDXXWEa/0GaLFnXprjB
-e6s46ccii3nSle2SZIRh+jIlUu4kU3SFhCWJqfQ2qsuYF3j/+1zw1e+rKYSrhgTTa7+1NbYoSPES9dZN
-4UoRhT8KnmeylDMXKJdTCOc0VfqM9LmDMLoyRoRNuFQ801UrTV9U5apFoWMOWZezrpEVzGy+nLpqTfOA
-7YposTxDpmRy68dKsu7hu88pqhZsjcIWxko3NQW5NiVXy2mjcBdbZM7wrqKy4DhpEtWdSBiYGLVdCFwH
-X8qi9hRForr2MB/uOyUqILUdxVHtVC1ZVKQ6D0ZFB9C5IqlLmdZWX3jU8HsL/HVP0RuB45qmoxr1S/57
-66EjU8diqBuBYV38HpzPBZnNfJi1864pk84h/EtRUAsDfL1J0WCwPE1b7UVHWlNYpIcoTN7VrSzMZk4z
-G8AhrOEQAtvN7tD9R1XL9NrLZsZvh9fch46LdOhrB0lUirKFrMuc3/F1psEMSqboqgaf+dhaye2ynjdR
-XnaTxS7TrxrgJS2S2yX+mwV5yVt1BWYtOdEl+cAF/GUGE/j66w6SsgJZXOe1pDcKhmvSOxfh91iuljCD
-Tg0q/xxEpPvJsHZo3VaOao1lf+aATNfnfHdaseFBN6gKw/RpWWSUP1KKwPvvq59/imzO0fk2XI9MKI4g
-AAiGuyJSH7iPUWCP3vdv37zkq4wzZCrUrOF6uFO8ZdtXE/p1rHdKr49qrWG1Q4PuvwTMgOGmcYqHw7MO
-3X1B92uOYhuadq5FdR+tUAkawwxWTYyI7nMUFIvp7b7FWCxU7FpU3cGYSqGIymXdxmUC5/QjzGBg6I4G
-nsXKDVXxsuT1Tn5EIgxiQRWNSTqYloYV0g9hkOizQwy6k59lzdkd4xvm46Rsznv5NkQwyhY+vhLVx8q4
-WPltlfaI6+U017iftcgE5yRPlY/FYgZ9h0F3P23vBTP4o4uzrbre5129evcANQQwm80gZwnOKcPEt80J
-pqiwacj1HW5vWtYDphI9/F1GmFnjHr/8hW+J/TVgl62OcJ2nZe/9Q6O99hQJ04drCzyNeLdMmGPpPkpw
-jqJdHpoq9bBnPvScwqazD4et00rryLQO3Rdq94TBmGR0TFIUSr6wEme6TfHUQ4uFf/4TBoPhsLNhUZEK
-YZgQRfoiqGVouaii7DmDjJEy7KaHw+wMUjADTR+9oys8Z8kFUehnTSKBkqdrbLsX6nu1xqpMAocoRP9a
-tMwPGCtN5ZXahGXRnDKSptvQ6XH9xTqJMsFXVKI3EMdjuKKfEPgc1BIh5QsOlEG4oYlaAmEJLJEulmpY
-UpjRoaLTEEbWt0Q0Q/sTzODktBnvXNAFzOC7yaQJT7V8mEHwxbe35CT5a9BEJ0TcGezx/PTkr09b2JVp
-lYIvnpw+xdsOMk9NI/gJxkZ7E3u7ECQxdsI3hrSJjqmIU1P4rhtuvT4+nYzA/NOm3Yya2NOdWIMwJGbV
-XmYv+qZVO9balcmTSGKqoyb4Qu9I0Iy9iGQZsiQM5HrRQSklwsDubTAC+cmLN1Fg0bV+uV4Uas/TNAwE
-xiq67SjQqRReX9dLgWuzDyeFY25a9MiUrlf+BWgd/hXEutHQc2/Xgs4S9nhAG+elER+DkY0WP3q7Ez2n
-qR7lw8QpZ1VuXh/fnLVLRsG37WeaGKbeHcmIWkYJJSvOEv+2lGH4WZugxfp9nLRN7dQvE7M6US9BnwxJ
-kXF6jjWA49NJJwld3o3O00k/jYTDGQSQGmGbSuxmD+XRnyItPv69/7mCbPUXuwJjhf64kUrwO3Ols1lS
-hX7PW6KjMsiPy1K2J129Ep3QePJn4uMRSbpjJeUSotPdeRocTyZf+UUUDt2l5WNvUpWhtSux7JHg9Vtx
-WnyOv/zSCo/1G3o9uXFs9TLvKB3H+5hFMIKnJ1EVSJ9f0k56S9qfCekTT0jriqAEYZIqytlF8WaD7i1O
-W71F0cS+5DnTncCke+lnCXR37SldLvvh4VlnjmmKn8Gxd4rh53Ur7SK8Y0d1y+mw+a4gXdX9l4C77vx0
-97DM5/MUq+htTQuPiH5oZ0AVFiOgnvCgndgwEurdDH3yix0Ou5vuof5XMgj+1SyCz8uSlsNR6eGD5yqs
-dn/kiXT3Uqkzkiuup7OQpGn5MK9nCCdp6guc/pSBetbuBP9zmOwTdnT0iPA3jcKSC/uERY+ZYfBF9Ugo
-GOrep7Nk+ySpJ4fbc2pBHQwfP2zq1ZrRkHqvKaB8ahUV9xpQEPdMnv7nrkq0ryUq2XVY+BbYNUOiujJ5
-Szl7S9gCQzOOGJuiFNlCLXum4nZEQqdqPHRvNO0jYvOMWMnkVg/OP3CxImao++23334bX16OLy6OXr+e
-rlZTKYPGA+FLvkKm9NRO42JlxSWVFdJ4VXhliKMGgfZdQ+1ZRZfymKQYBli/1SQwJYquUXt06nhynqtc
-4BQGlMFXclBPXhmRagqDr+QRWXAHLjUwcSlXBrJyIV3Q0kCWLqQLSgwkcSFd0KWBMBfSBW0NZOtCSpDd
-u9GBeb24OnZEnuJ7kYbkbgRzwVfaT2XQmyk+Q/a9ILEOWXIXUZbgx5/n4eCPwfCsIopTLtFH9eBSmRuj
-n8gKLZHMb6USOlQrHQ5x+WKTS0vZIqztOYTjkaPZ4c2FroU2/fX6XgRwWPkjMGb0XVhVNg5dlq+1Z3rv
-uAqvRXMTjeGwyVou5PeF4HnWJ6SkGpYP482xkYtUZ0G1Wfb9pf+k7RqPwTxvHo/NQ/LiZdQXdo+WJBP8
-4zaSKNYoooRvmNKOZluzIboCzE4mx0+PJt8dHU++Lv0xOzn+6sn55EknHgrh/5ZoMMofGREDXRSPLi+P
-Li4Gw64oY/NjRZmiOhh64+RgPIYfBF+VLt1sNtF9TsWdXPEEIy4W4w9yHHN+R9G+Jl7/UKWKMZQxyTC0
-+1fdPddVucuRs708dQQLNP2BNiG0jxzNIarPq610Ixk/ZlRgMVnbE3nbeDhkLwtVeQ980eq4NSoqjtLQ
-fFkUX4ZwaKXBN3DyLXwDTyflv+PJZOI+ISyMgBkMzsovswEcWumKv3/38soGevn7ivazCUdC8RDGnq8J
-j3NzZtntgFnpeGbDDQZGTwE0PtLQUtyhNsj88mg8OGvUCIEkcfzrulR/f/WrV5VTHIhuaJrWRTJLqQqD
-s/Lh7JwLCDU1NS0jUHgGMSl6jTOgh4ftnYphBjG5pk47t1nSFCGMo3hJxLkKJ0PziCiAYGjJnTqia0e3
-ldFhEVflyy7PCpkMq9woo9MVZ0kLgY7oobtFjZckXBejIBI9PvYE92AwgqPjYZN/gernDUNRTXrlc9Z6
-6wL7xvsR13RBi1+W/L3Km+wjsMaY6O6YcrXkm/rlbLnTqJrsSC75xmNYW9oWZY+FbVkj2OpxsjbS1DNd
-AKdlQZOKxHd8jWKe8k0U89WYjI9PT55+993pt+P/evrtyZOnzuud9uFTGAhco5DNFzpbi6wR5pdEbvAW
-07d98ZHKcyHItqBqDweFsOsb37xWDsTml0oypTGGw6gwraoeZ7Y7MycqXbXaanuCvCvb6r8HZ/8fAAD/
-/2Ti/1qBOQAA
+H4sIAAAAAAAC/9xb3XfbNrJ/918xq36QqmVKdur0rhQmx03SJvde9yNO99yuj08XJkcSYgqQAVCyk/X/
+fg8AfoAiSDndfdo85NgzvxkMBoPBDECPx2N4JnCOAlmCsCZqGQ8IW+QZEVEaKTmA8fODPtSR4LnCR2Il
+YVTRj/vg15wrqQRZ78F9uM1R3O8B5SxFIRMu9o264ilmsoQcpJhkRCBsiID0yRQIu58dHOjfrrnM2dl6
+DTGUrlrxNM8wDEpWMILLAwCAgC3eaQcFI/urAbzkTAmeZShkSV8tEoEkYosLPW8/NVKcZ4pWXLa4KPxZ
+UnIakQRrfpLR9TUnIg1GB1fD2cEBZQrFnCQIb99TlaGxDfBOIUslsEVkV/OtpX8yepQGvpiCVIKyxezg
+4eCgnGaUcDani/Ay+NII/iL4hqYoghEEX2Y8IYpy1iAulVo7hHnOEo2BsKlgumtLyRhBS6/Bvv3fHeoI
+GmNZ0BuHMiym19IXLdUqOz3nKYYWof8hI9cZplNQIsdRRRZ4m1OB3xOJU5iTTKJhPWhfA4AJnu0SGcQQ
+5qULRyCmrv+HED+HeqimJyItHuYjEMOZ1W01G3IwDkaOpFmpKQSviFyWy17xcLXOiMLfRDaFYE2EoiST
+47SEmlk7+KSKUVfhSyVK0MPQtYMqXEmvMW8tZ58hRkGvEUZRpwF4txbe8V/frQVKSTnbb4RW0muDVtZt
+ghBc+J3wumDtNcDg+k3QkE4bqvWU/ZEhPyM05ONio3ttUlwjS5ElFDvMagD2Guag+01zgJ3GLQRZL71W
+/Wg5+8wxCnrtMIo6DVhyqbzjv+FSwd8obvfboHX0mqB1ORbofwIzTtKf2QUSkSyL9OUzUNJMH5NeGy9K
+3j4LCyW9RhbKOj1lTxuvHS8NKxcmk++3xmrqNcZq/LM+I4k1xGPqWfI4G62KXhutqu7IolJxce+3IkOh
+4E2J2BtgFtgfYxbTac46Vx1JSRF4zdRj7Fjn/XH+S646xyeMcUW618Vh712bCtu/PhWs++BkCU2R+T3z
+tmLuPT4LZP8JWoA6jVH8BpnsiNoEpYT3BaJlzxT+8cyIH2VUqufPxs4v/+gea8xw6x3vJ9yCO2bHkAy3
+R0bT82fj+ufmgDsFFVdLFFsq3fJOYEoFJuo9n4Iuqqoqzsi7hWRkSugE10of1OtcLkOniL0dgqtU5YI5
+BP0v0CUjShVMneLXpqPhDrRYP5OokOgu5jL4v6Nzyuha8DnNUARXEEOgS9Jg1hIthrcamuyH2pkPs2qu
+D7stwjvO1UXC140O4a2lOL1B3RoAAMglFyqj7GYK15xnSJhpGRy1Z7laXqDY0KRU85ZRNYWQ5Gr5uiy0
+C+ER5BIFIyt0ymeeoZzCO57hK5xLC/kFxUpOgeWraxSmpN5wmlqb3hCp2dSUgVMItYJSnYFWlgIA/Ijq
+nR0hNMxyHMv9rTKnrugNzHXCj6gcXItdTTJsj14bKn/gYgrhNVXNeVlNl1cWr63rBTY7NpEz3a6VTY9p
+ykS5zs2OrMQ0G6xi5UZQi02dUKnaqoobfclZWDSIL5eELfAiNxu7MRpukKkRJLkQ5oe1wA3luRw2O6NS
+pwk8iEt89KXVb+kzn0QVlxDbY3s38KvW3mnPu1r8RgcPlzsbZ/++kaj+B++nEN7o/8u43pAsR3PTsBO/
+Cw/cQMylRHN3fa/ta45e22NH15XFRgfmpq0MAOCDNLtkU5viBu+14qSbiyzhaVu3C1kThtnLjEjZC1N0
+hYSlqcn0NqrLmBd4+8dc8NUfqymEq4YGU2u/sznWgmWyRL10U7hQROGPgudrWeqZC5TLKYRzmikUDU2V
+OwijK2NE2KRLxdc6a2XZiypd7SB0zCFrS9Y5sqKZxZdTd1hTPOBuRrRcvkamZHrt50qy6ZC7zSmqHdoG
+hU2M1djUJOTalFwtp43Eben2DG8PVCYcZ5tEdSUSBiZGbRUCl8GXssg9RZKoLorMD7etFBWQ2o7iqHay
+liwyUr0PRkUF0LpUqlOZHq2+Iqrpt5b4656kNwLHNU1HNfKX/PfmQ0enjsVQFwLDOvk9OD8XMLvzId7d
+d02ddA7hX4qEWhjgq02KAoPlWbZTXrS0NZVFuonC9H1dykIcO8VsAIewgUMIbDXbM/anKpfpuZfFjN8O
+r7kPLRfp0NcOkqgUZQtZpzm/4+udBjGUQtFFTZ75xHY2tyt61mR5xc0udoV+1QQvtNjcLvhvluSF7+QV
+iHf0ROfkAxfwlxgm8PXXLSZlBbO4AN3R3kgYrknvXYbfY7laQgytHFT+cxiRrifD2qF1WTmqRyzrM4dk
+qj7nd6cUGx60g6owTJ+WxY7yR0oReP998fNPkd1zdH4fbkYmFEcQAATDvojUB+5jBrBH72/v3r7kqzVn
+yFSoRcPNsFe9FduXE7rH2PRqr49qiHfP6uYIuv4SEAPDbeMUD4ezFu62wP2qH4BCU87toG6jFSpBE4hh
+1eSISD8bUSy6t9vhzDdR0TepuoIxmUIRlcu6jFsLnNM7iGFgcEcDz2TllqpkWcp6Oz8iEQaJoIomJBtM
+S8MK7YcwSPXZIQazDtGc3TC+ZT5Jyua8U25LBKNs4ZMrWV2ijIuV31Zpj7hOSXPx/VmTTHFO8kz5RCxn
+0HUYtNfT1l4Qw6c2z5bqEDeK71at3j5ADQDiOAb9AjmnDFPfMqeYocKmIZc3eH+1Yz1gJtEj3xaE2Br3
++OkvfFPszgF9tjrK9T4ta+8fGuW1J0mYOhxibyHeThPmWLqNUv2Cu5semkNCDFZjxylsKvtwODtojbGG
+2NaF2j369nBNxyRDoeQLqzEO4NCXDy0X/vlPGAyGw9aCRcVWCMOUKNIVQbtBWEyqSHtOI2O0DGd9wk4j
+BTFofPServCMpa+IQr9oGgmUPNtg6NH94JmV2cAhCtE9F63zAyZKo7xam7R1NKeMZNl96NS4/mSdRmvB
+V1SiNxDHY7igHxH4HNQSIeMLDpRBuKWpWgJhKSyRLpZqWCJM61DhNIWRzTURzdD+CDGcnDbjnQu6gBi+
+m0ya9EzrhxiCL769JifpX4MmOyXixnCP56cnf326w12ZUin44snpU7xuMfNMK5YfYWxGb3KvF4Kkxk74
+xkCb7ISKJDOJ77Lh1svj08kIzH/atKtRk3vayzUMAzGz9gp72Vc7uWOjXZk+iSRmOmqCL/SKBM3Yi8ha
+v/GFgdwsWiylRBjYtQ1GID96+SYKLLseX24WxbBnWRYG+mo4um4NoLdSeHlZTwUuzTqcFI652sEjUzpf
++Segx/DPINGFhu57rxfBvins8YA2zosRd8HIRouffd/LntNMt/Jh6qSzam9eHl/N4MErd98tNDFCnSui
+P9mJUkpWnKX+ZSnD8LMWQav1+zjdNbWVv0zMQgzBOeiTIS12HBxCYAjHp5PWJnRlt3qfTroxEg5jCCAz
+yraV2u0e5NGfghY//r37XUE2OQ99gbFCf9xIJfiNudLZLqnCoAd0VAb5cZnK9mxXr0YnNJ78mfh4xCbt
+mUk5hei0f58Gx5PJV0GfQ/tGuevcVGVo9W0seyR4/WZZ8nP85ddWeOyub/c7tnqF7/vyzR5hEYzg6UlU
+BdLnp7STzpT2Z0L6xBPSOiMoQZik+j7wVfFlg64tTndqi6KIfclzpsDcvPirXIgh9KQuV/zwcNbqY5rq
+Yzj2djH8rC6l97Yd1S2nI+a7gnSH7r4E7Lvz09XDMp/PM6yitwl/TPS3dkAVFiOgnvCgM2+FXK9m6NNf
+rHDYXnQP+l/ZQf/yLvrMXbLjcFS6+eC5CqvVH3ki3RFr3bjpRkBHM8my8jGvowknWeYLnO4t4/TareB/
+DpN9yo6OHhH+plDQL0DGabrNDIMvqiehYKhrn9aU7UtSxx7e7VMLdDB8fLOpZ6sxEfVeU0D5ahUV9xpQ
+gDs6T/+7q76k9ws4YRH2tMO1GRLVhdm3lLN3+o4onIxKm6IM2UItO7riBw/94aALUX4fYZ54zRuxvh3X
+jfMP+rLLNHW///777+Pz8/GrV0dv3kxXq6mUQeNB+JyvkCndtdOkmFlxSWWVND6uXhlw1ABAvDPsrMLp
+V3r9Lo31V00CM6JfRbRHp44n57nKBU71/R98JQd157UmUk1h8JU8Igvu0KUmpi5yZSgrl9ImLQ1l6VLa
+pNRQUpfSJp0bCnMpbdK9ody7lJJk1250oFf0oDp2RJ7pR6eQ3IxA301rP5VBb7r4NbLvBUl0yJKbiLIU
+736eh4NPg+GsAiUZl+hDPbgoc2P0E1mhBcn8WiqhQ7UawwGXHza5WMoWYYXVXcTIGdmRzUUGMdjtr+f3
+IoDDyh+BMaPrwqqyceiKfK090yVSei2am2gMh03RciJ/LPQdVZeSEjUsH+PNsZGL7ODBWSz7/dJ/0nKN
+x2Dem8dj80hefIz6wq7RkqwFv7uPJIoNiijlW6bv7yJ2bxZEZ4D4ZHL89Gjy3dHx5OvSH/HJ8VdPziZP
+WvFQKP+3RIMZ/JERMdBJ8ej8/OjVq8GwrcrY/FhVJqkOht44ORiP4QfBV6VLt9utfh0VN1L/PU/ExWL8
+QX/vy2/Kr8XrP+2pYgxlQtYY2vWr7p7rrNyWyNlemTqCBZr6QJsQ2idHc4jq8+peupGMd2sqsOis7Ylc
+A6C6LFTlPfCrnYpbs6LiKA3NL4vilyEcWm3wDZx8C9/A00n53/FkMnFfCAsjIIbBrPwlHsCh1a74b+9f
+XthAL4RabxOOhuIRxp6vKU9yc2bZ5YC4dDyz4QYDM05BND7S1FLdoTbI/K3WeDBr5AiBJHX867pU//76
+V+9QTnIgEO9aF8l1RlUYzMrH2TkX+lVHADUlI1B4Bgkpao0Z0MPD3ZVKIIaEXFKnnNsuaYYQJlGyJOJM
+hZOheSIKIBhauJNHdO5olzI6LJIqfdnpWSWTYbU3yuh01VloodBRPXSXqPGRhOtiFESix8ee4B4MRnB0
+PGzKL1D9vGX6pgA+uSM5SxfYL96PuMYFO/KylO8cvCk+AmuMie6WKRdLvq0/zpa9RtWwI7nkW49hu9ru
+UXZYuKtrBPcoXSNNPtMJcFomNKlIcsM3KOYZ30YJX43J+Pj05Ol3351+O/6vp9+ePHnqfN5pH5/0RZX+
+JKP5QefOJGuG+dsrN3iL7tt++EjlmRDkvkAN/W+Cl1e+fq1gGslIZjTBcBgVplXZY2arM3Oi0tVOWW1P
+kPdlWf33YPb/AwAKRZz4szoAAA==
 `,
 	},
 
@@ -22180,480 +22180,497 @@ dIDgRCiAz+vsRwGHrwlAVWUdyR+Hw+MRCYkpbn3DsMQOIhXF8BxvVgNodfvY7PU+fbPXHylLjyozIxCx
 
 	"/js/bosun.js": {
 		local:   "web/static/js/bosun.js",
-		size:    147245,
+		size:    157504,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/+y9fXfbNrIw/vftp0C02ZCqZcpOm27XiZJfmqTb3G3abpx0bx/Hjy9EQhJjimAIULaa
-+Lv/DgYACZIASdnu3tznrM5JLJGDweBtMJg3TKdT9CgnC5KTNCQow3w1G63pmqQ8iALORmj6+IsOoP2o
-yDGPabq/oPka1wq9JllOGEk5QzhFuOArxOk5Sb/Y4By9Ed/QDPmLIg0FAuSP0ccvEEKofAIw5WPx4auY
-Bc8JC/M4A5AZGo0e1l+/pglBM3TQePyWkdwAv4L/c8KLXFX08Isrfzx++MV0uiYcR5hjhOe04AgjFqfL
-hKBcYKY5yki+jhmLqWzKdzF/RTjuaYyCKl/UCFAvKxJwkkB1bFpVxtCC5mhOWSHrFQ19Thasp2INZq9Z
-v62qRseEoDWNSMKmcRrGkZgLS4pebEjKkR/i1ONoThCB3yuSEzQnIS4YQf95jApGGOIrzMdA40uFQBbu
-JrQG68ekNfC/4qQQQxsT+bUxwi8us1y+Fd8aL4855gWTr+X3BsCbeK1wi2/NyZOSDU4KzEkkYYwHlvlU
-a4nuWdEdT9OUcqxmbldfVIA+nqAl4WZniKmG0adP6ONVg86Xgjws/nz61F4ZrwhjeEkARH+3wR1znPPn
-mEvI6pcN9kUalZD6uw3uWU6gOWoZ4voDW4m3eQKA4q+VSlrkoSJRfhVQsDz2i7gJ/QNlHGDhiw3fzxep
-Ik1++/QJ3VkSju7dE/0Pz/yxvW2YkyXNt7Jd+ocBKSdHNaZBllNO+TYjASNczLe3b56hGWpPCPEREyel
-F2iGJM/1x0HBQ38cSJbr83hNvoev446BTOmFc+jKd2o+dVG6C5l/GIFqnVV0movsNWFF0sdsJJCfO5lM
-3sVjcoPF1NkpoK0t+XDIcg/lUm9RUy4X8aV/Odt5GraxtDfbTL3bZs13zwmOkjiV7/WP1qRPQ5IkJFKz
-Xv1qQH1fJMkiLsGqn5a+k53QuxHV9hXBysnAfQVg/Zi1Ohm4Jvy1TU/5Dr6256d8+SJtlnyakJz/nWzl
-e/3LBmRAPHRsd6xzu2O27Q52HrHdpeQCPc1zvPWNtRcvkF8Cmd0B/UZz5Is+PouFGDVBZ1hVA+APxfNH
-6AwHCUmXfCV+7+01kSDFEAT9Z/jkLD592HpvEBpkBVv5gta6EEDG43q5qy/a32Rfwlj3tVhBuZs8V00O
-1bBI+IfixSN0FlZtnrvbLLrrLDw5m7varLBWjS6X/9DWHhfz9yTUU1P+aMyAnwiJnobnEkT9aO54GYjg
-6lu3yMPaIk8J+qzIc7W+pIjVeNQA/yfNWQ3YeNAA/REz/nSeir0jMUu0n3cU1JJd+6nJhGpcwtj2XrJf
-SBrF6fJZQpl797OvGnO4hy0cmEB6y7CtHDGTJUDFpu/MUJFGZBGnJBLyyh0NYbDfT58U3opXj20zWDFj
-npsMxzUZFfACJ4zYNuhap5q7Ijz4W06LrId5V4A+W7Y4t+jajRgUtlTfXWI/W9rF/ubcZcvOuXscJ+IM
-HCmM6ldTCmcv8pzmEkb9aOIpF7BAY13AencQTbPsDsYOoyEsO8ySqvdLWmeGqj9IcwqUvUYUK63vnmWx
-hy7W9GwVJ1EOjMXJiUUnK7Bhu49RYLf9J+zbfzTaihkbMy7sY8g1+aUqaM50eNB3RpdAfnuCV1zc2ZlL
-DTSsK0vw3TqSLft6UqG1diRb7rCRn6f0IiHRElZZR7NNyGE7er3Mbru67IDubb3Cfc1eqM0nOSnsXLNv
-QhmQPlu2Rd4SiSCxglPPm8c0sVc+LQ9jAs540pT4cZzE6RIYElPQtWctOSMUG2sEXFIXqD+0nBWM9lUH
-BouWEqfLIsF5jy5TQe3ntOBkICzDaczj3/vA55RyxnOc9cC9/1CQfNsDJDb5nIU076tVnpo0iJg6oBd5
-montVvfJmkZFQnxPv/Im6AT62EuXr0VPeBP5EwCe0ZTnNElIzvTz9TLMCQ7S5bFooP1pwClNeFy+TZfH
-quP0kyIOcEiq92ESZ3OK88ibfHE6fviFJi8IabqIl/6JdxfG6ZecbuKI5N4EeXcTGoIeoPZwxXlmPKiW
-Sx3BBLWKT1CtsLl8WrDBiq+TB69oRPw65yApnickOgJZalJ7lZMPRZyT7zAjR1J6qhiBsfjEwF2sYC+t
-iC8mKG8yqXqDAlFGwhnbdPUVXntTb9Lc+2OekCPkPcdspUeg9p6sswRz8jZPjpCX4ZzHOGHTSINDTzTK
-hOW0MRE/43niWZusaIs5WTMngS/l2yHEAaJewgBhL1HkMsudNIlDOAE9/TDCBLJeugTSfrKASboJU68H
-EQWw/WQJsF66ljnOVk6y/ibfDqEKEPUSBQh7iVpRxp00gW7415hcDKNL4OolS+AEqpocIKE4+jk9JjgP
-V11MQBHO5FnDSfuxfj+EcoWsl3iFtLdXJWt20vYMXitL4TAKJcZeAiXm2+hfeTh2NuFpOJx2dc7uo12i
-7J+xMeM037opg3PiDxpq0MSVwP1zV8L1kpgV7jX1HHOMXqR8KG1Z0b+mfil4L024tA24e84AGTSulVmk
-d2xL0P6tTh2w3btdCTBow9Oa8t49TwH2EghGe/f+8jQMCWPShu7aZo7Q6BGg2U9ixh8/mho/Rv11T1PB
-kh31/wQK1IqGDhJScrEPGB8/mlbf7QQ0RCrKVyS/iFlTyMtJFOck5G/oEfKm9n6syZJBnHKShyTjYqeF
-Y6IhmH5oSnbqvNM+k3pChiSMe0eGbCi5pu0Iq4YfeCrB4hhx4v3X/qs4jbOcLuKE5N4pmiFPyKpe+4Br
-kCKxtEGu6ifbh62uuKpJ83mRClFeS9MgsOeU8uOQZqQurWuYCaogaiJ5+TS4S1NfnQ+erXC6JMcFTI0a
-QvBcmKBQ6vkmKMvJJqZFyyxg4IXJhma6THBX1iGfP3SVYiua8yROz4UAX+lIW51SHtCMQ5broFY7h6GT
-sk+N50G1zn3vO3gJGyQ68e4y1b2qk8pzEnz50BoFDxd8dUzyTVyKFsbAALKJWirqzDRBdz+YAzVBTysU
-tVFjf8CISZyM08wXM3nc7nADDGvtcVXRpokxXiD/jhpsVX2H1jwtksSl4dHY6sgCIaaQ6E3FzNFsZrBz
-D+2hDdpDnuTnHXV/RLI98rxpLsA2HVZyr1odFKcxr3UPI5zH6dLZ73hDXshTL5ohDRwcV48f2oqpfdVW
-9Gn9lbX4hyIm3Cz0D/HACrohOZOmlRL4V/nICk4zknIWzSvC6niCV/g9zdGdGTpA9+61XsapemlFLrc2
-S5vfmC/sHVbwFZqZC6sOZrwIXqYx96v+LPhKYZ5UNb5lJE/xmhiPXtOEMOP3L5Ubml2poEh7z2javaLU
-3PvP459/ChjP43QZL7b+ZgKzcYI8hLzOGuac4kE1kDSkEXn7+uUzus5oSlLui7L+ZtyJXxa7bg2bTtw5
-+XC2yOn6bF3Dv7ZZAfNSO4uz1Wu52fsN/bGA+6Dg/lGQfOvDNtOA+hCsCc/jEM3Quv4mDz4UJI+JEkQ+
-NAqqZuZdTcpwSpJnCWaszinAegacehFf2riqfINmsxna0DhCB2P0EemHaAR490cPG5yLXcQ8XGn8NnYY
-YkbQKMxjHoc4GR3pVijUe2gUiW0mH7VFGFm0SM9TepHaSsbpgjrLXeA8jdOlrZx+5SoqTdK2kkzuhs6S
-oLHZqZERWeAi4bYi8s3IaaNoDf4GJwURA/+x/Y4RZZ6sJsU52U4QlLFNiI30d5kZxmzb+EYkIZzUKTg5
-J9vTrg2PJIxYcLWRoJkkcHgfLK3tdHCMLqqv6opfFq6IkPu+jxNu+n6VrGSRE7aq1bsAUBszgb3rQxCR
-BcmbTKRekUDYqBGZW3W8xpzY+FAmqhASoOgRcQDP4ikGi88TiXAmZBkLy5Rvpb/keNwao0AtAeOYFGGO
-bVNDzzzVIMUYTVsYlGyvCb0lx2uC0yiSdi4Bazd06U8U5ITRZNPqjytLM2ClGo0geWukNM73JOTwvom1
-/js7GS3iFCfJdnTqG6KvnYtHQZbTdVw7hFRfp1N0HP9OEF0gviIooUuK4hT5F3HEVwinEVqReLniYw0B
-54oSTjxJ8WaO8/oc/h3N0P0H9YlN83iJZugvBwf154nAL86gf/p6ju9Hf/XqryOcn8Pbw8WD+3/9pvF2
-DTKU96evHnxD5q2X0h+U/Y6mUHv97XyZ4wjoRF8CaP11GOdhAkzupNatJ4cPDiYI/hOkndbVDicPOt/C
-CwCBVlsLW1+fNpjERnRl9FXASCImjfcnMSJeffoFOMtIGvke2yxbrzjPfU+OrTdB7Hfre5gF8nVVP9ss
-VbVPk8T3chLyYN6qQKwi/+Skago6gXG4rzrmtAFPUi5YlL0Bog57C0IhgYgDa5uCVhN6ekAQZ4XJL72J
-nC3219vO14s4SWqHWrG7lYvz5PD0YZNtqILbjlIHUMo5JhnmqyCK8ZqmkX1g9ETcaRgEWnsvRy1aW/uE
-WEneKyQ2g0gtOHG+hQeHDw5aa1CXuxBL9MD+nqG9GfJQAkguSnQXHVD7O4Opr//Hs7JXZqoaOsZ/TezT
-g/GcnoPK5WIVc2LvXgm0r+fyoeZYPavSitEY/6+uMwkGrMWOlugmBA+6l6N3eHDwZzsK1aFdtVy6l46e
-R13LR7J+a8epXWGXDrNjU13WQenJwalBrLV0F4s47CudexP0zf2gnEvXYF73nczrOtP6vmVaCw7Ac5yy
-WNT/XNkShRjxoCFGKBH1GS0g4uygrfaTAE5XXvExkeztWTxwa5XM0KFVlKNP7eKy9ThREmMUsykjzard
-6sCuw5AQF1bFYpGQcho3OOCAZYCaS6E2OyYoNiZI3JodULoaT9+GW42x3x52C/SNlhG68VJCOy6VRn/L
-0CpacL8c/Illulu9bg3BvzalcZLY5g9OkobOBZ4o+4RFX23BgxorBLV0nJbTd2vZPIbqOxHv7w9YOCBU
-rCg4S9/1vT+VVhdvLOSjVkeJ1w2fqpY+u3GOVWW88Q6HUdFeOELGVjWG+ADaQOk9kAK2G98cVqW2m36J
-u5pT7na2iWGEH8PKj2n6GqdL4sMJBihTfrKW8zOynE5R2ybY1o2W5i/Oork4ZMs4QSEi/vbbb79NX72a
-Pn++/8MPR+v1EWPewy90bLpUVZXQ9eIlWEJDnBDfI5UTQE4SzOMNEZ1zZAZwFLzIyREaxSn6MxtVJ64M
-M36ERn9m+3hJjedMPIxMyDU8WZtP2o9W8GRlPmk/iuBJZD5pP3oFT1LzSfvRFp5szSf6kRyAL8SolDMk
-LxLyNk98fD5Bi5yuRS/pSQNn94yk3+UYPNLxeRCnEbn8eeGPPo7U2MKJOaGM2KCuTCjQDf2EZYTiecCK
-OeO5mG1lHQawtuKbsHG69Ct69tDhxKjZKFtAILFcyKJ9Tzy0V/aGB2S4VFMljWOzyD3RM05tluo1Hfw6
-rhfVDTlb5rTIXEg0lGqF2jyKPHn4xVU1WNIS///ScE2nSHDeo+kUbNrKC+yJHKMVznJ6uQ0YyTckDyJ6
-kXLR0ekWBkQs/9n9g8Nv9g/+sn94cE/3x+z+4Z+/enrwVWs+KOS3Mhug8oEzYiQ42/6rV/vPn4/GbVRA
-81BUwBlH4555khPYUOl5THxp54M9RzD2LTPnC7nM4pyoo6zcwLasGeQdVcFBzxvCrXilA8V9+LFUP8Zo
-T2JDX6L7X6Mv0TcH+r/Dg4MD0ySniEAzNHqof8xGaE9i5/Ttm2fHcjppHwr4v6HiN7DUovAjGhawN4TQ
-H2iGCAtxJjtGUDmCutRDZazYK9HtCaLAyX46qnVyTnBkdLHZq+L3i39YazJWIRYiQJ24gGVJzH3vobaI
-lmEtENHzEMXoEQqrAJ5G/I4OgArxiRm1c7GKE4L8MAhXOH/K/YMxCIQeakj4UNRYvGLBtkUAMUvCkmfI
-pkqEB2ObnqRIVS+YqGUxhdyopjbANacFo+dJjhmxdL1l2o9GE7R/OK4VN5I7fDTrMQbUkx6d+1TAefXi
-TBd3Vl0vPUGSFJj1TUKOV/Sich1knSRVYPtsRS/aZDWRbQlz0NdENUFbcZ4rSZxOYXc50syZcRye0w3J
-Fwm9CEK6nuLp4YP73/zlLw++nn77zdf3v/qmcvSS5h3fy8mG5Kzu2tVoX/UC3PbNuayOvtIhKmYyJExC
-OUxtJ6e205I+kUJYAEvikPjjQJFW8pOHIBTBRlamrtAiqWTcb7RI+n88R+TRwT70gI7B6fTRKt38Gy5a
-pWNWw0tOOm5ZHLGUA1bNYa6oB3nCaQmcosVxSUMG8pHf0LgoJ6XqIc+3jd5WIGKn53TuSzwBucxy62k1
-xGC+bxmAKzSeZysH4+9wwGq1wlORGuDu4eHN0v/gj/BmecS2jJN1EGZFEEcJ+biijM++vBpN0OjBWvwv
-NuTH6NsDrxmp19SqtM7faldU7Y9UHhyzMYaRsQKEX3ZAIhM8tMZAG4CLNI3TZQcEx3OjIjyHenLIUMLa
-1VlMu/IYnFGmz8GCpJrAJD5e1CH8GL0zbpa7xztEHaO3xqXnX0N7OOwwXhrMVVoYOM3KRC3M4VAGPjEa
-9B/ypxVUIj3jMpmKtBjX8qkgUxMgc67MkMcAo9WbT2spNssz8+BCIAgG3AFhVpdLYQ95AvhJSi+gK19h
-vgoWCaW5L6SzIKUX/hhNNTN3VAfYxTmaPlvhnPtmHzmVl/a2pcV6LnZJq2pH8u8FzV/gcFWrpdNupD9a
-mhLd8tHhptyqRAa1mvg5Xm4miONlK2a62TRRmRJG0GO7ltf8AG17M+RNHMS1O9BWXFAmBhYGVBA7XONS
-o+LKQUUUqE4UfyxaG/c8meO8cwVdWRdJyalqvL1pO2n7R9B2oKNijSq/AqmnVhhQp+ky0aH/M/yIu32I
-GeHdmsTOvcng9M0Np11Q8FBvUttvPn0Ccbi3qOCiVVG959iKSudkGT3l230Zy8ZqZpGTliOeWKiap5w0
-PLOaCzRv2w6ilscgqg6f2ILThjeSaZNM3BucTFA75ZH+wLwGT8iTPcGRNjg5tWk1Lc9KPqiYBZx/HNXY
-LDTIyhj6+N2t8Lod+Vwvj7Pztx14m61/O3malGnVnm2n/Dnm+AgGeGJ9/xNekyOopU1Ou0KY2idxdHkK
-AlZbPGg2QR07oFyHEHlOthG9qNsm7kLwg82UI98EbBUvwAXx3j2kHp2T7TNwYp6hw6+6pAzScilumVhA
-RW894kAI85DzjRHp+DmdcDQ7lMKblpNr4lstUkRHzLEKWoeQzNCI5wUZtUqmlMeLbctSo96u2fJXnMSR
-832Z4m/URh1VRnDL243Aizl5BYlLerc6gxL/To12yHnpNwi6I+rsdHXX1HW2TxNp2PN7KW0ibvWGIE3Q
-nOGclZj9Btg4wOxVnCQxIyFNI+aPG5EaV3UVlxpvi2evmG7nZGtMinMzDxNyKS8MjLYVqlCeVGCdDs7t
-Pf+cbL2JdY83/bNL7ZSoz5tAtdaDu8WDumJarBqHpQVtp926mK/jHhmqNaObTMsxnZpgMpirMeGN2V1A
-tk2Y2pD+1TbjrudyYYgu7eLi6HRkcqP2FqXyex41GEMb8O9ky47MkWmD/ATL+qjOoeq0t7utucokA7A5
-vWCOT7w3QuA8LXPBqoS1OIr87lXZedZsqSPKmH049rdI2cVzvFw+RZ743tS7nkN3+8AiPmA3Uq/dYkLv
-druLRrERf/4Z7rpxVDFM0+nBmGxxZETVxZF9zrVCDsqWg7KkLH0bgQXYTN8NmcmqNN0gWqJG0GmjvD62
-jpohNgNmVw9pJeoFjhMSIU7RknBkUHwR8xWKoyM0MrsF7aHRRJ6j5RtRT8ckHbAfdPWSnW8biRWqfNi+
-fddwD7cMqpn+LI00X1xjqHXYp8Cg1X+O038vGToP+I0oCSWSShl5XWp+oKzlrLsTJSvKeA8R5n5eS3Tf
-u7M7ooras6ORRd4Ihw3+RrgOY23hae0bZm6QVi2fM6dodbSKlne6gukNFoLmKuBGNgJ00y3OzYWkqdFg
-RJLNAGRg0d91tNBK9DCFHnIr9ZB9H2513u1MaTEVT0YS4+i0c88y5v3LW9m+Bkyq5jzpnFQDxgd1LAm/
-6lH3QujdGtAfN3VVpGnPHlobptZ2OmSeD1qcf9Q8HyxvwmUGVWKBvhsNKsjGxTNmcgIjzXYz1QQ2MxUU
-ZYKCXOYlEA9+Ifm6ndkUANBMAj6sv9No0KzEaIEAvAoEvjdgDMrQDBm/GnBhQnAKeRSauWvvGIVs6oSQ
-0vNfcSJaUXl8jNSJWpA1shxsVSHbjGg2XoE6FX9fGPPDPlw/YFZlhKgH/g/Mig7jY6aVGJ4fPetIjZ4F
-0kdxhqxafqWH9bPgu5gzdK8x6k0lkK1fkC1JemdnGY38HnhM1VvzmLfcXMQz0QBQ3TTaIN81qLbJydBP
-aiKbxpL/iRFRXd1sq/4AmdLsIkdvQKponbugan7nCIhV+Pl3PfCK4Z2ed3R6Ljt9NnP2unYpgx4f3OFV
-xqDO/v4b4a8VH7bvVXoBlY0fgPRtxcCMbLgtJ7EWj753D1W61+cysYRftJSurR2i3ik1l7kaL56gYoL+
-emA9rprtrG85ff1nba2rC3dAXe1c/Whbe1sn5mq/67wj6kygVhO/6rLpdBFfIhyt41Tes8dXmKMVZohc
-8hzL1RfSPCcso3Afh5DQZNCGcSEfCwyMcCkdE0XRGsPtg2gpDiT49y1kNCg9GJFRKMuJvDuHYBYnW4TW
-+FzWdk5SebUdWuY45UjliTGJYIhTWpFw5jdX9zggODQz/ol3tsW9xuy8HVZ15p/ZWXULb+YKSar4sUAC
-3+Wu57L5AiWfZkiW67NatwyfuhI0A0xt3UHjSi8zp5VM7F56kjIlT46MpHSjen45IcqyeJ0lBIXalUyM
-O1vRC4TRI71Q9uM0K/hjNcgg2+oF91K8qfSqPXKuoxSIry3ZVOXtEn8eGhKxA0dwN07VJSEntTx8p7Ue
-c5Ru9V7ZH/6oMIuMdGSUmR3UgXTSAHzKjtAo5Cq6qEq16T2C/kWCL8xGnFzyEQSFz0YLmq/3FYIRQuly
-P4oZcJjZKORSlaNYjj8eifeQy756qSmr3u1TuAuUzUYf0ZJwTvJj+F8nwhs99sCf98ZKdTPZ8C4Kde8u
-l5F48J2FuynZJ0gXB13VjZTuOlSlUr2XT+w+qDrgpF7gjdtpldM6fvXbBVzHrX7bgSFv6gYngpPsacNB
-+ezTJ/Sgy0KuS5SPlP9Tq4xyHPsBQ24lVcp82FNQJrGMWmX180+fmud7reuF2XUmFguaIe9HimGXU0lb
-g6DdIzJEm0RnWF8VpJwS4KfDqXiN46QClT8do1MLVTNGqf7cMVqcgAAM5j5Ir2/zvNjNQTkkb1ZEOkOF
-q5yuiRXmlczeJ7OlWr2qcRo9jxeLtqbFOpI/kEQ0fvRmRZD2KpSDAuLJnJAUhRI0QG+EbLMmOGVoSwuE
-c4LiFMlcbIguQOS4yGMukDC6JjQlYEnxmMLBAvSGok1MLiCnknoIQXbwwDvGG4Kexzihy4J4IMeImi7i
-JEGMEIRRkcaLmEQoihcLQRFBNE226AJvtVUojyOdxkmqvSDZHoqF+CKrwqChi1PGseCVCjgvEoJIFHOa
-Q8Uhzbai9rykM045RTEP0G+q9YwLwkBA41yq1ZhowUXMV7TgKKIgXK1iNkFzsWOsSAoNWhcMrhnekHyL
-QpyTRZGglMJurnuRIJxuLV3Y9oZhMqL5DX1Ow7aT2AhWzOgIjQQPZjr8MKD5cgqZ6CAKn/0JwPaNJ6O6
-dX2kl0Y/Kg3ZQpFQel5k/Qgk3D4XW2ULCdj0Y7kb9KMyoVuo1jjMaT8OAGOjpnYRVYGFucF1ahdWllvh
-vIiTCNLZf5/T9YvLLLdnwAB3WceB9mFLkk7JxVMjdnXECWumOISIuugSzdBh/YU4laSRXFMfCiIt+W0v
-QRVRZ7K+EzWnTqvQOIMOkLj3DwW/qxcqJ1BnOZuobm0m2hPtasvscXTZzGrS9lfRO9G79F1aBvR6aK9e
-1R7y0Md3aTMoRHy8/2DlNXcfP8Lti+oSvqurI/EEsIAC4uoK0VQ8Av9VsDZeXbmwzmm0RTP034+yx9Jt
-s4HKVe5R9vgNXrIj53tYTI9dr//j48dc8Bd093yC7m7Q0QxJct01/sd/POL540c8evzx493zq6tHUx7p
-nxv9c8rzrjpJGnU0aSpp/m8HwJUYPK8920l5W40O3GpEbKEyKV8K5/mqgApKHb1LR+NgjTPjdJQYmU6S
-gOfx2h+3s50AyhP4X7sc76PDUzFpwxysAGLeOqDqqGrNkLDvaZwK4sTrppocZvTeTIV57ziXjZh2W0FX
-MU8GD2sy3ZBXrYGyCIZ7M2iEFa5b5AJAPigjyHQqL/lnoTj56A2/SHmcILzgJNcnQCExFFmEuRB9nouT
-ldj7W/jKtJwC3RvqK9Y4qfVhl6WqvVWYbbQaUESXvZEMrN2N7Wmei2Gd/t937EsZ5f9Jj/Ync3P8JDfd
-T7DZjd+xPf/k3cW7/XfBu7une+N37Mt3H6fLdRv7GvNwZdly1IB9bPrb1TYQS7RDa7NwwyhxogOiJit0
-wElBwAagtj9oJdisAnJJQr8aBKuDK2wx0vUaSp40FzeqB0BIoPsOoCSG+/0lrQKtBQ6EBwHoUnUpJLbg
-kqojADmwG8b7tGEaqboOssu2gRrByUOcn1QGixVmKwin0D7FK3VO9bzxjbyPaifStmPYYL5jE+zaNos7
-9mOt4QJYF6xUjoCu6IbG+diKp5Yjqj0mnxvPtLati3n2hNl1jL9yp45p+lrHzo7kzcYLwsNVdRC2eQ5a
-XMNyAlNkiPtM95xqHi6gs226gB/hco1ahWcSulmvGrAZOmtiMzCWQDYY+SxY0LBoLQD1bkn4sZQD/HHA
-wMbzzxxncHekxR9MlaKpP5onRT6yZU6wUHkXZ1myHZD1a/D6bY5n83czGds25fjSFfOBQ/KGLpcJ+SFe
-rnTOZzexEHwBCG3NcHQs9KilESVlDlelfkd+R9v6aLFYvTFfHSEPh2S6phGpOX5JJVbbc39zhKqY7iGj
-0eAitY6GQANkZi0pqym1yq/Jklwqd63XZPniMvNH//fdO/alWO+wee+h0bt3bE/8VilWlk2pWy+MOI18
-A23zPi/xmePw/ALnEVMX9rW74CLHme1GVaRuRDgmkDNxQ9wYVjQh/6R55ITIoaWyFmcvIzU3uYp7Vwy6
-dzuCbbBbFHAP4Eul764NZDsmpUwjtCT8RULE1++2LyMZB7zvgVZgrJC+TDn9NSYXDudbtqIXfhUxxE7i
-6LR7rq3oRfPOoCZ9jHAN5yVSz11XcKNeR8rexG76053Sy76b2tB0Jn0TDYLca40mVI7HRZ5MLHLVjdw6
-s5yGKgrfdbGBIEyB6Lj9YzGKB6eO+P1b9KS0OD3ekhsjqq4B8fVc2smjd0n465qdp3v/KSXT6iKr64WB
-VU3mcXhub7ZVxp8qy8OZEO0tmXjRbjMHWe1dpZTnOSK7S1lYUK/SjzvyiqB6KI9RS1/KDZikMCx3ZhYi
-+1JwuKxx7kyp+uMKy9cfe1y7/anVmFi2zNG7DsodsoplqaJdAofsZDYl/aW8AMxi+Vq54oWQVVp0yO/W
-BWkxFTLCrZufTXYRnLoeetg0f+uU/g0rt2WP4dSKSZm1G3ik/dp2tBU1BDGDCFJfBpdyWj24QVCpMmQK
-/OK7z6mtfvHq+pXUTO9Nc3y7tgr8Ebp/zRTlolnTGXAXyBPZHhmxNCDZEZ4zH77ktEgjXxataG7GlAKP
-QY8cKdvbNiBrShvDsyDqlIYIHxTb/u9p+wdMW9P9ozFulimhgR0zo79Ki59Kx/ws65OpudAUfXPQfTGh
-cX5oJHoHmdWewaipAoO/DSm16TdU6ppsuqXpFD3lnKwzsMSDefS/DdPIgtL/RnGKaB4RmIaMcFRk6EMR
-h+fofbHO0JzwC0LSKkkwTiNZ1a6nUCikj5/ww3b+NM1YbQncNGdZDyBA+X8W6+wNzpeQ7al9y6stL6tp
-umqlZjVnntHIgBPGfWn6ik+t66tW3Xs0QzHaQ4cP0ftWle/tVRoD+SyhjKB5jsNzwhHmiHGcc0QXgEn5
-sZAUnEWge9t6SrMhYD95dzVdm814726G/lxP8EKabYpVpa03ek69Y1/O3rEva/aZ6VraJUq63DWK1gDe
-gdJme46UxhkQkgCX1cDR3cb206vBOoOSpt6NJ8M5NxZHozF6gbSsqRDcIYpq++yd2XW3+6a2G9CeHJxO
-JG0nh83BKus+OTiV2Xe0LcywFzRP9c4zpmX4JL80wvzqyHZQ3RCuL3f+m/Kjq0YDPOjs7t/SlgYAAfzy
-px/94Mvx1dTSFQDQ0cCWK5/d4ua8XkMsmTNpIxVL+8h/F+2Np6226vwrA+7WMKKxS2bMuHfrOhJ9otRp
-XTrPQTaDB4g6loBZNNw2NKAWRwVXE3Tffr5ucwbHFafdFdtPb0jPQn1olRNQzYKO034zx9F6LJajvzZT
-y3X1kFahU05/jFPir9sr390BzUCEm2uyLB3myWOxfpMuj0CStuq6uoQ5MduHGMK0Mq2pkqmf3K36DQWi
-7h622LXbOaP0SaNah6Z/+KBUk/pQUkfxpiPlZDtbJa3TUPmQDyxer7/yKh9UvJTfvQlS9ww0JfvxYGRa
-ym/hKjMeDUYFrtpVq0rP7WF9UtsAjL5p+XIPQmcojCpcppJuIAKl5GrhUM9d+UCcFoHP/ghtYzaKaE67
-JCQZ2thz9gaSBbpeTI2D/b17/Qf7ksxGVq+bH/VrKibLyRwQOCLUzNN2p+KmvIOsPJNL5cIj4xKSHvz3
-e/F3l+9RnbW77vMxcMHo2W40qopz6rzNiPYULTvEhcHgvLbywAt7egBgHJQPupPJyjH/tQY/ww5rWvPa
-ApLmlGKwz1Yx4zTf6hKgQPpBPmuXrFlgnOJyi5+v5E5QlRwisfbYLz8/W2QlbXWnutk9xdDvF/Wg6U2T
-CuUauAlykiU4JP7UP5l8vPLHp+PpcoK8u4fvivsHB3Ovs5qE4khsVEKS/wVifsxKScrz7QS16hZzYhNE
-NCU6KE3sF5vA2fkD1LPlyLSVafWqrH0NF5Kdi3EVJLcTrg66+8wE7r8DrVb10LvQzELXvRPNxHELG4L1
-ErOyaOcmYOz6G+nu0LclXOeyO/35g3npJqiCUyQnlT/bi3oTqICTuywkAc8Lxp+yH/g6kYzyOxptb5Nz
-bW6LaTXXUfuE6OBJlZd9k0E7jAwDOlKB7tqT+rQkU+f+5/HPPwVy2cSLrSz0HLLpCS4yQR5CzcStjX0A
-isAR3n6fS3VYBsh/yp92Z2xFGr1IBVuVweG9utZ5QufKlPFdQuf+SXtan07QR3CuO0IQOj/NEhynD8MV
-zhnhs4Iv9r8dte8QxhvylPkC/wSNZJCeQNqTpTteLAZQ7tDZTUVxz+bIN5JIR0eWZdt2uBup9MajZn7j
-3lW1s+ykTiMwoT59QqOfKHoeLxYW/dt0il4TRnjp+gBe9TFEwuYExQylFA43Mp/Ak1uXXRSpo+/LhHRi
-nkGVRm65XaQMMUcGjPatujwZeCp15zHexOnyIfolIZgR9E8cN0MyXTNO4LmNGQeDfmR29f/otKx3jxqj
-Y7wh0UP0migfSXfaxrqfUZFGMsvR7cvTNTqvN/8SzNwJglDdbcyszjFtOnJbSSPOvuLpzZzLA+t0jUV/
-vWoSdNZbLyHzBIysXaggJZHyMaRu7MxY8hyz1ZziPBqatKQ/LclN0o8kpSyi01tY8jNYFN5aWQc3SRqp
-SOB3BWbyLfnOIbCUiMz0ivLhyJ51uq3mBmhDyS1RthWo7auUjCtj5Ss7kTlZ5ISt/HqDAr4i6bDTqtHb
-Fn/OHvvClTkXSN7FE3ar5y1sJuA7Qni4kmcpVlpROthJczpc67Kc3ptx6nne1KSwDPNoNHHeqXf92YJs
-VtjdrgR4mmVBFOcEbqbxPc6UBN918aqRp0odxt7myRHyphnOeYwTNlVZWYIVXydetVUmcXp+ZDr7S6ZB
-ErKeIMx53so9qFSg7Fjf19Sh9DAoFPIwXQAMXFZM4YzRnLTN2aJucrX3iziBFure22GdA7QfNYgMDTRH
-yJs1EdeAebwmAqjxeEVwRHJ4UbVg0jsoZtU3HxnwRZI6zrgdFIQMm7ChCDAKtBdC9fIXnGNRcnQPLgsd
-ORQMrivS0UjeFdpbrn4fen0y1Kmac4oHzz5LnXB/36ZVk2MGltUs1qVDaFWjYSZRXRuVMBPkrY17ivT5
-Fqxd8mvNqLGRFo2GNUM1AzICqa7yGDthpxpd7YbtGlh0Ep2uVier0/X6ZH1aFrqqNQmueK81p5om/mZs
-GoogohtCf9Tr1ts1U32R0gtpMVobb/GSGjuNNB2lxhNwh5EYHjdsRqqo+OuZzW6YbhS+OEWepXMqMwjY
-AH2IpkpTtFcbWkmBABGV7iFv7NX6rH4LTq3nMsw5yQUJU3Cz8aNP20/pp9Wn9Sc29vfxko6fTB/WOloV
-ke5tm7HREZZJ0JxhMuxTrKKTw9PxBK1P7p+WWmYPMtm98qw3sTcxycvCLcx2xJmYIdYA1haTve6WcvcC
-nFQAIpAVTvqWd+dMbQKqOPhqututJ5qAFymo2l02lNaaV6z0LtngpIVkXF8IVmSGn7kxE2Ee2kvpjCIj
-cCiFYA6rezSyWm+qtqb0xzh1Xi8pBi4QVfmgs72hKxPEeydoVsU4SoFNhTn6Hm5qACukgRrBdgoQA2aV
-EzEmnrrq/uLiAjYwnEZi54LL7i9onkRhQsPzaUjTDck5iWD7fRIzOnPETmnUe7OKhcCF8q9ewXXy63UH
-4bqkdy87nB0MvPi42n0V8bXlMEGdlx/XK4XkEudoD90fl3d37ngpMUwD0Uk+aQq8qDOLbJ8kp5jL2zS+
-/JczGFHpzkxGexvswGts6/7fvKb9+TevqaH+N6+5fV5zHKfhv1aSgRpvT5Sp1sga3N9+ohd+6xizU59Q
-mvA4+6P6RE81olad+HtycDoOVL3+RwSyqnh5hEZzyjldj3ZqgsfZGzwfpgPYvQHAz8VZU1Fe72oYDqXK
-MhXxG2vAgmCEZMODkOfJ39t30erP8BvGp1OZszZmiFEEV0nvkxTyFeEUzQkKcbFcQaxXXqQIyxywFyuS
-Iug0UTDESUIi8PCz4S8zx2ZN04nZoppiDt27h8RDfbX1zdvJLmIermpVuZCGmBH01yNBOZ67mdaGB+qu
-gOdkgYukdZO2+RFzYCO2AxzAjXzdkDIMC6Cl415M02PxzF1MI0Yz6dmgHFIAEyhQ3kE20PKdfNGJr151
-g5wXEHMlCd1rel6an67gLujpw69EV8OccyKBRCstiu60SPrjIs0+s+GGYsXcOtrdpRIMgQesmAfi60vt
-6PQuHY27R7IW5CjKyjhHwCTDO+7dQ9MT9I6fTmUEICvmJ/HpWEY/dg5MN83gHCfqUU0VlU9QjPaBjO5Z
-3LMqUrEqLtgfuDQAvw7KvEVZxOMsJyz+Hc8TMmzrygnjeRzyI+Q9NTTFdq02TpI5Ds+PkHcPwl/i34lV
-N93YDzPKuJDQB+yLsPOVTWilTBNvaep7AEEYp1mtlWTDJ6iInUG10gdAtcLFF+pQ1rRnNxmfN6JhxzTn
-0vRa3QJhGKzUQ4uirk7y7nIH2i1C7S5IVuNAZhSnOSe5JW1Z2XM05z/GjB8h21GybLh91dvk9lbGkKqj
-a1YwpPOQL2PGg2XMV8UcTkrrZJuGq2kUfX3wl/lfvyLR/W+/jb7+61//8pdvrcODC04hWd8tDI5jZdnG
-rbSrK3n2pqOm0JwcnNpTDw7vW8ccjtfkxzi1sxgQbVk0/x7OmOKk/BUcAtWhE44goz//Nv3zevrnaP/P
-/6Wt6w09OOakfm9gXcEMdqaxirzxa5poGSubL+O0ltSf0+wIHR5UI5HHyxWvP5IHhSP0lfEsIQt+hO4/
-OPjC6JxbO9exlTRXN/P9an+lJMFZIz1mPEEur+wG3pP4FM3QnfoTu9bI5QsuhG5RmfhSx9PNP1uYKk/y
-XoZq6wl1/vVq4QveRKVNbeAs+0q+9RW4a1+40/Ee7XSiAO1QyvXl3F8F6kdJgb3z7yiwjvS1u9Gh8QmO
-bDAMPEHzbuQIi5NQkNAQJ+QZXWc4J/5cPBvAQlA5WlUfqG92jaa8iLyCaqSO3xip4zdKaLZWKnCdk+1Q
-TOdk68Yzx/mKqAykDyAHi6TSKa2ZBcDkuo5Tv3w4QV8/sNTULoQvzUKHtkIg4G+WP+iCNcLQlwbSPcUA
-A06z6ofkbna8JTVVBfsmkv0hSNhm+c84gvvMQFS7ED9c6uwLBVkWKqsQHLf6BWzajuLyWExTY4th4rdU
-cgVwL4N/cjCRNdlixgHH08tYLVa2WQb4Mma+K5eewO7LSh0gNI9BCyx7yaZRhY4h64xvXR3DNktFD5we
-SuWWvUacZSSNfI9tlq4cgGL78T3oBW9S9ncnsJwOElpOh57qeyrnOU6ZEAC8CZI/EsGYvWpywqDvIW/i
-NWevN7b1IwzWsMrhmjlR8SUSw4t4si/+XoviA6CvXGZ22uQUCSK6xnHqn1irib4CRiHXsClHRQavUkBR
-oGLzTLjQgKtEplDlHBX8DV2N2w7Mum58OaBufLlb3dpc5K7etQwTsiRwRt513kfxZuDo82Rf1mIbMpBa
-4zU5KwmRX65dOWjTZQ8LOVx1ovhqiQpHZhDZ7ZIg9kXRi/YVpLraCy6DriUB8x9udXLxRnFe9oGXOpoW
-rqRWpLFu28BNM5La0SftoETrQV98oK4yN1rie8Ec567GAfkR5liilXKGHq+OIqCddHQHMkdIHJy66q5P
-U/tiFJNXMJ5IXZb00JXq08B46cQm+ZNfrdxITsrxELRC6o5NaaO3RLmZDC+iN6s6/c5S7naVBmy039Fs
-h0bCTSVNfW9NC0bWdEMC0dPlr7PLweW2w1tocga5sMsw12uSHyZxeF4nYILed9Egr0RDM+TB3YDg5Sb2
-QJiZ793KUfPc6MlkI6LoaW8G3tqBM+qH7zjI9lkBek6tzbQj5sdtIFBHV3n3QgcGRsClY3jWKKTPmmiG
-7vqjP8lL3TpqQOVJsw8tkpf5MpqI7lj6XkrFgo8myJXkyfz0GVNQZ38hUDl6K75OJmhOo603LvO/ZT4J
-6GLBCPfHQjDsGhDnihh2hm1vHwmek8S5O8LmIfZZV/bljp2i3CXEmu6RSMkl38dpuKK5EGdAkOmCF1zp
-oBMiEiDefvCArLtRRYJTecH9XsBtk6HExrbjC24RPBibm4dzx5FMziGa2o/v7XFjJOscNCmf3WTYOjb3
-wV1yOLBHGrvp4Q1HX++xFx2nwe59rj0EZUMNMCcng+M3munjUgyuTL44d4jCcE/+2C66IhU2cdYhIaNy
-ElXaaP/Sttm78meimhGyZtsBV/s1f5vKW+xPPLE+zqVf9AR5fxP/vRH//SL+e+GdGu7+6WLNfTZB6yLh
-E8SKxSK+nCCa8VJFLL6jmfzz6VOpGwY3eX0pz/cJxdxnhmN3zH7CP/kpZG5SoTFMBsbI8HjPok1npuJc
-IBF1yqTE1YxItZJKJitOjTrvpOMWSmgQeoK8AwjgUr+PkHfgWYj99Andidn3cRpz4qfjFjpv33Drx2b+
-ZJMOjB7P6jmaobOK9Rxi+qDMIqE0l1ERYmPDYzRF5S8xGObcwGiqimX0wpdDZWCRmM0CkB5WzYgT+bql
-IlddMUNNwLKbWtOtbLpoBg44/T6+JJH/oNb2R+iQ7D+oDa+CVgnmWvaRlCzRDKXoEToQI7XvifHxarYN
-AbKH/L18bFBnuPLLeDXfE9O5y9Zs2EQsE1AvhsODg4MJEsvoo2btTTOqrnC+5aQzOGtojfe/niDvO1El
-gpktb/dBvfXH3YFzO1U/H1y9YYEjCWY8Dr0Jkrolw1IJv/811souY6VOahbzGCelwtr6+NMnZNgrGd8m
-JFA7oVWRIG3/ndHi+uPA66DE7TdqxzGCyDPjNUiqP2jV4Ci7tATnX9k1wiTlcKdbnGYFV3eWjyaqrbZw
-Um0NlhBiq+8z537htud+h3Np8L6I04heiC1LTNPvdYSqMfYSYiI4WDuc2mF5Rdr6ev+gPrOUBbb5WFth
-G4+VIfbgoNk05JrUVi8W8RHCnyXkEkHYpSTKDLtEdfcWdG3Xi6YFZ09Ow1W5Eg4fHPwL7DMdBpl+Q4u0
-sYgTMm5d2anhty14mkdxihNnbAPN/gibx3AzRk0i9g4PDv7s0hJL8wynWTdtVt3mdPqH2UP+MFvWiNOs
-mfBMV7jdtcLtkApF02011j0BxBp2OQBo+i6EGNTwFVcsrA9//+6iWM6F28x5pTn4BFmu8hSfi2Aey2Mk
-ONP13eaJBiiVbCdjI72EqMeJG0T6Kr1Wv8rVoWixEz7UOqwpKQs8ciR6vT4t5iKbaVNX1XCnTSxI8ZpU
-Fvdv7YoI8bmheVt1WLlTVLRVtR8+sJe78V6BKhvmACu6+GwN6NdCmP0OpxGDcpKa0wkKDl2FBRepMwhn
-h/xh/LOOv2Us7ytQ7jTKwOHQWdDMvTM5xgE6h8fhOfPlnIJbgezAUY5bl3Yi6yJoukhZXcmQZgnNHP4b
-OLdv9FR0JWLWn93Xp+ZTOzVFtv5/grVtawZ/Y63W3ZDarETwD9fA150IDia7sKlftbOUa8FWxl/QlC6V
-+TfIQWnn4srT6X+BC4V7MUjpZ7Qc9Zn4RmDwHE3QSHpldBXoNC7fpOrtsKqBQXUAVR0JyRm78MEZUoIp
-Xb6gg6SRTeBBlU8aU6Zzo6pgjvPRWCqyjYltRyNQDDZZj8TZcIduBEL6oLcj13SVq8eXK2KIDXokuedo
-Utt3xJbjj69pWm6YkNUKGtv39zYTuL4v9d9ynK3+JafvQ/vpu/nY5gONyuP3t3/s6RunKTXyJnWfz9sv
-lyQlOeY0d7yf5wVbQXyOAJhDPI4L7EUaARBJIwsIgZRl3wnAI+T9fxaINb50ULGOU8eblOZrnMS/2/JB
-1bunG0Cn53RAsRW9eNro6S5lh841dYS8R1G8QbDwZ6OcXoweP5pG8eaxNed0AxaFNNlPlvuH9weWkhX0
-olZovxlMy7AC8tWuWp8JuhvSdRYnzmRZYKV8kZB1S+ERhKs4iXKS+g6zl3ZS6y1tvSGpcrN7mkJ2SByn
-YB2xRVOb2O53Y2tR066kt2WV50ZjBnfZDnGa7li3tV/sbvxgGn4ZXbbvEKnTWy5ZN6UlovtDK//fqCNs
-6/y0jlAfIrVzu3Nud/lz31jj1e+a/YdptDyxdzp9K+FoV0YOHJZHZjRF9w8c8gyUUjbtShhwrNI4tfhM
-6eB3ObvKjbMzAN7DOcHekVPCghtCq77LCe7yeprnBJ87zrEynHpoTeLXsLMiSNNi064Kw0/XGF/2qEhT
-34Py3kSiJVEfJIgT5T79nSrUpRUvNww75v8lyvCuqv4YbXhEFqzhcCweOUlRMGESZ79gvuomORajCLB9
-+Po9hPp1Rx0O1F2IMwqXJO1DigLwgcdJ0uctH2f7GZYjX+SJ/yfx5JYjMP64yIvr0LRVNNm7XfQF06uw
-27rTAhnYJZKD3KJUNIzcNncE9eSAaSuVGB7NcBjzba+jWb8rWj+O5hoZwrkM3tHdkLDImXSqVCvG1XDT
-b3orZs0bulxa1dUgnCQ0FNxRSez1kI2mDqU8lNjiiRIaaloXCcWiH5RMYa8ZZtQblW1sx+pLuKwNBWor
-0Bu4LIKN2BVRsSPU5UcNqcR4B9ejibykpWVI1lKldaS8P5GvD/FhaDn8ytdf/eUvZP6t8/XXEV58jZ2v
-//rt1wR/5Xy9WPxlcXDgfI2/efDNfXfdi798ezhfuOuGj+W1S6rG4jD0715s1L1rL4JP6iVc5e9+v3W/
-p0nUUXpFN/IWg2vsX1C2h1W3JYGUpqSnUBSzLMHbCrqD9l9EBWgmf5gy6VEY52HirEq2RfBeV8QzYHwt
-75JqY++XrhZxkogmXKxi3t0GxTDblXT55mu2TFO+r2z43uH97NJVEyTkuOZIy9Qk1xtpi1OAwFbSIM5Q
-zkOx2Or+D8mp+5bDUidmbItOW5yJ707546YmTZD5c3zxNE3LvaXfnaIlYzXuM2l+1L4lU0l6LksyMhyW
-m9jdJaZTmRdwDkoQXVMZIQJZndp7srw7Q/y/H9I0IikjUYc5pqOKURRvXHYoXTSHPO2AoF6sSVdOL7pw
-5fSiu7ySSO6PxtKLf/QsJ9B/b1n9SpbrYj480KhxYOLuRv0/1PifL9LbbzUg/Syb+zZPbq2xev3gjiBc
-VNkWL0HJv8rJYjRBOHibJ2V/wXeainri8Lxlz+zELk13Ac1IKjFN0OhsnuD0vKuhLu8i9D86Os8wJ0ua
-b299FSq8n2Wjf6DMeuq5SYMFzs+ysfqWu1tur0Lris9E02l7n3co93J8Ue7yZwFf5ZTzhBjONqVh5mV0
-2aUvSfEachCp2Op6+GAek/68AUMccn5Szj72lgMNwzMKuHM+IIvUqFvRWfVlzLtq7nYLAmVH3Irkk0cm
-l3tTQkM5Lbw38gIkeV0K5La/jF1eUSC9iJriYCmjpv0xmkL4kLvAOk6fx5DiVHrQaTViZwkxYhPxBQ7P
-naD/BXC/dXSwu2cJrjkbd+4jMgBfHCPnMSMh9yN11W0XE4Fou+gSPZ4hCd6TZawsBxXViqD9rlyw7rhu
-HapemWkhtrNb0Mx4Wf1JHF1a8taZLcyscUjmh2j1cswhOWrGT0wW4XKUq4rDZJUrGe0hr5yxKi4s4yeH
-p65pW29YFfQqSh2c6tsfhpTdopl2mNqxBbJ8pMMh2Yec+2WEoyBqXyk5JpBmvxOX+Bhlt7rsVpQdkIdA
-0PFIr8shKQmqJZzxqBu/Av8vgL0cBPsbwG4Hwao73psTAYZ/EAKtjAPVpppQPV3mXlr2N10edFrnLWp3
-usHVaXPuW6Aq6duIuqx7YphKs54Yhy737ErRVGpdpG5Hd2pXuTfkkrsplXcfyMHt77th1QK3Zq+VTwY0
-9THSLtwWV5CqzHfgoiAL/YYeG/4A125gZYnRJD1B+w/QEXowLOGPpukJ2v8WHaHD/mL1dBVVrZC4Ah0h
-T3rfdXReCrn/q9YF4kGXDDKHa9RpRIRs8N139NIFXOoUh3TYfB4I3uhIt9DoqPk82A4CrpIizYPSqHl/
-sPfqfB5oWeZ+l1QGt38Ipm6HEbz4Uk/LLj7s0F0jJwuS2kWdX0xqF3t7+9CboK70SQrs/iCw7aHbqmiC
-3e+L2oDrKC45SfmxTBLfLaFBGtIKvFtukYAv0gjdqZXqzVcEN+OgfXlHrkbSvYuoMn5V5Hm8WOibCF2l
-3HtPaWWU7NMl0LURXE3QA5u0YwbgPaOJyp3veyfybGU4B0+aPqynfaGADfiabli869QPd7xH0utWHX43
-MYvncRLzrTgBw6+k+xS9c6yJq7JVHEUkddXVr7Z2HLT/HUIpP0NDKD+nKMdbiEHcLQzw0ojku+yK5INo
-LKUf6GhyaXTVe53j+OkMON0tnC8iC2ZNjtTV2lsPzeu3FsvTvzxQy+80d4WZnRycCvkdRrwL29NB6NRN
-YpCGMufPBbsNijQWgpa7ks881rDUSsioqkvpEToay9gv+BGECXgydy3jXfy0UDsIw6+SHRpPB2+p6H9f
-0OQOPvSo14/eXU07JVlnasVK9VTLQ+bewUseBaqcHrithOvSFFSabV83eadZ4I4+BSHKmTQXlSFkl1VI
-PKB53qXZFm3pNFOVKJrk9GQbhbJBVrCVLypBU6AMNGWCIXRpy3ZJAImUN3mwdbA8HZ14okfjtEMhApgu
-HZh0bKGp8XNigtObDP5FM2T3z0JVHvEBNgidS7w5nGYy71BtF+KfotHuQYWqNOLDqoZU4rdStWPtAK8S
-+2bXpFL7qupY5zq0r6x6RLbC0TF82zWM3W2P0GHZTV1Vm6v41kaoXAL9FKi7hX2gZB/6YoymyBa7VI6e
-vB/fPXoK505CIAzC/gzK2ssBhXtdEIK40k2pi0AAFBU+7hZSSsKcShV3g5C+oFhWhi/RoyGV4Q4Njrsy
-+1OYYRVvEi2ZQBUdS7MS636CnIdqUopp0UW8qkawzDIUbh3vLGe4aseXg2ov571BBHao1IZkiNh28o5K
-MCrDsoTYK6OvusiV+9iBiknzD5yZzZ3HpWH3AaBBdwKgwakbylq3t1nrdljCiK7My8iWMwKSQffnOyjN
-HtJBQ5wK/P2/HoyHZUrY7w1eMApcigJ+ZSXoTYIwikBldEjWXdSAVu9MnO8+uHOa1K9WepvGkMB4HLyn
-ceqPHqLRrR6alAfiy0hemYamU0MriF5GaP+xfN+H4UUaidNrhUZi3X+M1Js+Gfk1+OZ0MNTa1agH6l7U
-hitoeasU3IbaZ5BfID/uPf/qD7TnpFXhSXwavIRU+h2kGzhUb0j+28Z2cBooiH5sIU15nHbl70e92eHL
-Ts1V98OXRzM9InvoEB719yZSPQqIzPJDCqIhHZzTi/5OQfVuzumFvaPjHToayewT0B6ntNT8dETjmp/u
-4UFVp9ZaZJn5okWVEun/iT6/lS7c1dSPqigfw7aB07TmyVa97XOCNTzaavYWl2PbS0cyfv0xmV2fgxsy
-cxCxzfIId2xPaIc7dlBl7tvELE7iuYxbHH7bRrVbNTV0Q3ix7KzSHnQTDqhxKXNPlxJkQHd0ZGWSay2C
-hfUl8rV48SUKDh6Mpdl5YB1ltqYaiiElS6GrmkW9MwIKMp7Tc+Jsmw6J80XzBrdDIt1XYa+jCQqGtWIR
-J8ktkyJQGoQcBB0OB+0WgLFi5LxBolHo0km7UmhZjQJwB6M0DZjKriEVavJ28K4vr8x/Aw4Pg+jp95Yj
-aVTDp3aDa2ILcRJq06DqOVGBcTOVbEGPL9h0in4iG5KjnKQRydGcXhKGLmK+QglhDPEVTtG3KIsvScIQ
-zgniK7KFL1G8WMRhkXDEKYIYhl6eVxH9CH27A6/79hZ4XFn39ZkcTf0RKN5h66nNKZx2xnmhuvnj2lz/
-Jv0gNRe9ZKJS62+chUAG6BdGagFzXdla+qkdetvVkDFrB9h87mPV9JHw/rSmEU6OV/SC5N444Hm8XJJc
-5w+4ZsxPTZoCn/0e13y3Au9DQdQdzZDion7fVY+71i2FPeyYgwgNzUMkPqp5g2ROZIqSmTv3Sx0exEm5
-ow73ox2Kt4ze6BmKsljdNfVGXr3603Oe6U3ShP6fG4Zd1ljZ8huF1igsPfo8L3I2HXJjSbvOoOs9TW/t
-tEiSG+tiCWZEOoBiV5YCR/UNZ3HlUeRLG18pNFW2vPG4033cvB4ONNwq706PGhmgBiX/HXjT66BUNqh2
-+xpkeBi6G8r8Be0cDvOEujeeQZedwg1DN6TClY1gMBFitZj30O002iiQjq9do6RoLlmK96fFYjGggHkm
-g+sSD+8/GFLPCmdkXwrzcbqEtGJ5zLIX0dIdtTfQjj3Ml6QvnTHSx6Eup+YKwOqHXL1+bjWzlpNKpdHr
-vDTQoY9TIWaQ+SJgtMhD8kJ87/DqDtgqXvC/k+3tujZVrUUz2SI171xs3uha0QjMyWLNlXO4+wrEynm8
-Vcaa6bQq81xa0hdr/rzIQZ7Up/iqfCCOi43HB6djlz1nuN9SpUszsiije/fQdZzhJaIqsfNQ53ujnOzA
-AU70Q044Fm9392RvpIEc4oe3g7HsmvP2thbXnc9mdVkOxym5QNUZcWjBSqNUqYWqlaHUQguZlpXHayIz
-tA5FXtkk22vxOqjLiYqmU/RmFTOU0CVDWN/vDLeEIpLnNJ+gecERThhFFzQ/ZygIEI2iwIr95kddu9Pz
-erEWI+r99ttvv01fvZo+f77/ww9H6/URY5b4lMqDVXE+51po+C43OlPU2ktiR0b/ZzTlOU0SkrMgLL/7
-3gvRr894nsjk/jAkYnO/u+I8gy8JDaVJRvzIacHrBxh9zyIUmKASfIIksNncu9X95XG6bF2UDiiCJeG+
-N8VZPIUxb/pZBKwIQ8JYw2W02auqKokCzdBJQ/Y4k6VE976ox7aTPJ9AOLxtoEieByq0Fi5GsQIcF2u7
-5RperuhF6rwj/kxUH8CgMBtx4oDivEdSVb03ky42z2jhYnzw/vs4Z5CboFzKMOXq77o8SH/EzvL61cCw
-ldpoSW9akjcjVs2CDRlcltxhSqAZGkEvowXh4UrMRnnfwwjtwTezqpPRIk5xkmxHp/VoofaElnnHaqQq
-GOAytXgumGiwUzUpVdsXp9kvOc3wssX9r1roOeU4+TFOCevMJ6aYTL2/lXdHB3Z5QCFRfwU688VBc7nV
-qnSsO9vMBgE4z4NwRcJztyTB9/Z6uWNjPqm+4NaGi3YsCX8ma+1t8jnZ2rnMH9xsUW+5Xlwx+oO6QWBy
-9gQEltQ6QYC3Fhjw74yyBgOfAPL2GXMgKwfUsJcEORFLrSkHWQ7kgxgCcjKFZ6K9YkVLTmxjC82ubC8a
-6LSnSdI5eWTIzskIJ8notB/dsVqIQydkNYWbnSYrhoHpqjUvEvJjnNY5l2DxE2SZuaLmIhctHk1Dmi7i
-5ROckJzP4BJiNUMftooscrquyZT9G5GoZW+GRvd0WahC/9BS00gIafuvXu0/f94MOa0hEBXYEaxWR+t1
-s6zMeu+g2LH1lfXJglCb/LoLsZyWpDYKWwlVa7vIk9YYg2g4nU7Ro5wsSE7SkICJZTY62AeJMeBshKaP
-vxCNfYOXx0SwdUu0bPlEApXPJddRFMh3D7+4gug0hfLXfoS/OtH9aiJ7jTn5OdNuRV04DUg7agPArEHe
-c9WDXAL5tegAvopZAEeYGVqge/fQQv769AmNcMGp4RsjQfHy3AAVvwRoE2yh6VGA6rcNdJnTIvtuW8Hq
-B58+mXlSa70gW9LugFc4G9QHr3Bm797ytYn7HwXJtz14AcaXzTwusozmfII+tHoaL5c5WUpndPRBtPeD
-+ezTJ+SxYu01umhNeB6HVQn1W0A3QXO56hUg/Kr3Yw2ympRGAf3w0yc44NdmnMYg9n8ocudDEJE83mAe
-b1qi/3SK5jg8RyFdZwUnqIIEToY+1KBB3VGSZtsN4aWBZIa8JS6WpHGwrQsUZZhHs9VBKE4gxCrfWGpS
-0P11DcIm6HCiqr5ZELaRqbEznomZIVavUcNVffQjY9AjZp1KEePy9FSCwW8bKMdLAx/8UrNHc9Ya+HIu
-15lRpnqkChorsVY2/Vu7sPHMXRp2xRWGbP6r2JjL0yl6RrMtArLBBQhUrwxxioAXofkWLRR+RhFNIrjD
-jIGWp7Ykauu/Oa/OZJq6ssNMNcVmgs5dcvYGzWYzNBp1a2aG6ocWSm/nf++67mhRce+N7W3FsO1KgoXe
-JCxmbzEA1VifnJ8Ktu+WWpEcnh8pjsoRAM6R4wuw6m4RTiMkD0orskZxKgZtDk+rWRE0EYIeb43PCVMj
-CUgpX5EcZXhJ5NAiPw5IIBAjcpnJN+MWyzoLVpj5HybIUxR61mgoNfofVOfWRr99DWWzEgmhu75LE9zo
-YVVQjAj0tdo4XaV31yNDfVVXD62wOco25gcrhRF1iSczjwj61XP705IT6rcSKWzSQZZTToWQY+B2HlgM
-aaZ5gnYyFHOhlyPRHu+J4DitVW+MtlqGQihSnbrNHPsi5ppIedy23mVm9PHYTm01jp8luepsYBnG5+4R
-LLd+tX/du6e3tyZt8jG9SBleZ5Da3Sy3h7x9Txxs5DPbzurcrU2cXmtT7miWuc3bm1dlG3DJN6/MIEtT
-SOyXChvyn7nWtNtZQxxpKRAwI2gksI7aPk4mMfa9xOI9JTGqtl0Lqaujdi7wCvrdGsTaKpITRjjch2wP
-vna2FCTcnnY67pNo4KzNNnXggUlnHnbgKuXX5ENBWN+J2gRtM02mDMne4WofL2lTZKw8JzVPleSZSI3F
-kOVF2r0IzgTaFi+2BRCa9XfFDmoTndaKmuVO4tMh8hv4iIqqzxqF24NVLqhtRugCOfP7yFnBeB6nS8u0
-0B84TTmR6E9EEsIJ+hCfnHdkU+nKXuf0bpR0zilNCE4/f0Lp/D0JeQ+dPwNQcE62zN+Mh2ZT+gPpd+vO
-bYvdXFvmml/K54ucsJV88ivJmbTxdzEABWVXpaiXup5OMy8QtruZ17srdmbpUCfOu6tjkm/icDcL8ARp
-LBMkcFgswpWGBvjViBUQj76OU/iDLyG6arMUfyKyEX9+j9cl1FoDxqmAPW1psSPWrEGA33YthhQrVXsT
-NIqTmJMcJ2c0h58XcRKFOI/Ej/qrlPKzuP2o/iQnS3KZiW8lotO60kjRspGTI3iF39McPZ6h+0Iua76M
-U/XSYSutHbdbu/dVswPEZnlGS+Gm6gW5xU4qqWKiRJZ2J4Y4fVpwKkPemy/b6TH9JeHHjRxlY8EwRoLW
-UdsOy1rwnbYUo0sHBGy0kfstOvTHdhKscxsbNhBERnaTDYSXEZyHKzSrlmEgH5mipQB8j2YKOHjPzOue
-wO9Nvph/83WzlaIY5nRugnTMiIHeTBArX4pE79ET9J/HP/8UZDhnxH8/RkdQts5dGzXFaSTzm4kyL1Pu
-Vx2wwmzljyFB30HbcI6Xm+bBUw84zTmJzsSpzAEBGpKzrPGyKdaolmnhxOSdHyypzuq4T+JT1XVSA25b
-mRP0oeYb0W6JEhU1JazudaiNoOBhqGGI6WOoh7PgFBSbavTV7zsz5MHE9Nr2Q7npVUXKBzPkiaXRLlLm
-2asKGY/axYwJC+mbrL0p0xjtVXC2SWtiwq0MXXfLlDomJvNsclWb0JHyWT1bg9GkjmzERkfiv3rysNFa
-PF03n67E01XzaSSeRs2nF+LpRfNpKp6+aj7diqfbkYuXxOw1SdAMTf+v/y7aG/vvLsbioHF3akj/pV2N
-JG/o0znz1w6XE+XXpt3aWDHnOQ65D+v1+4Ri7q9PDk/Hk1q/naxP7p+ell5wVlZT0vB0zt7Q1yTxW7x5
-Ov2JciRE+pADh4jTJaIL0DyC7kHiD9D3NEfkEjQJE/S+YByN7h8cfj1CF3GSoDlBG5zE9bQt2uPFsAOz
-if6loo+UG2SwyOn6J9rKsGrxRGm37vgCZ3CTTKt10u/Wth/Z+77dmai194DWA83kHAjIJQlbmbNFta3R
-Nmo1pkRXTQraGLyO/YQV83XMn5q7invvbu1BtQv0xEZW8FXwN8LFzxSvW+FNLYcWI0HFpI3+Rh4u02lE
-5sVyadPlGkKI0ZiXEcg5I0OwM97CrXmCM7vtGoxwgLLgdt251z4/DdjoocltXE3XG0uWCG1JYISswVy0
-xudE769IbSUTdLEiOUEYZQVboYgSlnrtOxNahIp9rP1QHJtCzNt9cg2nI/g9wOsI/u7iWiQPu+5lUPr5
-iQl8MpLgo9PWLJ56aM8yd15GN/a5tQyodQDOgouYr2hh6XA2QWfBIk6jf4rhtb7/iF5GR9YGoKvxDu6i
-1oHqHiTILNkamGPQMQk23X2saMhlJW83nzeZkSmkNQuQNOqcME+j6A2eDyFJy9F1KbTlI4pagqo0NHQL
-quNOIhnhL1XtFZku8VjTGTuPQjjJVngObksjPA8jsliu4vfnyTql2Yec8WJzcbn9fRSwLIm5PzIPVW2G
-64pkafqsG2tLGrmnwEotEXa7eD9KUqjAxGQUVYcn4jCy9C2Wt0JZKJFJdfNtUPcDZbbox90pW1HG+4lq
-yRl/I/wNXv79u+0r7RlkzEgx8xyzEk6TJwChj23SV82EBdlK422e9qCockBqy1t35IvOoQD9yYkEPHWa
-XPrVD81REudg2DAUDTcZHVDbu1rfJlbeBr1kVZfWfF70RztunSnYj5bbQqAXDXcYl3D0oeZE43B5sXec
-UU1l73XXU3O42bkim/0lqi5D7MzZCKdUtTisJhNU54Wd3jeW1ldeEtHpkNxIpVtM1QtuVT40vvKFEM0Y
-BNznXWN+6k0Y4N7hti+0JsRn0ScNgq7dQndOY+mt1ulQg5eqbgl7Eu2U71x6Ncpyw6rxHPdIOTJkmExF
-InBPHLlv/Mr8aILkPjFkDTcVhh/a7ms4gSiKLhemDU56239OtqIBG5wM7QJbuNYH7Y8o/lhPba/EOY0V
-OUFiA0YxQzi5wFsGGpdFnDMuyrYDNy2a12o3NY2DMKmcZ0pLeXhm7Ex4guZdvYlB1bgCOaQ3Lhjt75Tt
-vfSYne9UyU516MR9QUJDnJBndJ3hnPjzARF5t3m09d6C3CzO7hBpp4QHJq8j7Tn4IosUsiYci/1qqhA9
-kX9ntyiYVCYoAYXu3ZMb6mtrilWn2KWeXKtrh+kMBmkMjO27fpazWtVrx0W7utUyIHkciu4fPWFxGsro
-FJe69/4EjSK8ZSOdmPAmmgY1BVri/W0c93eauGbfV947ei8413tBz9lRHB5AwD5He8gzdTPW08GN5jnM
-aGCJbi5qHmakJ7HtFPWZ8AqLxnxJuM15CgF31MZGt2HRgCsVNaZ+xg4p9TOVWqYO1dzp6+Nr7viZy1v9
-Tha4D4FoZ3f1DwOsi5lljhhHMikOdIgJXWc2aNIu54uW09hAT3+zwo1g6p1QFf1y5lsc9fXHdbn1kBFo
-mIalDu1DR+A7qrb2vDlhW1qMc7KNZLYBw1vHGnEOu4QKO1fZUMCsIB+dk+0zuOV4hg6/6ljIcg65vYxb
-Gj8d+NUdy5rLQNZyLV93SX1w8GFk2Ltq0r5jtlqNXminlQd48spdEXjszdH2HyTOyRb8zLtE3/UJx8vO
-s9Q1ri+U7nnNJssVJqrrF0vRNZQRyve+Va0tRqSPaaxPZOBN77G9L02rW0ng6iUz/EPS4MbuwrEcjsLV
-XTV7jbtPTauNG+ojhDsc6WimK+c1QLtnNJDOzC1rbtMjypt/87U3QXNOsQ/+RtLnN15s/byVQKxdWjq/
-GIZg6QzzBBVpRBZxSiJ0pP1iepEpW2aFTfvJPFH+LuiowtuLrfSXqfBVLjQDMAI3jFPjUuXySqrKn2aM
-nhjeNQGnx9B9PrhrFUliQQmuM06U+NJEiS/7ULbbvY5TbyIo7+2hNb4UkLipoHEmmjA2r0p4tO9Kkrk3
-dnZ56LI7x7g8iEp3q+YM8+7Jr3Dqvet7f4I8kt5Y3+MsJp5nOZIsCZcGjVeEY98uRu5y4CZpSCPy9vXL
-Z3Sd0VSc+BTS27DdiJqNA/UtHz5UerEgoUtfpf1YEs7jdIl0k0G5riw1o0q/sOshxE/p6yJN47S162rH
-6CDEaUgS3/QTt3jb3HEiQmbGDoAQ5ykF3BlRreeZCnRp6kaNobAYVGzGB/vEd9oh4CKeepGT+LQWfz1T
-sdbOE4Emf2+mVob4BRM0HiI11VeFhRj7uNd+aUGj09Xzw0uX+NmsVELWN941zq4T3WrGJOsEFa7IZD0g
-CzPW0BlmWC8hc1zMVFaLPhnJrEXa8n9e+KMvR2P0GO0Put1qUWbVKMOpZ2j05Qg9qV5VXvLoyHS+7zaK
-dKfQd6QgcJJnuvlft95djC7qdGjJXtvW815z1/furePUtQFYRYLmjoR2kQi8e2t82VddTVywVVf6c8Rr
-zNvCoXFqVDntmghMTkXSCJJzGO6RxitrfLrFT9Qo4vAYdermaoTeizS/49q506uSCdlSW7awAOsUu1ED
-DaS2bGLo9hOATeyJQCMEbNe4WaVu2R9jSJGuhJ09g73D7NwDqe0WxIucsCJRiYxxcAyMt/PEOTCprcPP
-jdGcf7eVd5U/HXJnWZnF1X4HgtMV8wLnWgCwGDpNfZvsgZ52GOh+oug1FLFlOrUrOaC5/5QotCzQde9w
-o9I9NTolBnlhJ7IF1Dg7pIqEBVz/KBxDrZW2l1n+hlxyh0+q2unruPtiOlxV7M3Q6K4QLbWz2kl8ivbQ
-SNSN9tAH8f2d6/608qrNOi26l/e7b4m0kYI3S99GzthBwTBzcVswtUyfpi3AAgLZ5WqhTXjO3uaJzYQh
-4IqAFXPGc/9ggopSyPCeePIKhieWKgpghJptVTFOffzI2R6ZDa/444wmFrPIoD7vSGvqQsY4zWx9bbBI
-pbVwzTvzfCMGUh1/GllVxVbC8wLuQJmgB3Ap24DVPuRYVj0TlYBuRqe+KFMlP82yIIpzEkJ+E4+zX2hW
-ZNYrJRSv/mioBmSQyRHyXnhVtA10zlGjU4o8OULezKvIrApwss4SzMkR8h7NC85piuCSl9lozlM05+m+
-khNGwNP2V3ydzGSooXyQJTiEvNez0ZxyTtejx2Q9J9GjqUT32KAuidPzI6N1QO0EkYSsJwhz3nZeEytR
-kTVDd31PfvdkmcZgyYlx9wLzcOUDNrEozN4scqvjCuxUjndoZzsXlxzdexSnWcEhqfhsJB6OEE2fJXF4
-Phup9DZwGcf44QjlBEc0Tbazkf42kqmrZqN7CX+I0Soni9m9DwXlDwW/gDSNyJMP7i35QwEVr5eI5aEF
-LMjS5SxLl3X4KRbfRo8t3El2c5DRjG5I7jvSF9CUk5QfQYt3OgOUEe1XzqXwNCE5/yFmnOb2m0lbK0LP
-5Lcw26cZznmMEzaFvKAriSkQ09dr1e4KZlf1/6uylu8UVqvyrn5seGBUJ5yneY63OsrwnGxbZ4WmDacC
-rVk5batCVH6ysXuo2fmh5TALSKpK29jqOsoM53jNGt5ZkFa24wp2D5+7zgUbuP9JynnePfPk0TxnMI55
-weCgoYjYQ6N7OElmh6NreZWYGkFLzJKcBzL/7hlMX5uPcXP4mjfPbSYIOzPR3YHOx24T0w48L4CLL1hj
-FMTWjFZBO227fOa4b63ZKI3cbNiqQ+yVkuoeOkSPKsLsGnHzswpepJEiVRc7ATynQO3OPnfDqjJ6Zfj1
-KRbIxmQR49qKA9YfxdOOqu7JyYbkjFguLbvqVuM086g0yBj3nML08VUAnykGDZHYNSxdCgFHVzcl/J8o
-eoVV8v2ncjl9T4s0cmft7Hfr6o/hajtt9V+S8QNl/LPbbYwAlypQXvxqgagsowqE1xZO6eo1NyDwHBIJ
-CwbLRi3YBQPbiDUhQuWR10zkFxZ5TlL+9vWPtXYV9eObRpM0U463fC60Y0jTf6u5ZNs+TqbUrz8fqhTA
-6+YbHUyiHHWPaj1/1TQ9d3ryaM8dq1mxioJrhuq1VDRteybHc2+CHDGDcnTtFx3Y3TmnonGmB6L4fXuu
-mmJ6mRPkqiFFaXe7zqQB5Z1gxjQf2xwx69AtyUlKMZyTXJxmpjLXQfRp+yn9tPq0/sQg6cHUoruWl9KK
-clIDvLGPtlbsagLKDCUq38H65P5pkBM4sfke8JBX3nio7620zS4Jf8Lx8nwmxukex8tNj74cxvOGA4oV
-HzhTBpiWrGOVB+q9IgY2lqIHeowsfpVXjswmNft4aQ8/PDjwGnwnK876+ATANH0863xSghg59WpENpjL
-xNLsKgHVEfLwZulN2j0DB36JofVSLqAj5LEt42QdhFkRxFFCLHgq2+mRzt/dBhJc7UjIgpTxBktz6apO
-3VzD0Pm/Z9RpG2ro+6FTDWX/teYjyM2GCt8957pkFgPBycGpvuPJ+4XkIUk5estIUy7Rjv9Z4TIhNKfr
-mqx7pyLAdE9FCVLbZ3rmn547IzV31mQtLwxqJITpnThDJk3Nket2aF3khPzhpN7OlIYGf35T+rCc0iMx
-le2ZGQTtMC3QTEjmkNwokLfzpUWSTJqL5Ln4WT9e1ixYJ4enD9t+fVVlgo3WiewQCVLCz+ZbTljvEjIg
-uxeSCXgDzt6csSnhgUIbbpr5mVDJ53leWNi8kdFWzF6VL09BoyrH7RE6NPXF+uOc9RMUkU0ckiNwkfjX
-sXijjz+PVQG64HUzZZt+Ey9wSI71VBii21HEmGIPU0/iyOrrI1/D6oEzV/mruZYyYzGdRNnJwekERdnJ
-4Sn6En17atfUyBRmgPINXjKtvaUpeMfQgndkA7oFsvYPHXQ5XOCN/g5WmP18kf6S04zkfFtvBcxdq1uF
-RFViOWkXOxVjLR87fJ75WmUv6UE0qF0diqYe9JIG+WJnp2edFkXJ5HyduXnpop+JLnq55+KW2WYUs3Or
-VIKuz9cmt0JUIXbM/wW8dvG/hMku2B/MYZUKgdf52InJ0yofiVPrvfSgFMhp5nACMdiszhAzs81kF8fS
-qCXUDdmKRla0JTs7LhhkPQo34LsaxbWYblfhE9EkgUKMYg/P7sJzU4bdhbuHW9+wiYOYfamJtXL7fr32
-yzSMI5JeQ7ctIHfOAs7C69tV46jST8dR3bh6J26n5arr/UfrgnHECnGWQbFqtcCJmbrlCCyIhJOmj647
-QKPJs+V9lze6rVqiOFOuCs2Y9pamGIet3H82V21llkazDnWgmIQkACvM38nWrsf0prLCJ+A7AW6i2wyu
-bbl3Trbw4Jxsu1TbCcVgY0vilPyCU1K/GnVjMSBCsG4Q0ZT8qO5YvncP3dkETu+oAXtTOSssQUq1qqzX
-fjSd7hLM+E80fZuep/QifTqXcVYvHRtTwAqwzFVClZ6NwbF8Y9mKgjmNtlUJ8csGVSf9ulm/QK96bqFP
-T472fi593PIiIW/zxMfn2pfZ30jFrjurKjgCtSf/zTJ4GH0sxYchHUsCnheMP2U/8HUixY7vaNRcCTdy
-3Nt05/sc7o7XP9Td2UOZuoLekmA/pEmCM1a/CCaetC9LMVHJFOV3Go/ay8bJBaoEtrpwh2G6VVwyDqfR
-2C1l66nNphDmzp7EkQwaulH603J7sUWsmey2vcassJLplt7LT+VPK+iu01nvktB6XUHT/cCu9YbFqi6G
-lj/E2nfxjDK6AXK+NKF+xIxrxmljGLYosxrpfTFmoqzR3UQ5klgEU7h2PTgGzyJIay+p8sQUrT0vJL93
-xqT17Q71zKXWHpYrUaw+Mn6IplP04jKDyxhXBGWwblQGc2VaR2L4rSiH3W1jH2dJhSmH1NfGbTph/P/k
-Xd1v2zgSf7+/gisUsYO4crrAvniTFGly7R1u0Qs2XeCAJCgUibEF25Igysn1Nvu/HzhDiqT4Idlx9gqc
-HtpY4sdw+DUczvxmSDjqsEDb0DULSLMecVUn2ANv9L3BFKHx20twipzoQ4uSNQLFSRgYv6jlbvTV12k3
-1BVsNYwxEXu8P/S4N/K4Htjp8qqnoMsrdzmXV4PiNl1twoezflsjy8CotQkBq5Ybgc3TsdbJKqEf5HTK
-D1kVL22jDDuagKghq6CCrAoour7T2fViEDBRzjWEK+gFuHHYUxETYqlPT6Z16uCoxzFEln+MvaHhAJvl
-MUb8o9iBgBRWUnhozKqtSDw4CJEILGJ62B/NruQxXk5Iw7L7y6ShbQgO4dj4r5EPcASkIFDu+M1FpZ7Y
-GIO2irjlZL6mrEnW1Yw0zJ/sEW/2tJAovOmBYkEDzf91JtkeOcX2GsKAG4CKAHyJY7cZhJiyfgQBn2OX
-FWOjgmhzMOGHHAOHISH4Hc40wuHqC6dsY5l77MNnaysPOPgff8Vrylgy3+qQBxCO896lhwuo2g0Pn/Ha
-37qa3D7P8bw4Q+Ev5yTVC2vNP74k8y1Aws6zDLbZbRqSVW07smoPzfDuifIx9kZYfbBYewFKsmz87qcJ
-GTGalkXGRr6APMTYSpF7WReKL8S4EIj9ACzKpW7/uQfISVDVdh1hIPHLvR16hRr5vBQuvVFwhp57CRgH
-QUR1a064/Py914by+R/BbO7zuHadr3jO79W0Xl5/i2uHUNA8kcQVM0/a/apU8o2t0F9RvUL4aSWSZyrN
-4p9ZicREaA3653YSmuVaXfyXleShrOdUS4S/rWRid1LprO1KBx2Q0Bkd9ngkcY17o3cLK7I70c9ahhUy
-9NYH+lDWVPw4f4Ag+7TI1F8ywSpf55aVPUalbBziNxgWlxtQ8h2bnwQ8gMPtq0jWThgd2IN4/ZwtWOrZ
-qZsi+Wzn+srUoe0RUSUOfc5NUlPWJv9rkXkTwyW4YjMnn0kjbny1H6BM1XtYxYnWn/upQY4JUPORk3aM
-7K10xSFKztTAe3n5NW1u+MByYNR6YWPDcQa5DNAnHTBcuKdzup8oPaK8rt+QfMD+s3zqdc3rlBY6xHGe
-zcjoHDybHebx/JHlzMyVpSifJgQNYrU/jx1+ensh8bcqLdd54XIYCBHZpe+VqbxKWLMThfq/nNoff+on
-8c+Mvdf+BfPiZ+d84QdH56FEbMO6eoY8P5PR6FBEHRtNuthK2kUZbO2uJijJEVzSTjt+WkwUPr0hk+e7
-o6n0pn62VB7meqIF9ZAePW737UeMK2lbemrKLIfdK1+0Z4ZwZQ8YWqiAfrTIHM4tQiCYdSUEOynITzND
-uPJZ8UGbkUuTkUPjwsWjmS452UlQOJp1ZCcNbBUGuZVNyEqzjjBldpNzIe+oOw0xNym+dQRXPu6UlKr9
-aiUs9QrlUPUbRU71W45jj1goze8oIqTi7LCvmhdJMadDQhJmOeMHlouyeMjrtY1HYMqZSWFFyelMeQeS
-bFcJJTc4RpvRBBvz8rOv7yMRSkd0Mh0XZUEPR8IRrNv3JHj8pay5Vlvp60ZrGLxwasSFe9tyaoWBqyCM
-23HMO7AX4ZcWmcoqhvygjHJCqNz6FBlUBEwgDRlazqdBmWG2qczt5BuUmU9NlVdO1EFZcRqrzGIFG5pd
-LFwqvzwWegoYALSsGxvAxO9dKgJT3DlFvOnE6XgI6DRPOpq42ohWdYr8buR6svvSs9dZq5sezWkT7owV
-TWrTPMiyvoTV7ikvsvJJtn48uoCMDYRzxiYCENsuJny7r+dAvbLzmZDfX31J3Ia5AqkhAPMjHc/lAd15
-OJcu1q6w+Zr+zgPxlC4/1SUAnt101G8Crs1UwGkaN/H90ABE6+BBER8cGn+SdAmQaLaYBFEbGf+oU2jL
-mOmCZpsV9ZTCCUyKLENkNQ16jZjwayQEXJUugRgErTLzbI+k1rIDIoily2sBs8KlN2H+tQQE7M+UZoyc
-p8uifFrRbA7gbo5bJMwF9kEXqwQutvDVmyqp+dlZffJlvm/KxMrGX/oyoF2xlQVf+zK5rBA7HHEaI3r9
-1yyDxJA9onxAv2TmPDgQZeGYu8nv4otFvspqWmih08LYmk5gQ29yRf6b+7pMsjRhzTgqi39WtIhs40b9
-+YOf6d2fHSBFXk6v8nTpCBPk9bAhVieNeUo/jx3BhQTbxYyN0wVNl3/P/s3PliouRYBroITlmbTZIrrM
-U/RdLNL7+amjj6APdF7wpk185Ab6BpSpcD0gval3Lcm4tcJ7CJKTk1NefPDKSu8CsFoP9Kf+pGXR5EVf
-eNwwUHp3Eqm+6u2FbfDOfcMI2uquArNsqswBBE5C8wRBsIyJItridZFy3DfqjBly70h2Z2aIZTu0H7ME
-BXEzQ5oUF6uSUW1b8wfPbbN8hJPHFnmS4puW2uG1IZ99dQcvYt5ddXx7DJEi8TzuGS3y6Z9+/qnn4kg4
-0jWnbS6Nm7msAXJsMCItCfVwgP9h0g1ChlhZW6RYI2cnWrbYOdebVZMPdMSSj4YT6rrtkEmSFMOfhNpg
-gYVq41GX0uFNiIs4PkGuHThGd49yBt0MVbUOZwO2L8xxDUfqC7R4t+30h+xtLV/747+HNzjeh6iG77Rm
-F6Z0exKLlILnxNhx8lVv73COQcLvlGNI2xCO+aRbz3vzCMIoL34c4Qx9y+uPIJC6z2VXqXeqpFnIjK5w
-A8SlDIr4vI8m4Bk5OA+yN5pIPmt3Bu7wcSS0IinIzv5NWnnvjaYinwsVn/z/rDSAzX2qubQ6fGa/v9k+
-hOpXm3FCxbOpV72j1LJWDOihYN2SZmF+Lx6XD49T6RQEIkdN7d40OqCl+4anEEh0EzXsU11Fju1eyC7G
-+mzmQp8Xf94Ckb3ktZrezd6DjLie0/MAw30ZdhNx3D7nimrPiDKkH41g/y4WHJnDHNfhN9YKr/DPQQsv
-2B+XSUazD+iB6ZHYhJK3nM9X2xyi2AJsXzR91hBdFhjZKar6D5fIYtGCaIXuxXEcB6KWGY0OCwce9HYB
-BO8au7ZiXn+2uHzVH4hcgfSCja6r4jtgQljOcbDMcru9tz1u9cdx82A0cIBn5QCqRGhL0Z3tKghMmEGQ
-IcMf0ybyxcrFN2UxHqEy01i8aS9g/OkprqL9Yxfn1Avh2qGk/zyx8A2MTuIP3q9EW348q1MwfN9jiwg8
-Hd9Mfv9jfHh3OJ3zTfDd7ebH4+P7rWRCHBFfyk26gFOxuD1yfPQae7rlP8xrBwMIBeF/NKMPSJQIX8X+
-rsLKheOHTc+N/crvCELM9RPhUITnvdJV668HBDPTc4GTu9xEHcXdBKpwk2uPH72IXxGOA0R7dNB/PyJH
-TjLRXMEnNxp08YSHvmIOHupyfSlDEfYUBe4xvOPlJWWkIhZGh+E6vsg4hVvXAeEMI5fnH2j04DTjUcuE
-JgBcRw4d/0LTCMfgJTki0Slfhbvg4cQz9NsjzbYDUA4IjPQotoGvUFTwoKPZxQ2C0xqyM3bT9Dk1BY4J
-n8uOR4jkxdDYXL5zQVHKE8FfBCWBsEjp8lVoSNLlUBJACfsqRKS85MFkQCDrVyRGlT+UpI/Srmn/1KCJ
-1FBCrjb1/HW4UvGSt+BHSl+vhx7a4m2ChniMAUBDuaTFLzlrlOdYH2CDnWMsfMKSjRlliFfwFeyQTsEc
-Sa09EP8NcvFFi//f+caL4ofzTbMwv9xEGV3RhkZ3wXPdV4wwJw/50SXP5DxlYUos9Esi3LC6SJRfFcGK
-gHGEDpycIez9ImEL2F8cy7tdhytsf7OgRY8dQ7dZrqipkKRr60fQgqH3jNOpQPNgROoJtLZ1YAxYpXY6
-lBO0VY/94jsWa30Bp1y9F6LDLhdrypxK0q/wJYaTbCtpaCqX0itVNOUSZMzfmCbhg0ytf/EckHiSK1qv
-c8ZkvOJ2vOsfPpY1FPdrufLpbuRnATevlcPfGgWQ52cyjvjg7NQvJZsjEhHttTPor+Md1omsJ6ek5agv
-pXfgvmxoonMtkrHD0KxoveYLmqlzswfAdPq384t/zOS6zddcgpjAcNstgkXGUn59y5o6qcgiYeQ+yUhS
-5ZCMV2kUy1dJvtZFJ1n+KIKP3kaitNuINMk9RNQ9vY3evruNzm4LmdPIkNR1+XQbnZ1Ms/zRl0iU+laE
-ruTJN6uzTmcAIi+t1zuNTte9PxQ2BDqM7waQ2HnDz1OUFS2AV6ypy2LeJV0mA0kK0k39CRcQiflklZ/x
-mQElH5GKHIncRzw3/xgykBVlTDcrwXj814ltShbh5VGu11iDY5+N3+SFwFu8aXX0Ee+ca1o/5imVmmsJ
-oWQXYWAhcYElbTepUSOTjyRgt3Ihn7kKm3QSnbMZidJGIHh3xBY+cfNUSS9tbUJ42UZq+UyfgJzBQoud
-4c+UWXCFlHgG/G99a4YUi4R9yBtTIXaf205pomfhGznQF2Cx0v9g+El3hxmjDU9mVTMhYDVo7clCcqnU
-1D9Er2uVnX/zOuKgLaK4ckHJp+8ukpcXn/dYZGyH2m2UCATHH5B/yOIfLN9yu47QBjKnjdV5no5zcrSm
-2SalGk/ZZj0hevQHtlmTIzKuZDPekwqbMCPHGLz72E0f+AnSBybGZfwJBwCzBmBlCCU8i77gdxLXvAiZ
-DMrTVy57ssVVXTZl862icVrTkGFbz/RTI118l7y32qNEjQteoyVJakIkeC8YUuREq22QRNkj3uBnbHr2
-RSwGrbSkFNDRdD4hkS59OQSjgCAEQjZ0jhKDFCjfz/29g2cXv6AuhqPjiGO1sLdW12Y20jazkbmZ2SUE
-9rKoEKkj11ZmF7XFThZ1trDP9Al2sAh2sP8GAAD//9vs/L8tPwIA
+H4sIAAAAAAAC/+z9a3fbRpI4Dr9ef4oyxmOAEQVKTpxkRNN+HDu33TiTtZ2ZyU/WekGiSSICARrdpMTY
++u7PqeoG0AC6AVCSZz37X52TmACqq+/V1XUdjUbwKGNzlrFkxmAdiOXEWaUrlgg/9AV3YPT4TgvQYbjJ
+AhGlyeE8zVZBpdBLts4YZ4ngECQQbMQSRHrOkjvbIIPX+Asm4M03yQwRgDeA93cAAIo3BFO8xj+xjLj/
+nPFZFq0JZAKOM65+fpnGDCZwVHv9K2eZBn5F/8+Y2GSqovGdK28wGN8ZjVZMBGEgAgim6UZAADxKFjGD
+DDGnGaxZtoo4j1LZlW8i8YKJoKMzCqr4UGmA+lg2IYhjqo6Pyso4zNMMpinfyHqxo8/ZnHdUnIOZa86/
+llXDK8ZglYYs5qMomUUhroVFCt9uWSLAmwWJK2DKgNHzkmUMpmwWbDiDf38FG844iGUgBtTGHxUCWbi9
+oRVYL2KNif9bEG8YTCBi8mdthr+9XGfyK/6qfXwlArHh8rP8XQN4Ha0UbvxVXzwJ2wbxJhAslDDaC8N6
+qvQkH1kcjqdJkopArdy2sSgBvWAICyb0wQhgAgF8+ADvr2rt/BGbF+A/Hz40d8YLxnmwYASS/zbBvRJB
+Jp4HQkKWTybYb5OwgMx/m+CeZYy6o7ZhUH1hKvFrFhMg/mtsZbrJZqqJ8idC0fY43ER16B9SLgiWfpjw
+/fUiUU2Tvz58gLsLJuD+fRx/eucNzH0LBFuk2U72K3/QIOXiKOfUX2epSMVuzXzOBK63X18/gwk0FwT+
+4cJJ0guYgKS53sDfiJk38CXJ9US0Yt/Rz0HLRCbphXXqim9X486W7tPMj9ZAtc/Kduqb7CXjm7iL2Egg
+L7MSmayNxmQaiamSU0Jb2fKzPtt9Jrd6ozXFdsEf3dvZTNMCE0l7vVurb7t1/dtzFoRxlMjv+UNj0Scz
+FscsVKtePdWgvtvE8TwqwMpHw9jJQeg8iCrnCpJy1vNcIVgv4o1BJqpJ/5qWp/xGP5vrU378NqmXfBqz
+TPwH28nv+ZMJSIMYW4473nrccdNxRycPhwkk7AKeZlmw87S9F83BK4D04cA/ZDI8HOO3EbJRQ3gbqGoI
+fIzvH8HbwI9ZshBLfD44qCPJCQK2/21w+jY6Gze+aw311xu+9LCtVSaADQbVcld3mr/kWNJcd/VYQdm7
+PFVdnqlpkfBj/PAI3s7KPk/tfcbhejs7fTu19VlhLTtdbP++vX21mf7OZvnSlA+1FfAzY+HT2bkEUQ/1
+E29NLLj61c7y8CbLU5KCTZap/SVZrNqrGvjf04xXgLUXNdCfAi6eThM8O2K9RPN9S8Gcs2u+1YlQhUpo
+x96P/BeWhFGyeBan3H76mXeNPt39Ng4toPzIMO0cXMkSoCTTdyewSUI2jxIWIr9yN4fQyO+HDwpvSasH
+phWsiLHIdIJjW4wKeB7EnJkO6Mqg6qcivfg+SzfrDuJdAnp80aDcOLRbBhPgC/XbxvbzhZntr69dvmhd
+u6+iGO/AocKonupcOP82y9JMwqiHsW0D80X+YDkd+EL+tJ8wfGE9YRap+r5Iq8RQjQerL4Fi1JgipdXT
+syg2tpGmZ8soDjOWqOJGSswXBVi/00crsN/5M+s6f3K0JTHWVtysiyBX+JeyoL7S6UXXHV0Cec0FXlJx
+62AucqB+Q1mA7zeQfNE1kgqtcSD5Yo+D/DxJL2IWLmiXtXRbh+x3olfL7Heq80X3sV7ivuYoVNaTXBRm
+qsl7k03u8UWT5S2QYBNLOPV+YLhHPC0uY3xReVPn+IMojpIFESSuoCvvGnzGDA/WkKhkXqD6cty21zTJ
+lUFKGSSLTRxkHbJMBXWYpRvBesLyIIlE9EcX+DRNBRdZsO6A+/3dhmW7DiA85DM+SzPWKZzFW1MOgkuH
+5CJP12uYQD4mqzTcxMxz80/uEE7vAAC4yeIljoQ7lI8E8CxNRJbGMct4/n61mGUs8JPFK+yg+a0v0jQW
+UfE1WbxSA5e/2UR+MGPl91kcradpkIXu8M7ZYHwnb54/S5N5tPBO3Xs0T79k6TYKWeYOwb0XpzOSA1Re
+LoVYay/K7VJFMIRG8SFUCuvbpwHrL8UqfvgiDZlXpRwsCaYxC0+IlxreqTJZ7zZRxr4JODuR3FNJCLTN
+hxN3saSztGz8ZghZnUhVO+RjGQmnHdPlT/rsjtxhDYuIRMxOwH0e8GU+A5XvbLWOA8F+zeITcNdBJqIg
+5qMwB6eRqJWZFctGR/xMZLFr7LJqWyTYilsb+KP82qdxhKizYYSws1Hscp1Z24SXcEZy+n4NQ2Sd7UKk
+3c0iImlvmPrcq1EE290sBOtsV7EuePdK43suNd5/rXXPa8jWLAlZMotYS1MrQL0aq5Xobq4G3NngRRas
+l9aWfi+/9mkiIepsGyHsbNQy5cLaJpK6/y1iF/3ahbg6m4U4qVV12hqnQfjX5BULstmyjbyqhnN5i7O2
+/VX+vU/LFbLOxiuknaMqDz1r257RZ6WD7ddCibGzgRLzbYyvFDtYu/B01r/tElVn2yXK7hUbcZFmO3vL
+6Ab+Qw7Va+FK4O61K+E6m7jeiBbiKQL4NhF927bedO+pXzais01BoXWxj5wG0mteC/juuS1Au5kIJbqw
+8xEFQC9WQkF3cxMKsLOBZA7BW3bGjHEurRNsZ84JOI8IzWEccfH40Uh7cLrrHiXswlr/zySaLtvQ0oSE
+XRwSxsePRuVvcwNqzGoqliy7iHidfc5YGGVsJl6nJ+COzONY4dL9KBEsm7G1QB6GLuAay/+uzjOrm2Tz
+tu8id864cE80rltSTZNwQE0/0VQW4AXt1P3H4YsoidZZOo9ilrlnMAEXbwHu2FhcNUViaYJcVd5cjRtD
+cVW5J2WbBC9J+T2FrkJZmopXs3TNqvegHGYIJUTlslO89e+liaduXs+WQbJgrza0NCoIySZkCDMpQR3C
+OmPbKN1ww30lx0uLDSZ5Gf+erEO+H9tK8WWaiThKzmGiS58bg1JcfbXrq+0KXLnhwmkxptp7v9znnvsN
+faQDEk7de1wNrxqk4gZKP941ZsFFg6hXLNtGBWuhTQwhG6qtom6jQ7j3Tp+oITwtUVRmjX+EGZM4uUjX
+Hq7kwdi8ISVYkMvly4q2dYwoxLurJltV36KPSDZxbJOd5diqyHxkU1j4uiTmMJlo5NyFA9jCAbiSnrfU
+/R5kf+RNXt+AzXYYm3vVGKAoiURleDgTIkoW1nEPtuxbKU+ACeTA/qvy9dhUTJ2rpqJPq5+Mxd9tIib0
+Qv+JL4ygW5ZxqbQqgP8mXxnB0zVLBA+nZcOqePwXwe9phoqtI1Ro1T9GifpoRC6PNkOfX+sfzAO2EUuY
+6BurCqZ98H9MIuGV47kRS4V5WNaIZhtJsGLaK7S449rzL6WB36BtyfzO06R9R6m19++v/vqzz0UWJYto
+vvO2Q1qNQ3AB3NYapiINetXAklkasl9f/vgsXa3TBDX1WNbbDlrxy2LXrWHbijtj797Os3T1dlXBvzLp
+V7NC7h2sly/lYe8Nxg24dwruP1E069ExU4N656+YyKIZTGBV/ZL5KNCNmGJE3g3Gpm5mbV1aBwmLn8UB
+51VKQXpJotTz6NJEVeUXmEwmsE2jEI4G8B7yl+AQ3kNnXKNc/CISs2WO30QOZwFn4MyySESzIHZO8l4o
+1AfghHjMZM7YUnSToIYkMZWMknlqLXcRZEmULEzl8k+2olLZbyrJ5WloLUmysL06GbJ5sImFqYj84li1
+P43JR1MLhhP/vvmNM6X4LRfFOdsNgcqYFgR9oPVQmAmY5jdkMROs2oLTc7Y7azvwWMyZAVcTCUxkA/uP
+wcLYTwvFaGv1VVWkzmdLhnzfd1EsdKu6gpTMM8aXlXrnBGoiJnR2vfND1MDUiUi1IkRYq7FyVEcr1LAb
+6NAaJpIDxBHBC/g6GgWkS3siEU6QlzGQTPlVWqIOBo058tUW0K5JaPY+sE9n3iFFGHUtI5Uc2wqKaMWC
+JAylBhFhzSrE/C/0M8bTeNsYjytDN2inap1gWWZc3n7G0M6CvtexVp/Xp848SoI43jlnnsb6mql4iOZC
+q6hyCSl/oiFj9AeDdA5iySBOFylECXgXUSiWECQhLFm0WIpBDkH3igIO3yTBdhpk1TX8B0zgwcPqwk6z
+aAET+OroqPo+RvwwAfdPX0yDB+Ff3OrnMMjO6evx/OGDv3xZ+7oiHsr90+cPv2TTxkdpacv/gBHVXv06
+XWRBSO2Ezwi0+nkWZbOYiNxpZVhPjx8eDYH+h007q4odTh+2fqUPBEK9NhY2fj6rEYktDmX4uc9ZjIvG
+/RPOiFtdfn6wRrG95/LtovFJiMxz5dy6Q+B/GL/TKpCfy/r5dqGqfRrHnpuxmfCnjQpwF3mnp2VX4JTm
+4YEamLMaPEsEkihzB7AOcw9myIHghXW6cLu60DEC2DgjTHbpDuVqMX/etX5GC7fKpRZPt2Jznh6fjeHK
+WHDXUuqISlnnBPXsfhgFqzQJzROTL8S9pgHRmkc5bLS1cU5w3KcvAA+DUG04vN/Si+OHR409mJe7wC16
+ZP7O4WACLsSE5KJAd9ECdbg3mPr5/1wjeeW6qKFl/lfMvDy4yNJzErlcLCPB3Bagw3wtH+cUq2NXGjFq
+8//5dRZBj73Y0pO8C/7D9u3oHh8d/dltG9C2Wi7tWydfR23bR5J+48DJT3yfATNjU0N22brJtcYaS+9a
+CUtHabQW+PKBX6ylaxCvB1bidZ1l/cCwrJECiCxIeIT1P1e6RGQjHtbYCMWiPks3iah6WFZ5WKuRNP7p
+SA4ODLbNlUomcGxk5dKnZnbZeJ0oGqMVMwkj9art4sC2yxCyC8vNfB6zYhlXwftsg8ZWqKyOIUTaAonG
+Ro64nE/PhFvNsdecdgP0jbbRzbfSvlulNt7SaS3dCK+Y/KFhuRvtmTXGv7Kkgzg2rZ8gjmsyF3qj9BMG
+ebUBT32HQEPGabh9N7bNY6q+FfHhYY+NQ0wFalpgAvc890+F1sUdIH/UGCj8XLNWa8iza/dYVcYd7HEZ
+jebymx8ZxRj4R2h9JfcABWxWvlm0Sk0HCMOasvez2RjOxCva+VGavEQRknc0zFumLJAH5gqvBp06waZs
+tFB/oZgdL9nSAxMm4P7222+/jV68GD1/fvjDDyer1Qnn7vhO7vUvRVUFdLV4AYaaQ9ScsdIIIGNxgGoS
+HJwT3TVmIzYZaomjBP7MnfLGtQ64OAHnz/wwWKTae44vQx1yRW9W+pvmqyW9Wepvmq9CehPqb5qvXtCb
+RH/TfLWjNzv9Tf5KTsAdnJVihWSbGHVQXnA+BBRU4yjli4bu7muWfJMFZOsfnPtRErLLv849570zGBdA
+ZBVtgrrSoUg29HMgfT/Pfb6ZcpHhaivq0IBzLb4OGyULr4DFy8NQq1kruyEXbbmRsX9PXDgoRsOlZthE
+U0UbB3qR+zgytiL5qOVuxYNq0bwjbxcokLIhyaEGFT+lTRaP71yVkyU18f+bpms0AqS8J6MR6bSVFdgT
+OUfLYJ2llzufs2zLMj9MLxIU2PnJjiYEt//kwdHxl4dHXx0eH93Px2Py4PjPnz89+ryxHhTyW1kNVHnP
+FeEgZTt88eLw+XNn0ERFbe6LiiijM+hYJxmjAzU9j5gn9Xx05iBh33F9vbDLdZQxdZWVB1gJAIUgrnC7
+el5jbvFT7oLv0cNCPQzgQGKDz+DBF/AZfHmU/+/46OhIV8mpRsAEnHH+MHHgQGIX6a+vn72Sy2mg+1zU
+RPwalkp8gzCdbehsmNF4wAQYnwVrOTDYSofqUi+VsuKgQHeAjSL3hZFTGeSMBaE2xPqo4vO3/2msSduF
+AUzqjfP5Oo6E545zjWjhMES+UmOI4BHMSteommdU7lo2C051f6iLZRQz8Gb+bBlkT4V3NCCG0IUah09F
+tc2LG7bJAuAqmRU0Q3ZVIjwamOQkm0SNgo5aFlPItWoGBqcaabSgjTzLAs4MQ29Y9o4zhMPjQaW4Fjbj
+vV6PNqGutOg8TBHOrRbneXFr1dXSQ5BNoVVfb8irZXpRmg7y1iaVYId8mV40m1VHtmPc0r46qiHsGNea
+OBrR6XKSE2cugtl5umXZPE4v/Fm6GgWj44cPvvzqq4dfjL7+8osHn39ZGnpJ9Q7Ki9AwomraVetf+YEc
+IvS1rK6+0iAq4tLZTkJZVG2nZ2O7LzCV9HkczZg38FXTCnoyJqaIDrIiKEjOkkrC/TpnSVEaaHRuOjqk
+Eci9m1pttAoHipqJVmGYVbOSk4ZbBkMsZYBVMZjbVN1n6bZERtEwKSF9+cqrSVyUkVL5UmS72mgrEJhA
+INKpJ/H46DdivK3OAlLfs4EVjeuaytH8WwywGr1wlQ8MmXu4wXbhvfOcYLs44Tsu2MqfrTd+FMbsPVrw
+Tz67cobgPFzh//FAfgxfH7kDoxKt5f6tTkXV/1BFGNI7oykZS0B6MgMyGTqjMQfqc7ZJ0KagBUIEU62i
+YEr1ZBT7hbs2sZhXN1z11ynP78HYpArDhH9u2ML8aKMzqJe7L6JVV0EEGRSWfzXpYb/LeD5eecAdhPFl
+CBxuMSgjm5gc9D/l49iO9K2QYWqkxrgSqaYiCXhNYBNwOWF023TY28Vb/eLCyAmGzAFpVRdbAU0D+Xbx
+JEkvaChfoMpnHqdp5iF35ifphTeAUU7MLdURdpiASJ8tg0x4+hgNuiwaq31LNqspy4x9y+n3PM2+DWbL
+Si2teiOdJiXyDuK+t5gpNyqR7sI6fhEstkMQweK8TSCCXcPKFDMCj81SXv0PwUl7NLQ0rjmApuLYMpxY
+mlBsbH+JS6UVV5ZWhL4aRPxnfKcP3tz4Lshad9CVcZMUlKpC2wd3uuwj0syyl+kbTIBVg1b0qFM3mWiR
+/2l2xO02xJyJdkli69mkUfr6gdMsiDTUHVbOmw8fiB3uLIpUtCyanzmmotI4WXpPeWZbxqKzObHIGDcp
+enOaclqzzKpv0KypOwgvbQEOcOkZcJo3/t/kLbfEvQ3iIQhu28a0rskS8vQAKdI2iM8G/fZHQQcVsaD7
+j6Uak4bGTBi66N2t0Lo96Vwnjbu6c0PaZhrfVpomeVp1Zptbjm5oJzTBQ+N3FOucUC3N5jQrpKV9GoWX
+ZzBRNbebaskpl+VamMhztkOxVoWi3CPnB5MqR37x+TKakwkimqDLV+ds94yMmCdw/Hkbl8FEp26SRPTG
+Kw45h/e532iejp/SDScnh5J5y/nkCvtW8RTJPeZ4CS0/4Dg7qIpxGiWTVETzXUNTo76u+OJvQRyF1u9F
+8ESniTosleCGr1vEGwj2gkLCdB51Wku8u5W2UzRRr9agu1hnq6l73rrW/uWN1PT5nS2tI26MBjYN27wO
+Ml5g9mpgAz/gL6I4jjibpUmIopaqp8ZVLRiUnG+DZS8ut3O20xbFuR7hCmzCCw2jaYcqlKclWKuBc/PM
+P2eoyjad8bp9diGdwvrcIVVrvLgbLKhLosVhUjWHrqJt1VtvpqtI9Jl4bUV7g3EbRDHpA8M81Be8tro3
+FMeUlja+vmtacdczudBYl2ZxvDqd6NSoeUSpyKknNcLQBPwPtuMn+sw0QX6mbX1SpVB3Wk48Omtqu0wS
+AENnsJenLsr8yaFUKWJlKOAgDL32Xdl612yIIwqffbr238hyvNg+myzGqDPXM+hOjSbdpDdSn8e9NOI3
+lijW/M8/wVM3CkuCqRs9aIstCjWvuig0r7mGy0HRcxKWFKVvw7Eg0AOjU8y34gUVHULN6dRybXWc/VdX
+R9MK1PMgilkIIoUFE6C1+CISS4jQjEAfFlRDDeU9Wn7BeloWaY/zoG2UBuP2Elqkcc98atinWzrVjP4q
+lTQ3kBSSoiYX/43tLv2tzcgjrN+oJTOJpBRGXrc1GIXmRi1BSXlHI/TzvJJCoPNkt3gVNVdHLT6/5g7r
+f89E7sbawNM4N/TYII1aPmVK0Rho5S1vNQXLD1hymiuBa9EIbnzE2amQVDVqhEiSGYL0DfK7lh4aG91P
+oAd2oZ7lHG4M3u0saVyKp47E6Jy1nlnlW//HWzm+eiyq+jppXVQ95qdtS3jliA56lLIcDR9x6crB6DpD
+K9PUOE77rPNem/NjrfPe/CaliSgDC3Tliighayl9tC96APN6qIlAj1SwKQIUZDIuAb7AkATNmLEEABMJ
+WIvimqOBSYHRAEF4FQj9rsFoLYMJaE81uFnMgoTiKNSjAt/VCpnECWj987cgholu8eGoGzU2yzFcbFUh
+04qod16BdkT5HbdM1w8BLyNCVOaN94w3T/Ojh5XoH3l+3RJ0fu1LG8UJcPtNHcG+iQSH+7VZHxjCdbRE
+lC+pY+tgaZ38Ls0qozWNRMPMBd9hB0h0U+uD/FZrtYlPpnFSC1lXlvxPzIga6npf8z9qplS7yNkb9J6A
+ddn91hnAXfjpDz3Riv6DnrUMeiYHfTKxjroawYxGvPeAlxGDWsf7eyZeKjpsPqvyDVR0vgfSX0sCViLd
+NIzEGjT6/n0oZa/PZWAJbzMw5hTQT4jqoFRM5iq0eAibIfzlaNBiblbB3W/8jL21DeEeqMuTqxtt42xr
+xVyed63Zt94iarXw9fgDGIQkCFdRQkc3ZeeDZcCBXYoskLtvlmYZ4+uUMp2ASJXThpbqkPsaRkr3x7Eo
+rALK6wiLFGZZ8McOgiSEwoIRtEIYWox0PCzgUbwDWAXnsjaMyiSbtciCRICKE6M3goNI07IJb7367h74
+DPW05ejgN9PmXgX8vOlW9dZ7aybVDbxrm0tSSY8RCf2Wp55N50st+TABWW4fHx/8KyqBCWEyRMCrJkvT
+Y1rJkPmFJSmX7z1HC0rnVOPLISvLo9U6ZjDLTclApIBmrRDAo3yjHEbJeiMeq0km3jbfcD/il1Ku2sHn
+WkoR+9rgTQMZtwv/0Q2bLTj8e1Gi0q+cVuLwnVVGzFK6MXrFeHjORi/i5J5RenRQC9JhDfApOkHNhPIu
+KkNtuo9ofAHpwsQR7FI45BQ+cdBx4VAhcACSxWEYcaIwE2cmpChHkRxv4OB3yhJQfsxbVn47TCnLKp84
+72HBhGDZK/p/HgjPeezeuep5yWkVquvBhvcRqLv3hPTEo998tp+QfQh5cZJV3UjonruqlKL34o3ZBjV3
+OKkWeG03WhVpFb96tgFXcatnMzDFTd0GMYcJHKgC5bsPH+Bhm4Y8L1G8UvZPNrPaHwKKraRK6S87Csog
+lmGjbP7+w4f6/V6Vl+5Ib3GzwATcn9KATjn52vd9w+REsT4xUWwbOunLzcK3QZ6tSRaRj+YybBVEcQkq
+Hy3TWPFpK8vU3lumVTDilEkvSBkODOt2T0vmGXu9ZNJqarbM0hUzwryQYf5kWFWj+XWQhM+j+bwpkjFO
++Q8sxs47r5cM1Bc1e8THTBlLYCZBfXiNTNCKBQmHXbqBIGMQJSCDtkE6J97kIosw+CLwdMXShJHKxeUK
+B/fhdQrbiF2AWLL8JXnj0QsXo27C8yiI08WGucTwYE0XURwDZwwC2CTRPGIhhNF8ji1ikCbxDi6CXa4+
+yqIwj/ck5WMUlQ8ijgBUVUCivCjhIkhmRfgodLMEFkYizajiWbreYe1Z0c4oESlEwoffVO+5wIYRJyeE
+lL9hOFESuaUbAWFKXNgy4kOYbgRWk1CHVhsuYMpgy7IdzIKMzTcxJCkd+/koMgiSnWEIHcNWIbL8On2e
+zprWZA7tGOcEHCTWPPdT9NNsMaKQdeSuz/9EYIfaG6eqhnfyrdGNKodsoIjT9Hyz7kYg4Q4FnqkNJKT8
+j+Sx0Y1Kh26gWgWzLO3GQWDcsTnyK6cHzY3EYHg63URxSHHvv8vSFXrPmENlYPFBL2sNrDphF081J1dH
+MC6cJlgUXsIEjqsf8PqShHJPvdswqfJvmhMq1zud9J2qNXVW+tBp7SDW/PAY6V21ULGAWsuZeHpjN+EA
++2Uwaw0v6+FPmoYt+ZH1JnmT5O0CFw6qVR2AC+/fJHXvEfxz/40XmQbfv6cEmCoP4tXVCb4hLCSpuLqC
+NMFXZOhKasmrKxvWaRruYAL//Wj9WNp31lDZyj1aP34dLPiJ9Tttpse2z//2/n2G9AXunQ/h3hZOJiCb
+a6/x3/7tkcgePxLh4/fv751fXT0aiTB/3OaPI5G11cmSsKVLI9nm/7YAXOHkuc3VzoqEQbmHV821C4ro
+fQld/MsCynvVeZM4A38VrLVrVKyFRIl9kUUrb9AMi0IoT+n/uW3yIRyfwUSGd8V/4cAGVUVV6YaE/T2N
+EmwcAEBdnk4rGq2N5T7eby1rzu+mgrZiLhzozbRDXjUmysBBoqU1uzRHwW5nuQhQ9AodgiKWxBXAZ3hF
+yg/8TSKiGIK5YFl+VYSIw2YdBoKFPjzHKxhEwrfH70R0r1NPkcZhZQwHfaxTinbrfTRqWnDIXksC1hzG
+5jLPGExg9F9v+GcyHMCHfLY/6IfjB3nofqDDbvCGH3inby7eHL7x39w7Oxi84Z+9eT9arMYGqY+YLZuv
+8wl7XzfMqxwgBreIxmFhh1HsRAtEhVdogZOMgAlAHX/US1Ju+eySzbxyEgY2tw9lo00lT+ubG6qeEhLo
+gQUojriAiWoroj0zO3PcRUCbTEwhMXmhlANByInccNHH2QPhVEbONiUI1LyY+1hJyREe4RWRd5sBEZjF
+roG+yRjBdZ8H3boWP7foptXNlZBUYjSBxeiz3pUny4AvyYUkt6Neqru565JP5H3E3eFbSo28DcOK6tW9
+aUG3F+3FPxODa1mm5iu+ZjdZZTJVYAXLurbJC4y4GhNnXtY9z5KPcZ60ninGvvbw9roFY01lp44htnKn
+ZEcm454zMVuWgoNeJpkdfpQtRn292yF3qqk1RpdIHNHv1BavUJVu70iEQvEoAnd5NeKWt7g4NGBpdi3A
+PXweC3dv2rd9DMDaN3r91ksr3SSk+onSw1QqfCuh6/XKt6gTrmPTMBZAJhj5zp+ns02DKqlvGKNEMqje
+wOekpfx7Fqwpr6zBolGVShPPmcYbVJX2Ml26F6zX8a4HwdiLqF71M/ojmfouEcGlzWsJ5YvpYhGzH6LF
+Mo9abm8suQ8RQlM3LANLI2roRNEyi7FdtyuKpW9dbWm2HBUoJ+AGMzZCPUzFdFFKV5u+J9sTKKMS7GGC
+mZPvykCTqwzocXcqc0g7/yVbsEtlcPiSLb69XHvOf715wz9DUoYI4ACcN2/4AT6rIEELx7yMUcTjaWiH
+humcBrPzC8znqlJONofgIgvWpmzLoHJ6vGIU9XPL7BiWacz+nmahFSKjnspaWtWzuDaFitygTsZWd1EZ
+E5Zlop1HtU/gj0pjU5nIpldVEQhrwcS3McOf3+x+DKUn+6FL4qqBQvpjIlJM2moxH0fVb5EdgQl+GoVn
+7WsNdcW1rFf19nEmcjg3lpqaqooGeuXMaA1NmP+1B6UzszEmNK1hC7FDMnqgsWRPZtpqd7/J4qGBW74R
+873O0pkKQmHL64G9UiB52IpXuASOzizhK27RkNhg83tLVrxQZsHx8oW4l0H7gomXFTVn++F1t5ne7Xpe
+kGWXRTQ7N3fbfHNV+rS3xPINjLzAHisHjOreggd2LYENipsMtl5F37eE1YGqJ5tWS1fEGVqkCIgS/P2K
+Q4sy2h4ouG362m929rdGXXrRs/GdfVpuYXSuLKug71WsYw2oe9BC5r8z6HOXtruZmdX09tiQnjHMjPHk
+NDE+SOarnrd16488o0XNyMNwQInUiEmkJjwiNWAhAoI1+BEnB2pP+laLtHxxA59qpZ5H/PjbE6mpfvx0
+/Uoqlid1a5RmbSX4I3hwvVqpW6MJURcKkzo2ZvSiWF/BlHv0I0s3SejJomWbB4bxCOGRJWNBU7N51RaD
+AXd1KyvFRK/QDv+3bD/CstWtn2rzZlgSObBlZXRXaTDTalmfRX0yMh2M4Muj9ryc2uWjlueAGF5zAK+6
+IJP+7ZAVFRJCk0RwNIKnAvUpAkQKpPT/b03hN0/T/4YogTQLGS1DzgRs1vBuE83O4ffNag1TJi4YS8oY
+2UESyqr2vcJSofzuSg+my6uunG1y4LqS1pZdd3b+75vV+nWQLZiAiSHJsSkssa6QbUQm1lee1klfMC48
+qdCNzga2Y7uo7neYQIShxMfwe6PK3w8ObAjURD6LU85givHHmYBAABdBJiCdEyZlncUSMoGi4fVbOTfS
+Cr65Gq30bvxu78bNGK+CbOKuynWS+Zp6wz+boIpS1zqOVlLbVrRr3NobwtuT22yukULlSEwS4TKq7fZl
+Lq96CxyKNnUePOsgE9rmqHUm3yBg8rmjomrFIb/+4Eaks9BRENrTo7OhbNvp8ZmtbkxqM9GG29E0P3WR
+gPWOaZg+KqJ7uVaR7SH3YSLPbf69sg4tZ4PsQs3eD1JDTAA+PXmj957/2eBqZBgKAmjpYMNA1axHtmaX
+wS3zVmr+cWufeG/Cg8HIGs2qR2oZLRhBQYy5eNJXznLrspT85plHP2q9L5nURsQSOR335n6JaFpqsVRw
+NYQH5nt4k4JYMgG3V2y+5RWrVUGohapWS4tUoB4KbDXAbeut9AiMbSOUy+lTkf4UJcxbNSlEbxJ6CxIv
+w4C58vqcf0kWJ8RxG2VibUwf7oo+2rZc6Oa6HdFgm3IQBaJSdBusOgxKSnUjKaOH6G4UvfSR+eWliuJ1
+tOqPQt5mSgSlq0XP4tX6S+eLXsULPt8dgkrHUb8BDHojy28DDVz5h/6oyFGh7FXht9BvTCoHhTY2DU+G
+Xug0wVKJS3vZF4EShjVwqPeDfdUOn/xV20RsVKNF2hWOvPOOTk1GdJ2Yqq1CIt2JvGhmdVgGNxcJVERR
+hhs8IbA4cuq38uNeqfqKu7sUQjzScvV04H9wjUT8e4jYrj5hLRrNninxV1lcpLbCIu0oWgyIDYNGeU3l
+iRZ2jADBWFreK3WZkWL+C2kVNU2xrjK0ByjClfJ2GXGRZru8BEmpfpDvWmKFtyl0rOZOZck+7G6HkvTT
+U3iWrFp7OKn9w3j9cVENTLC1JA7aoplXHMyYN/JOh++vvMHZYLRA193jN5sHR0dTt7UaNAfAUw6vAb+Q
+u5xeKUtEthvC1qRn3fphmrDc8RMPm61vHfweMuBiZpoSu2pVxrGmpH/nMAFqcjOoca/8gjpwd57BStV9
+8w3qha6bd/CWTxNjosB+J4jGMmylQUbXebJfQsl/HUK89UunMEmG5aPBrN9Xjl7oHu+LbMPFU/6DWMWS
+yn6ThrvbJHvb26J49U3YvJtedWTgaFB3ixqkx0Aq0H1HssxGEsAE/v3VX3/25Z6L5jtZ6DmFu0QSNAQX
+wDUjyIeUipDwgHdc0wny7/KRtyXmSi8SpMkyekOnNHgap1OlbPkmTqfeaXNZnw3hPdkOngDFthit4yBK
+xphOkTMx2Yj54ddOM8l3sGVPuYf4h7ofRJ7kceQO/DWeaXi9dKTzLFbaEWY/ms979MwidRxhcddkx+ig
+/bZzoje0aWboyJpLMG2QDNAqiLlTj2LeuTX35t7UZQqhaDR/TgEd+Q3iw9EIXjLORGHhgVwWROTGnjGI
+OCQp3c1k1JAnt849qaY63xVhJ3GxUpVaBMl9+BxcaD2WxK1adml4Smntq2AbJYsx/BKzgDP4exDV/alt
+yxLx/NOWJa2ME30+/mfWbm6Lm84hF8W7kpa6yAyGlIQ955l+ydJ1ylnoDFpTDZnmxS7M7p+YyDjfatFh
+4IlwDC+ZMoy1R5ut2odtklAGZ7v9K0qlndfbUHHAeed+MlRn2Qf2baaUb4fqpHO6hFTmOm1z0V2vWrD7
+17vX4qzWGSXz1Onh8KjAZZgUxzhnClK2Tgtx2xrZ6XnAl9M0yMK+wZ26wzfdJExTXLCEeRggQ3gag8aj
+pIqCZZXIQIJltRC0FVi7s6dEpIehlS8dc3R+kz+XoKzHVZRNCXrT/UpLrS0/2fJ6zjPGl161Q75YsqSf
+xEEbbbczIHsd5EpfCyzLbq2eX+k4BpFKXzx5H+aFGq2PE/xNkop1ZhCrxsNUi8IwzZhy25Z79PqrBfbL
+VLZPlLeCFvD/YWKgubTLj341YPVHSzy8DbJ6tIFKulvLR1q6lm+x5ZKrPmPYpyCOkvPKkART/msWN4Jn
+mxy+6m4DYTGJ7q3cJwp0Rv7po0tRm+iVjGFfCmcMLd8307UeVCOwBNRYGxTob2VwUm11VXJyDuHcxg5v
+bXztWgZKcLdBdmgRXJ0PijSThq/bwWDfUKZ6GqxAGMMb5M3qmxF70JPJoizYLfX1TaTdJ6jEWkbGce+3
+pm2jQHC/vvypcqrQyyFIgaVpdeiy1XJPjSwtlxv9ANwRIbbC0VdT5HBpx2hqDP5hYzDGj++SiSKC9Rge
+KgUuSoWLfdB2eZBhCjrFTkQ3ISmykOqYPtIe4qfF9jk/gwlsP8KG4PnxpMHdcM3z/CDT4PbKjMhb50sE
+C97PSFFBWqjdop6I1zZHiEZu4zIv7nbQh0bLojzNhDdQm3YINuWU9YBs3X03SRlVVGE8M0vbsU2Zav9v
+8unDh0aoIzWqCiqLKJrhsE2bV2NoTrdEIc4KDqhc/fmXDx9g6z9n82ATN6KimmZBbxNp+ioNskbgXpe5
+8ikfsHvzPNHl7NbIs7cegusOentMGlJ5m1nA03UxnBnj13fPs7JFDcZSq9GSTqc1wMPtZ5uvo+xmybrY
+savBrV5k2JolIUtmEWu7ylhuL3rbu5j4Om3RKr5R0rk8g3w95My/0FRqsEkasp/kHafEiy+N3nC56AUB
+SoIhw9jAE3CJVXDhBNAu5HWwOHGNR4Q7eiJRWU2W6CscyIpqTNRVXYCGgeTDKGOU9tpzBS8W2e57omW0
+xsx3Y9tleJbG6t5YS7gRiWgWxCfg/in8y8PPv5i7Vbm8EpDi9/lREH7Bat9Vfgb8/nA6Owrr3xNk+Qj9
+w9n064ezxueE4ce//OUvbougsdpomukTAzmjpXyCx3tTuYAXX/pUkyzdaQKVw6n2KovZagiBEJmRcKt4
+OhdkmK6Om6EKnWW4ABXoJYRHBWzUmS7jBEGekfTL/zkN7UcIdHg7mb2AsIs+etztvIHdCP8iCsUSJhKa
+Htqgl0yF7SEzx1Vw6X1xdDRUT1HiocRqCFqXctP8z+Dzo0ELYpKBG0I16jC40zhMKuir8VGTIURtg0i1
+nGLCOTwOo3HHaBf5b9ilYEnovb8aQmJzGGjpHK5BbLhcF/634UIya6dn9QCvrHsJwHvg6SabsRPVHea/
+ouezIQhyRCo/SMeks6HSuiuCeLV/D/iWTAc+V5aeHq6W06OzgbWtfrBGAue5fLtw28CEyDyX1p07lIux
+E1ouQneoVqOl2Xy7KBoRsjl3B8XjKsjOG7luDRVFaDruFsfy7jDIsvSiuxxGl/8mvcTCR3D4EI6P4Pio
+u1jG5v9wh3D8dSek7MDf1ah92RP+h3zcugukWcQSBMUEKqnbY56Rz+ruIg3oi6Ph4cOfjo+GRz8dDR92
+F5pHMTJh7p++/PJLt2WRztNsxuQyjYNduhE+vfFaKiCSQswEb4Gi/evR/1ugePQH805pCedL86wD6fNI
+xv/3vj5qgUQDmQXzDj8/aoMiJ1yvgwrBhPZF7hMee66vLW+EaJsO5Cc7h4ElgmVeudniKGHdc0xpbmq7
+ras5siQXWXpeTQ0TamGyQ180mcA/ff75V8H0K2IDaVWN4aqzIrmJDllCixjz3f+pThoGbcszIb1Q2/gj
+ROf4dy3W+vgvrjf4XW3hYhczz51tMp6i5sldp2RG31YmTbCuaHbemC1rmT0DG+Z/pfAK50kiweXkhYMW
+/+0r69nesjmDOPaIzvhhFiwsGOiSkM+IiETcOrZocOP1HCCSqMEEQj8PlC51naHKBIBvwJNvfkxmUcgS
+IV+ice/AbfdmD/1XmzXFVmfhN7uuUZex34fAizIw3eWt0fBcx2c/F93tx7tUxn0WZbO4By3KWohJ80Z5
+fAQn8FUfAqLOMTNqeZs7zaeNpGrqnYtXKvesTxUFMUQS93lf4nmIIscAXWpbeq5PINLQz4cPkH6SjXy/
+8cdl3YNFQL7p+PNuOGys63/+kK160Kl5mohD+r3X5LrysksHRSSCOJp1HBWGrVvip5Sh1rGSRARppKiT
+yLaNh2RNDcnlcUvn5KXBv2xtvza+ux7Idn2RXT5oQSZvLXu0rAeynX2cy3VJ2EQWJBzVWFakEiTGi72k
+ZZdIP4fy9w5/D9xxy+Ex3jccx35yzIZsSVm2G1dQQ/qSezj8mqFIZ7QOMhEFMR8pebW/FKtYk73sL05R
+Ton8Fcsi1uFJpBN6adGJMBPckinZ3tc1C+Nm8u0rq8wNhXcbmXK+5+AYxVIzDQ2Kn+qIK8CoZTOIr5Ys
+CFlWFV5dDTsnRa/65jMTzRWUVWeYR2nQvGu0AmOL2Aw//oIqXpiAc590+o7Fayd30nF+++233w5fvDh8
+/txBhx1w7iOW7nI//HCyWjntCnrloSHSoPfqM9SJ5ZvmD7YVWFQzXxWh3MoaNcdlNbRhATMEdxXFccTZ
+LE3QDmdcFJmP7+TzVrgZb6WPcc2/OCeHmKFODZXL+Sk/y9Fd3bGBhafh2XJ5ujxbrU5XZ0Whq0qX0MOq
+2p1ymXjbge66La8+F+XnxtcVV2ORpBfSh3ulfQ0WqWb6J2WFifYGR0NheFwzmlVF8V9X73bNmVrhixJw
+DYNTOiaXrDSWOKhMrWwBgmCl8kjQx4xCrJsXwjoQgmXYhBEFyPHCD7sPyYflh9UHPvAOg0U6eDIaVwZa
+FZGBqbYDbSAMi6C+wmS090TI8CdDWJ0+OCtcN11KwfrCHZjWSR3TEa0LA7F1BMcV4vQistc9UpR0niB8
+WWGnYrt1pdYBVV6ScrmbXZLzBnybkP+qjVdr7HlFSu+xbRA3kAyqG8GITIsQqa1EWofmUnmGKwd5TRmG
+1RjY0HwTK/uaYPicc1tHSZNAfDD+76bBhbCnLIZJGdpcWtCq6OaeG9hkLiz21Qw2U1JpMMuM4Zy4qIw9
+GY0uLi7oAAuSEE8uTP88ukizOJzF6ewcHW22LBMspOP3ScTTiduO+mBSkhAXj7kXL54/f/3DD6uVO+gs
+6d5fH0+OLDXk2ol5mn2LBhXl6asa38eMxlwpZQhCu5oHpTXgdr+rNy0DHCSPxXvZDHZxcoq4/JpEl/90
+AoOV7k1k8vgfe9Ca1f/Rmv+jNf9Haz4FWvMqSmb/XE6Garw9VqbcIysKSPUz5pAZjG8wJmkai2j9scak
+ULqrXZfrmn1Vr/ceiFfFjyfo8S1EunL26oIr+Otg6n6kDkgjV2VT0cj2RtOhfIt0zf9W2Awf2Vb4M5HF
+/8F2Nzd5HI1kDvWIA0+BL6O5OCSVEcyCBKYMZsFmsRQgUsg2CQQyJ/nFkiVAg4YFUefBQoq5ZcJfZDJf
+1x199R5VPKXQURdfUntupZ/8IhKzZaUqG9JZwBn85QRbHkztRGsr/HVGXl7K1tVrETDiGtjCBEQgTXXb
+IUl3K6GlajBKk1f4zl4sRwwT2GpRXggTCVDeUHbq4pv80IqvWnWtOd9StGTZ0IN6LLQ+U1SM9PHnONS0
+5lp1T80W3W006ePFiP7EppuKbabG2W4vFQcc6+KbqY8/f8ydjTFyeftMVsKTY1kZoZwwSauu+/dhdApv
+xNlIxu7mmykGIJdxy1snpr3NFHEK61FdxcqHEMEhNWNwk12R4K644B9xaxB+NUK3yYu4gmeMR3+gCX+/
+oytjXGTRTJyA+1STFJul2kEcYxK2E3DvU0Da6A9mlE3XzsN1ygVy6D3ORfzkF12obyf6itovgmBcpFUr
+a7YVQ9hE1nD4MmKF6oXVd6cC5Q1ueX5eY8depZQx89TN4zdXzXnVS4Og7v0tGK7uETP6HnFWA58S3KM3
+DMu8FkOMNBM/RVycgOkqWXR80F8H1tMtGfCyxE9GowXmeV5EYrmZ0k1pFe+S2XIUhl8cfTX9y+csfPD1
+1+EXf/nLV199bZwetGSjHJ23MDmWnWWat8I7QPGzN501hQZzsRkzjvYfW8sajlZobm8mMcTa8nD6Hd0x
+pa0dtl1dOukK4vz5t9GfV6M/h4d//kce7qAmB0dVKreLqhELH6hYuF5FEi2j3GeLKKkYvot0fQLHR+VM
+ZGh+V30lLwon8Ln2LmZzcQIPHh7d0Qbn1u51mGAuMRg159F14jhY17LiRkOwhTqs4T2NzmACd6tvxi20
+sRlg8f59WRn+qOJpp58NTGV4xk6COrbff91KTFCbvX3d1l6B286Fuy3f97tRkHQoEUqLHX7uq4eiBZZM
+4wqsI4V4/3bk+MiBsVw5wRCm7cghwJuQj6ZxMUPFZpAxb4rvepCQcrbKMVC/zBLNwkMxb2/V0n2rGVhs
+FdNsrBRxnbNdX0wYb9OKZxpkhQfDQ8qeJFtp5db0AoWTQ/FyCF88HPQpFFzqhY4fWprHt4sf8oKVhsFn
+GtIDRQB9ka7LB0ndzHiL1pQVHOpIDvsg4dvF33v5iuheJUWhogqkuOUTkWkzistXuEy1I4bjsxRy+RlG
+MvJOj5TLwJmlGZdPLyO1Wckd4DLins2nE7F7slILiDSK91w5Sq5N1GZ1udnbkaKHE0XNgSIf71bgwn+i
+WA4d1XdUrltQ1Wyl9EnPbaYqq9c1mkzrvhsdlRe2y5eA0wsiPsR/r9XiI2pfsc3MbZNLxA/TVRAl3qmx
+mvBzIhRyD1tt1SRQ6KuA1zrcTIMrWaaZjGxL9A2uBkNr3cFlj7qDy/3qztVF9upt2zBmC5aE11j3YbTt
+OfsiPpS1uJY2IAl5WzRE/rh25SRNlyOMfLgaRPz52mwYVURmvt0m4LmIo2jeQWqoXf/Sb9sStP4j0udZ
+IMjGnWippWvooaLOF33fNoHraiR1og+bkb6tfnxUV8WDYhpkvNNtgtBKPiOfr24nim63J7w49XewsNmX
+xoe6yX4fQ+9LKzZJn7xy54ZyUQ76oEWuO9K5jf7OeP2L5IdVTw8He78KBTYctnR7b5cOFEKt0g1nq3TL
+fBzp4untZe9yu/491CmD3NhF7PhrNt/gZTOE37v8SKIQJuBSDAyycsMzkFbm7+MOBx15b3Rl+h8setaZ
+O1svGIXd8C0X2bZ+QfetNRxcR0FQ8UxqwYCZ/PbK45bPBsXq8Zw/OTiebW2E4qbZhRYAYJYmPI1xOBae
+m6S44cMhsMG4s2SXMqV9vIBEji5q6YeA4dLdQZG5ce0xP53POUPrUJGur+Wj1e8O2zw+4mDKYuvpSIcH
+nrODO3ufFH08X3KOlF2KwyCZLaUzHTEydzro/9HgTpcfjXvo2/1jqo40DzoBd3WCEmnHjofUwn840A8P
+64nT6iYz7jlvnK1bJ03yZzeZtpbDvfeQHPcckdppenzD2e/hUd9xzrVEudDAvDYTtEuY5NeliEyZPLx3
+YGFPLCM+MLOuoNwm3rZwyOUiKqXR3uVgD1cbqCghK7odMrVfiV+TSFCgMhf3x7m0ix6C+z3+7zX+7xf8
+37fumWbun8xXwuNDWG1igY6Q8zkaDKZrUYiI8TdM5D9awC6sNCHT7oyz7+I0EEXANUqOz38OfvYSyqWm
+XGO4dIxRoc4N0nSuC84RCdYp04mXKyLJhVT03ku0Ou8mgwZK6hA6xh0BnvPq+QTcI9fQWIxvEvHvoiQS
+zEsGDXTuoWbWH+iZz/V2BGjYf1wPf5NsVlOW5WXmcZpm0isCD7ZgACMonnAy9LURwEgVW6cXnpwqDYvE
+PKjFT81XxKn83BCRq6GYQB2wGKbGciu6jt0IfJF+F12y0HtY6fsjOGaHDyvTq6BVyseGfiRhC5hAAo/g
+CGfqkLwX3YpuA0EOwDvIBlrrNFN+Gd3Ic3E5t+mayw8mdU6+GWRUGNxG74tQXTU1al7hdCcYv40aH3wx
+BPcbrBJoZZ8Qewmd9Ufi9qqf9q5e08AxNHGIZqikvAMAoGkqq6q0j6qtbFNW5mkGI3RKKwTWxtcfPoCm
+ryT/W1+dhEZBgtT9t0b9zP8seC0tGe+JwyHPM+0zcao/5KJBZ31pSM9wZZYIo0ozTTwnStYbgYxMskCD
+UtlXU3zvXBssIfCo71Ln3rHrc78JMqnwvoiSML3AIwuX6Xd5yHBt7iXEEClYM769RfMKufb1wVF1ZSkN
+bP11roWtvVaK2KOjm4cMQ+bPEjFMTu9HihlW1+AcyGW4LHbC8cOjf4J+pkUh061okToWvCEHmU2FsWvA
+p1mIgf9sBbALH0Hn0V+NUeGI3eOjoz+7reoZka47FSKmlEgfTR/y0XRZjkjXjm2e961w16dC7Lpj1S3n
+lgC4h9sC7tGShknDVlyRsC783aeLIjkXdjXnVU7Bh3S0GyAu/Gkkr5EI1iugQ5dQ6artWibr8VrjDpZp
+52432GBv7XDekqLAo5Y0TNdri77JJrmqq+y4PXYExgYtNe5f22M63FC9rQasOCnKtpW1Hz80l7vxWQGl
+DrOHFh0AYKdBv0Rm9psgCTmVU2HOhuAf2wojFakSiJZ4fR+JflbxN5TlXQX6BRwU6dp+MlnmgQYHw71w
+j1oFI/jyyAIcZsGF18vFrW4itW0lCTkNjfhTDAGE0BTRWy3Fya3vz5xO7dUV2fv/CdK2qyj8tb1aNUNq
+khKkH4PWDZgbERwN9yFTf8uNpc5aVq4mKV0o9a+fkdDORpVHo3+QCUVXNE1n4XSp+BxSeDpDcKRVhtMV
+QM2mXL5J1bt+VROBagEqB5KSljqdsaYcTZaP7WBJ6LS41k5l1qDqnDmo1HYGUpCtLWwzGkTRW2Xt4N1w
+j2GkhnRB7xzbcpW7x5M7oo8O2pHU0xlWzh08crzBNVXLNRWy2kGDcY/YTNDwVdnHllqL7f2Rb9/H5tv3
+seX2/bnx+v31x719B0mSanGT2u/nzY8LlrAsEGlm+T7NNnxJ/jkIMCV/HBvYtyiRcydTCuZpsLBFd4Zv
+EPAE3P+fAWIVXFpasYoSyxcZSi76g3UOTztAnnHWAoUa9ae1kW4TduSxpk7AfRRGW6CNP3Gy9MJ5/GgU
+RtvHxkTuNViYpfFhvDg8ftCzlKygE7VC+2XvtvQrID/tK/UZwj2MwIUp3S3yH9JSYriAhsDDny2jOMxY
+4lnUXrmRWmfp43Yzu6cJZbYIooS0IyZvah3bg3ZsjdY0K+nsWWm5UVvBbbrDIEn2rNs4Lld22/Qfw0uY
+wHFbe4sta29pgehB38r/FWWEO6uMML9E5sbt1rXdZs99Y4lXt2n2R5NouXh2Wm0r6WqnpUfI75AwggdH
+g5ZSSqddMgOWXRolbGx1flfpYPKDs9UB3g0yFrgnbXFGmTZ2GQvarJ6mGQvOzZ9D6U7dtyZ88nrvbDrd
+y8L0aJvjyw4RaeK5VB6NG/FfFnZBEjtRnNPfqEJtUvHiwPiXFob/c70DsG2Y0aFmcCyTPLS3dRZH61/s
+mQoqeR8Q1r2xhVC37KjFgLoNsYq3fkghCsgGPojjLmv5aH1IiRryAPb45pY9MD6e58V12rRTbTIPO44F
+z3dhu3anAdJzSCQFuUWuqF9zr+4YxZM9lq0KmJ2ug1kkdp2GZt2maN046nukD+XSaMfgzm1kKKjYTe9w
+1bxOF4vYpn26jNMZTAqOveqyUZehFJcSAzJElLd1jnZf2FTJU4ztR9xrFW1sz+oLuHUTisRWJDdwuvx4
+yruBGTD4KYdUbLyF6mF8e6MiOecqG8UAANw/sS+Og+OZO7R8/vyrr9j0a+vnL8Jg/kVg/fyXr79gwefW
+z/P5V/OjI+vn4MuHXz6w1z3/6uvj6dxeN/25/V2rArwM/d8o3nAUySb1EiZw1PJ9Z/+exmFL6WW6pav4
+dc4vKttBqpucACWMaC8URnwdB7sSuqXtv2AFMJEPOk960ppSQ0un8bAN/Us2M2Pv5q7y1FAXy0i090ER
+zGYlbbb5leQRUofvHj9YX9pqooAc15xpKnvNmW62hrDVcjBZLsV41P0/lqXmNPegK+G0Y9Gqi9Px3S0e
+bqrSJJ4/C1CyWZwt3eYUDR6rlkaz/qfOLRlK0nU7ImsFheq+xG4vMRrJuIBTEoLkNeUzRO8NZzK+loUO
+MRw8SzgLW9QxLVU4YbR1OnqUUZx2QlAtVm8XCm9bcGXpRXt5xZE8cAbSit95ljEav185y24D8/FRjjrw
+ddztqP+HOv/Xi+T2e01IP8nu/prFt9bZfP8Ebp/UMc4lCfkx+KwzhMD/NYuL8aLfaKZLdwCnt/MmAIBU
+3fmYUUtiGoLzdhoHyblzDVe2/9nZeRYItkiz3a3vQoX3k+z0DykXt91hxPlJdvYF4zxYsNvur0Jr88+E
+0aiPwXx+0Ben/FtfLLNUiJjpiX5zxcyP4WWbvASNDnhxYa25D2bNPOv6X934YgjXy+tFbegfUcAe88HE
+Nea9aK36MhJtNbebBZGwI2p48skrk6UMyTRoWbivZQIkmS4FH7zLqC0RtMCaIsyKT8ADGJH7kL3AKkow
+jSpMpP6qECO2lsAZG+IPujy3gv6D4H5rGWD7yLKgYmwcdjvgX8IEppj7R3ih/5xWXRsRIW+78BId5CR4
+R5SxohxVVCkCh22xYK9a280qalry7WxnNNeiqP40Ci/P2nu4Fl39KZK5UWrNIazFqU4izgbjjuK0WOs5
+NAvlm7cWqF7uwCI7Vjq9Yqmjszz7Q5+yO5jkBlN79kCWD3N3SP4uE17h4YiNOlRCjiGF2W/FBQCgld3l
+ZXdYtkccAmzHo3xfds0cAOSgMMEujPuA/4NgL3vB/kawu16wNP/NZKo0/b0Q5MI4Em2qBTW4bsiEq71j
+f+Qyb6y9PdVo0TbruUWikq6DqE27h9NUqPVwHtrMs0tBUyF1kbKdfFDbyqFwp8NPXE1u99j1q5aoNX+p
+bDKoq48hN+F+0FbmGzJRkIV+g8eaPcC1O1hqYvImPYHDh3ACD/sF/Mnb9AQOv4YTOO4uVg1XUdZKgSvg
+BFxpfdedDbvoHaVib+NBplOYUCnkDb75Jr302lYEyhT7DNh06iNtPO41UNOpv+sFXAZFmvqFUvNBb+vV
+6dTPeZkHbVwZTBRRt7vZXObLso0OW2TXdhIkpYt5fLF+Gd4pOe1lN9iDXmC7Y7tWEWqpYlu9NnCQ2KVg
+iXglg8S3c2gcJqCBt/MtEhDDw9+dQM9KAAA4ZcaBQzp7CiTjPmW8sshzTAClMhHuf/YUWkZJPm0M3ZXJ
+L+7hUYcD3rM0VrHzPfdU3q004+Bh3Yb1rMsVsAZfkQ3jt1b5cMt3AMCGqcvvNuLRNIojgdbu8iluv0Xv
+7Wtiq2wZhSFLbHV1i62v/s+FsmSfru9C+Sl5Od6CD+J+boCXmiffZZsnH3ljKflAS5cLpWt+1h3v6XC6
+nzsfmocZgyP9U13zurXF8vYvL9Tyd5rZ3MwwuRRcDWjG27A97YVOZRKjMJSZwCCKA3+TRMho2Sv5xH0N
+w881oZ/jX0qLUGcgfb/owZ/FZMncto33sdOCphOGVwY71N4O/hc7Te5hQw+ddvT2apohybwuDm6dO4WX
+ccjG3TSKRDkdcDuYdEkKSsm2l3d5r1Vg9z4lJirq6vwquCxd4gnN8zbJNvalVU1VoKg3pyPaKABA6K/R
+OBsrgRG1jCRlSBCObikAJChrcn/ntXsnnuazcTYYt2O69Np9C3WJnxUT3d6k8y9M4NQ+vDJEeA8dRB5L
+vD6dejDvmTou8D/VxmFr1f38kfNQ4rdS9VkLS4HnZtuiUueqGljrPrzq4ZGtcLRM325Fc3fbM3RcDFNb
+1fouvrUZKrZAdwtUbmGPWnJIYzGAETw8apm9kPLj22dP4dyLCcSK4XBCZccWiOASDtogsHGFmVJbAwkQ
+K3zczqQUDbMKVaA982DMmaosuIRHfSoLLq9T2ZV9hZW0CXsypCpatmbJ1v1MMQ/VosRl0dZ4VQ2SzMIV
+bhXtzWfYag8ue9VerHutEcHl9SNE7FppRzRvuGUBhvEk76u25spz7Ej5pHlHg8G+16V++QCgV04A6B26
+oah1d5u17voFjGiLvAymmBEUDLo73kGh9pAGGngr8A7/cjToFynhsNN5QSuASe4PvVJL0BkEwQlJZHTM
+Vm2tIaneW7zfvbPHNKmmVsKophTTxP89jRLPGYNzq5cmZYH4YyhTpmEq4VIqCD+GcPhYfu/C8G0S4u21
+REOlsLj60sUjv0wv2glqJTXqkcqLWjMFLbJKUTbULoU8KvI777/5H/XntFHhaXTm/xietTddw6FGQ9Lf
+JrajM19BjPtElRdRsmE3iQ5fDGqmhp9+PJrkM4ISInzVPZr5iBIivXyfgr0GOEsvxn0x5cOcpRfmgY72
+GGgAKPozabPY6OmN2396tEGt9OiRuUelEOl/xZjfyhBeXdO+JqjoNlBJoFuylV+7jGA1i7aKvsVm2PZj
+2H7914ldl4Eb6DGI0PEicPoBd+XYKU8+F5UmcTSVfov9s22Up1VdQteHFsvBKvRBN6GAOS6l7mkTgvSx
+drZHZZJ7LaSN9Rl4OXvxGfhHDwdS7dyzjiJaUwVFn5IF01WuIqdXQS6y9JxZ+5a7xHnYvd79kEgPldur
+M8RcFn3KobnILTcFUWoNOfKP9+kBKSucIfQrdOl0BMsyKgUoB6NUDejCrj4V5s3bw7q+SJn/mgweerWn
+21qOJWEFnzoNroltFsSzXDWoRg4r0DJTyR502IKNRvAz27IMMpaELINpesk4XERiCTHjHMQySOBrWEeX
+LOYQZAzEku3oB0o4otkmFiBSIB+GTppXNvoRfL0Hrfv6FmhcUff1iRw6a5DgnY6eypoKkqQP0b97Q6p/
+k3GI5r2aCYXUvySTkgcYd5arOMx5g5u0tm+2qz5z1nSw+dTnqm4j4f5plYZB/GqZXqB3ri+yaLFgWR4/
+4Jo+PxVuimz2O0zz7QK8dxumcjRTiItqvqsOc61bcnvYMwYR9I1DBAB593rxnKCzkmt77JfmWeXKE7W/
+HW1fvIX3RsdUWExTb2TV2/M+0xmk6X/fNOyzx4qe38i1RmHpMmIMrV3Hhiu9Tq/0nrq1drKJ4xvLYlnA
+mTQADTJ3cANjcWVR5EkdX8E0lbq8waDVfFxPD0cSbhV3p0OMTFC9gv/2zPTaK5QNVLKvUYSHvqehjF/Q
+jOEwjVP7wdMr2SllGLphK2zRCGCfjKt6Hrq9Zht8afjqdhv5FyQFI5HM3X5eAYdlTCTXP37wsE89y2DN
+DiUzj1naMKxYFvH1t+HC7rXXU4/dz5akK5xxzjG0GjWXAEY75PLzc6OatVhUKoxea9JAizxOuZhR5Auf
+p5tsxr7F3y1W3T5fRnPxH2x3u6ZNZW9hInuk1p2NzGtDi50IBMM0YfKtPQViMd7NMsftZZ5LTfp8JZ5v
+MuIn81t8Wd7H62Lt9dHZYDC4yVqDiixNi6IM9+/DdYzhJaIysHNf43utnBzAHkb0fW44V/sY39XCQPax
+w9tDWXbNdXtbm+vuJ7O7DJfjhF1AeUfsW7CUKJVioXJnKLHQXIZlxbhrMkJrX+SlTrK5F6+DulioKDN6
+vYw4xOmCQ5Dnd6YsocCyLM2GMN0ICGKewkWanXPwfUjD0L/zca66ZqPn1XwlYALub7/99tvoxYvR8+eH
+P/xwslqdcO62nBg55Qs7vAxyMV5tMLHWPZLCNiL6Y6BpTPzHMu7Pit+e+y2O6zORxTK4P00JHu73lkKs
+6UeczqRKBh+ydCOqFxhZZAhUYAgF+BAksN7de2X+8ihZNBKlEwp0ivPcUbCORjTndTsLn29mM8Z5zWS0
+PqqqKokCJnBa4z3eylI4vN9WfdtZlg3JHd40USzLfOVaiyBjI8CrzcqsuaaPmCe+0fWyWQhDk8JNjcML
+im0B5VUfTKSJzbN0YyN89P27KOMUm6DYyrTkqt/aLEh/CqzlfwqsxU33+cpsSWtaltU9VvWCNR5cltxj
+ScAEHBplmDMxW+JqlPkeHDigX3pVp84cwxDGO+es6i3UXNAy7lilqQqGqEzFn4sWGp1U9Zaq40uk61+y
+dB0sGtT/qoFepCKIf4oSxlvjiSkiUx1vZd3Rgl1eUFjYXUEe+eKovt0qVVr2XR1ZwQBnGB+fzc7tnIQ4
+OOikjoOxaSyEsePYjwUTz2StnV3GvPpGKvORu431FvvF5qPfaxgQk3UkyLGkMggI3thgRL/XKa8R8CEh
+b94xe5JyAFBniZ8x3GpegzA0UfchCHai8Az7izuaHrmJLDQokmHHsyB7Gseti4eAvFMniGPnrBvdK7UR
++y7IcgnXB01WTBPTVmu2idlPUVKlXEjih2BYuVjzJsMeO6NZmsyjxZMgZpmY4PjlK3TcKDLP0lWFp+w+
+iLCWgwk49/OyVEX+kHNNDjJphy9eHD5/7rQhwArMCJbLk9XKGTTbLFJLiy1HX1GfLEi1ibRSV4/GirRo
+qki7G6r29iaLx0bWcDQawaOMzVnGkhkjFcvEOTokjtEX3IHR4zvY2dfB4hUTMAGDt2zxRgIV76/0bOPy
+2/jOFXmnKZR/60b4Nyu6v+nIXgaC/XWdmxW14dQgzag1AL0GmeeqA7kE8ireAeiX5dMVZgJzFB/M5dOH
+D+AEG5E64xposDjXQPEJQetg87w9ClA9m0AXWbpZf7MrYfMXHz7ocVIroyB70hyAF8G61xi8CNbm4S0+
+67j/c8OyXQdegvFkN19t1us0E0N41xjpYLHI2EIao8M77O87/d2HD+DyzcqtDdGKYVr5soR6Rug6aCZ3
+vQKkp+o4ViDLRakVyF9++EAX/MqK089/KnL3nY8i122Aqdjq9HY0gmkwOwdM5rQRDEpIomTw7k5D3FE0
+rY6raLeGZALuItgsmGvLHAe6m0e91/4MbyAs61mTgu6uqxc2bIcV1dWdFoRNZGrutHe4MnD3umMDTolA
+m/SQG5dSyIW8PRVg9GwCFcFCw0dPavXklLW65adyn2llyleqoLYTK2WT75uFk+97lKZTcRkImBCi8sNo
+BM/S9Q6o2WQCRKJXDiIFokUw3cFc4ecpqg0ohxknKU9lS1T2f31dvZVh6ooB08UU2yGc2/jsLUwmE3Cc
+dslMX/nQXMntvO9s6Y7mJfXemr6WBNssJJjnh4RB7Y0TUM716fkZTGA+br0AjEbwUxqExQwQ5ciCC9Lq
+7iBIQpAXpSVbQZTgpE3pbbkq/DpCkuOtgnPG1UwS0lQsWQbrYMHk1IIX+cxHxMAu1/LLoEGy3vrLgHvv
+MLK4rM01ekOp2X+nBrcy+800lPVKJEQ+9DZIwwirgjgjNNbq4LSV3l+OTPUl3+9bYX2Wr2yEijOVxJPr
+V4T803Pz24IS5l8lUjqk/XWWihSZHA239cKicTP1G7SVoOgbvZiJ5nwPkeI0dr022/IfYorUoO7WlnMx
+EHkj5XXbmMtMG+OBubXJ9590c6/Gtml8bp/B4uhX59f9+/nxNjCerOlFwoPVmkK76+UOwD104SB/N97n
+tNZxuo1DuaVb+jFv7l4ZbcDG37zQnSx1JrGbK6zxf/pey83OauxIQ4AQcAYOYnVOzHyRaoz5LDFYT0mM
+qm/XQmobqL0LvKBxNzqxNopkjDNB+ZDNztfWnhKH29FPSz6JGs7KapOUXi46/bJDqZRfsncbxrtu1Dpo
+k2hypUh2j5eHwSKts4yl5WROU2XzdKTaZlhnm6R9E7xFtA1abHIg1Otv8x3MVXS5VFQvdxqd9eHfyEYU
+q35bK2zPJYndTeewbU0iiU4IUbJwTlq96O9uO2ODsJgJBu+i0/Oz60Wvs1o3ynZO0zRmQfLpNzSd/o75
+2tvb+VcC8lEs6W0HfaMpfcT222Xnps2u7y19zy/k+3nG+FK++RvLuNTxtxEABWUWpaiPeT2tal5q2P5q
+XvcenszSoA7vu8tXLNtGs/00wEPIsQwBcRg0wqWEhuiVwzfkj76KEvonQOceJ9gu8J+QbfGfP6JVAbXK
+AaMEYc8aUuyQ12tA8NuuReNipWhvCA4GPmRZEL9NM3q8iOJwFmQhPlQ/Jal4GzVfVd9kbMEu1/irQHRW
+FRqptmzl4vBfBL+nGUZVf4B8Wf1jlKiPFl1p5brdOL2vGsqCQLC3acHclKMgj9hhyVUMFcvSHMRZkDzd
+iFS6vNc/NsNjegsmXlXfegPA6zy21WnqYXkDvlWXog1pD4eNJnKv0Y62m+DVnS5sxIg4A6umjrMgmy1h
+Um5DX77yBlXA32GigP3fuZ7uCXusPky//KLeSywWiHSqg7SsiJ7WTNigrGCJfocn8O+v/vqzvw4yzrzf
+B3BCZavUtVZTlIQyvhmW+RHDfxYDsAwwdTIF6DtqlBPBYlu/eOYTnmaChW/xVmaBIAnJ23XtY52tUT3L
+mROddr4zhDqr4j6NztTQSQm4aWeiIHxsvHvmPVGsYt4SXrU6vJfbOYYaDEvCBgTuZxJsqtlXz3cn4NLC
+dBslMnnolUWKFxNwcWs0ixRx9spC2qtmMW3BUvgm42jKMEYHJZxp0eqYgksbpuBSxxRcmjBRbClls/p2
+RUqTKjKHOyf4v2rwMGeFb1f1t0t8u6y/DfFtWH97gW8v6m8TfPui/naHb3eOjZZE/CWLYQKj//LehAcD
+783FAC8a90YlWKlXY/Hr9OmUeyuLyYmya8vN2vhmKrJgJjzar99hulhvhTaEw8q4na5OH5ydFVZwRlJT
+tOHplL9OX7LY4009yc+pAGTpZ4IoBOr20zlJHpE3AYnfh+9SNNokScIQft9wAc6Do+MvHLiI4himDCXX
+UWi0eNH0wHyYPynvI2UG6aMU9Oe0EWHVYInS7N2ri2BNmWS46Yy623hrH/vmYFarLKQeMJFrwGeXbNaI
+nI3Vrlpq1ZZEW00KWpu8lvOEb6arSDzVTxX72d04gyoJ9GBC3Kj/PRP4iOZ89SFpGLSUqNxhE/2NLFxG
+o5BNN2iRas6w3ewMhhdCtYXG2GlfKWse3G3Ta3AmCMqzlO5lEtvjoKcujztNbwxRInJNAmdsxUGkpFLI
+z1dQR8kQLpYsYxAAijohTBlPXNHdUA4Tw0u8Ns0C0RyTaxgd0XMPqyP6dx/TInnZtW+Dws4PF/CpI8Gd
+s8YqHrlwAKaVdWObW8OEGifgrY8KwXRjGHA+hLf+PErCv+P0Gr+/hx/DE2MH4Gqwh7mocaLaJ4kiSzYm
+5hXJmJBM8z6kKefLCtquvx8Yh08yafUCLAlbF8zTMHwdTPs0Keejq1xow0a0yahKRUM7ozoYtFuZih9V
+7WUzo452RtarUBCvl8GUCVyKwXQWsvliGf1+Hq+SdP0u42Kzvbjc/eH4fB1HwnP0S1WT4No8Weo269re
+kkruEZFS92bWj7IpKWLiMOmwROzXrDyL5a20bCaRSXHzbbQOU07eSsuWKRfdjWrwGd8z8TpY/Mc3uxe5
+ZZC2InHlWVYl3SZPCSK/tklbtQZvleOt3/aoqDJAavJbd+WH1qkg+cmpBDyzqly6xQ/1WcJ7MB0Yqg03
+mR0S29t6b/bLVCYwJpuX/C833HqrYN9fmXmpu5o5jKl1ElcBomo1mLzY2SJVTfJ9dz3J9zepyKR/Cctk
+iK0xG+mWqjaHUWVSo4Wt1jdtg3wanvWJjVSYxZSj0B77QbOFwG70Au6yrrEsgtOwj3nHVdeQJN9/UmNS
+a9C1e2iPaSyt1dp6it9l3RL2NDzbN3DyXVWuXzWuuw/+ClGRCOwLR54bf+OYN0CeE332cF1g+K5pvhbE
+5EXRZsK0DeLO/p+zHXZgG8S9/YIHJjqrCCz+Y7y1vcB7Gt9kDPAAhohDEF8EO04Slzna9GNZ33aI6ZLX
+8jTVlYO0qMZ7lKd32skUDGHaNpoBiRqXxId0+gXD4V7R3guL2elelRzvl2aFygQ+isFj9ixdrYOMedMe
+Hnm3ebV1fyW+GUQqPe0U88BlOtKOi6+JC1kxEeB5NVKInsh/J7fImJQqKIRC8Qr+6780hli1sl3qzbWG
+tp/MoJfEQDu+q3c5o1a9cl00i1sNE5JFMxx+5wmPkpn0TrGJex+gbjXYcScPTHgTSYNaAg32/jau+3st
+XH3sS+ud/Cw4z8+CjrsjXh6IwT6HA3B12YzxdnCjdU4rmkiinYrqlxlpSWy6RX0itMIgMV8wYTKeAgBN
+2WhXLGpwhaBGl8+YIaV8phTLjFstnqrzq5/4a5u1+t21b78Ewt7m6u96aBfXg9YrmWQHWtiEtjsbdWmf
++0V9CPta+ldYJSTqrVBl++XK3+7NEffi/GqqYSlDezcY9/HYzeoLtiHFOGe7UEYb0Kx1jB7n0Tz/UkRD
+IbWCfHXOds8oy/EEjj9v2chyDdmtjMd3TAU6fVkz6cha7OXrbql3FjoMmr6rwu1bVuvq5juP8GSluSLR
+2Juj7b5InGPyexEs2ljf1akIFme3nL6QVBBQ77LcYVjduNfNY19hhLK9b1Rr8hHpIhqrU+l403ltbxsd
++wi1jZLu/iHbMN4bx6I/CttwVfQ19jHVtTZ2qPfk7nCSezNdDfZIh9cR0UAaMze0uXWLKHf65ReYpFyk
+gUf2RtLmN5rvvGww6CwtjV80RTA9wxPYJCGbRwkL4SS3i+lEpnSZJTb1Ap4oexc4KfF2YivsZUp8xas+
+GIkaRomWVLlISVXa0wzgiWZd44v0FQ2fR+Zamzg2oAwu21AGlzrK4LILZbPfqwgV86tGzioDZIBJ87HK
+GqQt0IR2eJXMo/lUksS9drLLS5fZOMZmQVSYW9VXmHtf/qRb7z3P/RPFkXQHeR5nOKkIuXSOWCo0XjAR
+eGY2cp8LN0tmach+ffkjihfSBG98Cult6G6wZu1CfcuXDxVezI/ThafCfiyYEFGygLzLJFyXDQCnlC/s
+ewnxkvTlJkmipHHq5obRKD6YsdjT7cQN1jZ3rYhAj9hBEDABVwG7XYY+uJiUo0tdNqpNhUGhYlI+mBe+
+VQ+B/aoVwZQ2uv/1RPlaW28EefMPJmpn4BMt0KgP11TdFYbGmOfd5Hvcbur57kcb+1mv9N2PldOSDl7M
+aHYN71bdJzkPUGHzTM4nZK77GlrdDKslBMW4mKioFn0C4ee1SF3+X+ee85kzgMdw2Cu7VV6j5k49Aecz
+B56Un0oreTjRje9vEkLfEoLA2jzdzH98q0mVWgW/8z5y3mue+u79VZTYDgAjS1A/kfbiCNz7q+Cyq7rg
+sqO6wp4jWmGs7oHdlkXFtKsj0CkVS0IKzqGZR2qfBrYbZs1OVCtisRi1yuYqDb0f5vRO5MadbhlMyO2D
+hUgnnkY1NBTa0h3sYydAh9gTRIMMtm3ejFy3HI8BhUhXzM6BRt5pdR4Q13YL7EXG+CZWgYwD/xUR3j62
+l11BbS12bihs/WZHjfKf9slZVkRxHfcUJqmKL4IsZwDcdnmbHIGOfmjofk7hJRXhbp/2YFXU3b9LFDkv
+0JZ3uFbpwQSqGGTCTnD2GZDSE5Zw/efGMtUKHENRvGaXwmKTqk76Ku4unw5bFRjU6x6ylrmxGrp7HICD
+dcMBvMPfb2z504pUm9W25KN82J4l0tSUYLvwTM0ZODdRFzcZU7fTGtgEQtHlKq5NwZT/msUmFQbCbVDt
+xEXmHQ1hUzAZ7hNXpmB44pqKHUxKslX6OHXRI2t/ZDS8zT/VdrjXmLeENbUhw4CkXrtQTkktbOtOv9/g
+RKrrT7V6ujKhhAItbIfwkJKy7Z0OwHwtK99hJSSbGdRDJT9dr/0wwiwZGN/EFfyXdL1ZG1NKKFr9XhMN
+SCeTE3C/dUtvGxqck9qgbLL4BNyJWzazLCDYao0pRU7AfTTdCJEmQEleJs5UJDAVyaHiExyiaYdLsYon
+0tVQvljHwYziXk+caSpEunIes9WUhY9GEt1jrXUYoedE653y58XA2UMIhGgar+FOlHhwFj1X/nZlmdpk
+qSjfF4GYLT3ChptCH81NFluVXZZvsLeeS0iK7j6KkvVGUFDxiYMvHUiTZxicd+Ko8DaUjGMwdiBjQZgm
+8W7i5L8cGbpq4tyPxTiAZcbmk/vvNqkYI72gMI3gyhf3F2KMUNFqATybGcD8dbKYrJNFFX4U4C/nsYE6
+yWH21+ka05Z45mFBt2+WiBPq8V53gMKj/cq6FZ5iNM8fIi7QcrjXjshX8q+02kfrIBNREPMRxQVdSkw+
+Ll+3UbvNmV3V/8+KWr6XW62Ku/q+ZoFR3nCeZlmwy70M0aKrKyhGCVrRctaLgYoGfLo1W6iZ6aHhMktI
+ykrPWty+scPrIAtWvGadhf8btKRgd4Nz271gS/mfJJ/n3tdvHvV7BheB2HC6aKhGHIBzP4jjybFzLasS
+XSJo8FmS60DG331Ly7c+06bpq2ee2w4hsEaiu0uDH5zfgipw61PiC16bBTyaYek3w7bLd5Z8a/VO5cj1
+ji1b2F7JqR7AMTwqG2aWiOt/S0w2oZqaFzslPGfU2r1t7vpVpY3K4CZ8b22x4Lw2/IDzP0XTTsrhyTB9
+KmeGpGVX7WKcehyVWjMGHbew/PqKwG8VgYZJvTPXCNJZ5/B/TuFFoILv07nC4bt0k4T2qJ3dZl3dPlxN
+o63uJBnojfLJnTaag0vpKI9PDRAVZVSBiMrGyUGCqQYRTCmQMBJY7jRg5xxpmjkgQmmRVw/kN9tkGUvE
+ry9/qvRrU72+5Wjiesjxlc0wpG6/5RmodtXGSef68793ZQjgVf1L7kyiDHVPKiN/VVc9t1ry5JY7HV5w
+dVe9hoimqc8UwdQdgsVnUM6uOdGB2ZxzhJ3TLRDx+fZMNXF5nZ41tyLoSbJhYrUipaABRU4wbZkPTIaY
+VeitaSWtAyFYlsAERjLWQfhh9yH5sPyw+sAp6MFobHSPV+WkBHhrnu1csJs3oIhQouIdYIgDP2N0Y/Nc
+oiEv3EFf21upm10w8QTNKCY4T/fRcLNDXk7zecMJDRQdeKsUMA1eJ+qMSUATG0nWAx6Dwa7yamBfJoV+
+vNCHHx8duTW6s9687aITBFO38azSSQmixdQrvgBAnbgMDd0uA1CdgBtsF9r9O//L6MIvMTQ+yg10Ai7f
+ccFW/my98aMwZgY8pe70JI/f3QRCqnYC78k/o0bSbLKqMzvV0GT+GE3Itvhq8n4aVE3Yf631SHyzJsK3
+r7k2nkVDcHp0lud4cn9h2YwlAn7lLDTrk2brjU2FUF+uK7bqXIoE074UJUjlnOlYf/nacdTaWbGVTBhU
+CwjTuXD6LJqKIdfttHWeMfbRm3o7S5o6/Okt6eNiSTu4lB2LkcfqLS0LmCBnTsGNfJmdD42vhvVNgtma
+a9fLigYLE75Wx7haGZLRaiNbWIKEibfTnWC8cwtpkO0bSQe8AWWvr9iECV+hnW3r8ZlKOo9CFfM3FdEW
+V6+Kl6egoYxxewLHury4c9UPIWQYufGETCT+eSReG+NPY1eQLHhVD9mWf4nmwYy9ypdCH9mOaozO9nD1
+JgovzUEC8TPtHpjoT/W9tNY202m4Pj06G0K4Pj0+g8/g67Ox1UBaoXwdLHguvU0Tso5JN6IlGtAtNOvw
++KyvtpqmUxtvDNP314sEM96xTOyqvaC1O7CLlwosp81iZzjX8rXF5lmsVPSSDkS9+tUiaOpAL9sgP+xt
+9JyHRVE8uVit7bR03k1E553Uc37LZDOM+LmRK7kBXRveSqM2eGL+C9Da+b8IkZ3zj0xhlQhBVOnYqfZb
+s5E4M+alRxTrLF1bjEA0MptHiJmYVrKNYuWoJdQNyUqObNPk7FpI75zfmO7mKK5FdNsKn2KXEAXOYgfN
+bsNzU4LdhruDWt+wi72I/Zy3UftuufaPySwKWSKuExic7x8FnM+ur1eNwlI+HYU1X4so7ApFt9pwAXyD
+dxmIVK8RZ8BVliPSIDIMTT3u6aBRp9ky3+WNslVLFG+VqULdp70ZjXfWiP1nMtVWammYtIgDcREyn7Qw
+mAjfNAbgjmSFT7ASaSaKFs5oyXDOdvTinO3aRNvoU4OiPsyT+UuQsGpq1K1BgUjOun6YJuwnlWMZrW63
+vtU6qsfZVKwKg5NSpSpj2o+60V0ccPFzmvyanCfpRfJ0Kv2sfrQcTCjCRs1cyVTlq9F/Jb8YjiJ/moa7
+sgQ+maCqTb9u1C+Sq54b2pcvjrEl4ytmikVjvOA8t2X2tlKwa4+qSoZAzcV/swge2hhL9qHPwDJfZBsu
+nvIfxCqWbMc3abi7zcgo2/Z4n/3N8bqnuj16KFcp6A0B9mdpHAdrXk0EEw2byVJ0VDJE+d3aq7Etjn6T
+CpQBbPPCLYrpRnFJOPo4ndcodr60+Yjc3PmTKJROQzcKf5pjbVBwqMT9FKy5x4ywkugW1stPZ7VMoRro
+vstZFZO9zyuomx+M7SeVSgwtH3Dv22hG4d1AMV/qUJgxOSecJoJh8jKrNL3LxwzLasPNlCHJmZmvZ2h5
+LzYyrL1slYtLtPJ+I+m922HobT0dqpFLjSMsdyLuPjYYw2gE316uKRnjksGa9o2KYK5U64DTf+f6uW3u
+tLRi/E8xwuiTjrqdoRVsxVu4WQu7qjfYEt7oUwtTJI3fbhKnyBh9CMUXKoqTMjC+Uc/N0Vc/Tr+prtZe
+0xpTuce7U49bM4/riZ2e/9KB6PkvZjzPf+mVt+mXTfvlrNvWqGFgVAZ3IrGdis1Ts9YJ10o+iO0EAAAA
+CNf+edMoo5lNQNUQrqmCcH02/lfbXTcOApaHA6d0BZ0Bbgz2VFANsdQlJ9MmtXfWY58yy299a2o4is2y
+9WX8I3+7r5DC0sZwvVcT799vayINEdfT/mh2JVv/fAiCh1N0oitScCjHxn+4toAjxAWRcMdcJ2hy4soa
+HFrBUXLORbBan4DgdrCt1OxpKVGw68PWkJ4n9P9bipzS9BqSCTcoKgKNi++bzSDUlrVHELA5djVybKwp
+2xxt+D7XwH6REOwOZ1rDSfUlt6xomHvchs/WXh5w9K988leM82Cx1yWPQjguOkkPMqiahgd3vPZbF5M3
+73NYVu5Q+rVt6TkhK8w/XgeLPYKEPQ3D57/s2ZFwXfQjXN9CN6xnovFsJOoj0TYJUBCG3vHDIbiczdIk
+5K7pCG0epXL0wvUeA9cWxL5HLMpz3f7zFkJOkqi27ghDwDf3duhkatrukvuESxdlOEOLXoLWQWtE9cae
+ED0I9acSZvM2r2uvohhLfqqm9bn6WwK0Js1TIKacebndbwmVv2mAkmtFCUePRoN/XrX452MLd18Y9C+a
+ICyMtLrwqQEyT7MF04Dks8Hon06nEq5xXOlBB/LQGbXhsXDi2ui5x0u3NdRSxQqZZusbNk8zph6ezinJ
+PkvC8lcOEEerSBhdDJgwsN/4hUyziIiYAgEZ3L4ww5iNLlL9OCwS6+OJuUX7E0Za8+WlbSujSgxszk25
+pKwA/zYJrcDYcG2Ysfk8N+KWr24nUGY5e7KKR9p83k4N+ZpA/AweFWvk1rCXI8Tgcbnwbo4/Y+IUF5Yh
+Rq01bKzoDFjWxR1wSbjR7eBWsvQofNxwCc5XZZJedLrm1bC1XeJwzE7AfUqeza75ipXjOalSliS9GII0
+iNV+Hg32iYWxRxN/Xc/SFUZx26+R9fZ95Fb+EnBxrRbq/8fWPng4+KcmKOgdVpz2hTmmIl4cjZcSdQzr
+4hmUubjuQGUdc4fuwKooo6Pd1IWScySXtEnNT4sr5KNTGH44Oxjl3tQfGiKPq4bJvErqkXv0mN23tzKv
+5NgYQUwJs5qtJqJ9UmGumguGJWVCPwyL1bxoKYbgpM4hNEGJfzqpMFc2Kz7qsxyloWuQuCB7dKJzTkPT
+zWLBSiD5qAdbpUVusDgkXumkxkxVp8lIyGvizqpnVLKrMa647kouVXvKx097RUOlPdOi0p7zdWxhC3Pz
+OwaTcnc0Vc3LIFmwPikJw4jjheUZqhmzVTMeQT3Uwq5DqmuIJFsXQuUHHGcojKLO3Pzua/sIAMWuAddL
+0oQNXOUIVp97aL3+Mi5elUfpx83W0Jtwao1rn+2GUyst3DKEcbGOcQI7I/yyJCyLqiXfq2C+IcrS+hbp
+hYI2UFm+2E+9CtNuKwsXm69XYdyaZdl8o/YqKrdxWVg+9y6uCFdZXr2wIegRaFk3NshW7Yunc4sbt4gV
+Tt2O+wSdRlB3aOqjtKorm1/PXH8D0nOru1Y3PVow0T4ZMQuyqnlQaDQiv4iSML3Ie++5z6igoHTOsosU
+iO06JnzXp+fU+tLOZwjvrz6lwVWRGlrC/OSO5/kF3Xg5z12sTWnzO6OdPZ2df48pEqVEriJ+U+HaqgI4
+TeKmvg8qAdFq8aDAFg4NACCYnVNItCabRFkbUUQ50VvYAOOzJQs3MbNgwQYGSRjKyGpa6DWohl+DtsBV
+s3NqjAxaVS2zfyS1Yjgog9js/JUKswITyM2/zikC9s+MhRyeztCQKGbhgoK7GbRIshTZBz3DiHEFonuY
+/y0R2idbYQzN2iiGL20FpF1xo4h8bStkskKsjYjRGNHqv9YwSGyzR9RJVa0kypjojVxzGAD82TKKw4wl
+Wuq09tiaxsCGVvCy+femWRqEs4ALz0mTv65Z4jSNG6uLFo76BymyjjTGvzOkCbJ62DQnyUPIlgyyzeRC
+atjVjvVnSzY7R8u4uxMtL0XLqJEQFgtpu0VNmQX1ma/gx61Ic7m/9IGOEuza0NbcQTsuqR7Ivamvi6mi
+tZJ6CIjg0QTRt6qsGlbrLfOp/6GWJkq60uNe9VjR5SYq56pzFvaJd24ZTaC+jluKbNahIRB46z6RQbAq
+G0X1xeoiZdA36gPTR+94g8G8ut3+yyKtjHjjUHsWp5xpx5o9eW5R5Du6eexRJkh2GrTBa+O2pwNRLOpU
+x3bGFCzxwu9YLf2331XHSqmOiH388rYtcuNm5DWIj3X7nViGGW4Zf+jMkb3Yx8q6e+Vcqy17nJyrTSyi
+no5Y+uJRcUJPz8ZWkGAm05+09aERLFRbjzqXTm86M7YTVN81ev0sZzTNVFXhcNbj+JIlXtGV+pm0eG/a
+6XchAoByXNt3RfcBh3MoxfC13lxnUOozKVHmjOewcuJEcdhnxAjwEx0x2bY+I7ZHWrhyW+ZXEM4QvefI
+HXqI9TuUSN3msluKd9CcJS/odEIrYZCD+94Zkmdk7zJyeJ1hPs6azsCcPq6VIpUhO7sP6dJ7zx2pck/c
+/09TGorNPdFcWg0+s5/ebu/T6o+24+T0YBs6V+nVHnIoolu5WZjdi8fkw2MUOrUGIpeS2luT6JCUbidv
+IQR06gj+fbZ2zqw38Ap9rpaSPi/2somM7JWr1fRpthXJ1XN6GRpwW4HrsThmn/Oy1YMe3I/WYPsp1roy
++zmu07OslV7Jn70IL7YX1Rgs/EZ6YFo4NtkVkS4W8T6XKJRMVeVZfWRZZGRXtqr7cimHWPXAiaV7se/7
+LVnLKp1uZw4s0dtVIHjT2h1YccF+ytf6VCk3WbLRNVV8RoMw7nHjqAxZw+122vS4rdLT9g728KzsM5Ey
+taWazoIK0iCcgCMtfq9xGPRnSO6liedKYWaFeLPOgPGTiaSi3WtX7qkbhmsnTH9c8HYNTIWP2XZnyTb6
+ekBX+r5tERF45J0O3195g7PBaIGH4PGbzYOjo+lePKFcEa/TDUrJSu2R4aPV2NPM/8myzWQAbUn4t9Xs
+A3mUCFvF9qmSlSvHj2Z7Tpuv7I4gNfpJZZSrvSar1l/3SGamlyIn9/wQNaA7banC3Nyr1mF5KcNxEGsv
+HfQxnpixmdJcwcY3NgLEDGxo7s+zdPU8T0XYgYrcY3DicyWlU2YsdAbtdbyOVtesg9IZOgOL5bO8zVjE
+Mm0bgNSRfde/rEVeg8/hAJwJUuHtoH8G/Iqssu8CzBeEzPSojoG3hKr1oqPZxQ32XYS2k7EOM7j+NeHn
+tOYRsm9uLtu9IEnzG0GPtEiz84/ShmB23rcJJIT9KI2YIebezaBE1h+xMSX+vk36Lrdruv3WSBOpvg35
+ZZMtPs6orBHzHuMxYx9vhuYF+maD+niMUYCG9JwlP0VclJ5jXQEbmiU85RMWbKpZhrCCt2SHNCFzpJL2
+4JNPpWAiLyy1b4gKJoSx+uXUCVnMBHPOWu91VG0Zw8J5joWMtywJKZG+DpQblmMEooaWDfAc6cCJA8Kf
+LAO+nDhm8t6sw5S2XyxZ0mHHUO+WM7aA1G39AACuhtB5x6lVoHkwytYD9bZwYOzlJ100aK8Z+8l2Ldbm
+gm65+iw4g/ooZowbhaRv6YtPN9mC0yiLidTKVYj0nHhMDO9edZTRv1guSAjyC8tWEed5vuJivesfvksz
+QvcyjVkLKvysws1rePBtBQFahnoOLs5a/TlncwAOaK+dniySrFMOPUygGNHxvgv3ZktTOtfKZlxjaa5Z
+tkKCVpW5NRfAaPTD02f/cZLTbaS5IGMCk7ZbJYv0c/71kIssWMMy4DANQgjWEYFhlQ1DwyWOyaMw2qrk
+o28che2NAyKYUkbdyRvn8PiN8/hNAgAAAFApEGRZevHGefxoFEZbG5DCeqhSVyL4Jn7sNH1LcEyutTpN
+en9C1id02BomEtio4UeIdM0SGisusjRZPHbMYMRJEdzIDrikTMyP4ugx7gzCfABrOFClD7B0HNVLXt0x
+4BhtYjXw8v/G2KawbCePOb2WNRjOWf9elKh4i6eFjN7ByXnFMox1m0uu8xBKTRSVWEjIsMyKQ8oVObib
+B+wuXchPTMiGNaCn/AScmVARvGtsC27caFZyL0VtinnZh2v5mV1Qc3ozLc0C/0yeRVLIPJ4B/vYGNYhl
+wL+JRFUgNo2aTmlqZukb3NcJsNyMKKo/si8zzgSCNaoZAlkNNs5kxbmsy60/kF7XZXH8ZnXEIay5ykVy
+Pl26SMTnP+2wyNgvancFIzXY/0aOnxzi6piZ62g7QBZMNCbPMnHGEc1YuJkxbUz5ZjUEPfsD36zgALx1
+3o0nsJZdOEGj1Lpt6lUtxBibc7Uu/e/lAuCNBbiuMCVYRCf4NeAMUeRghE+nXM3N5q+zVKSoC/JnGWsz
+bOvYfuVKh0ll7Bv9KVmNZ1hjg5PUmEjyXqhwkUOttl4cZQd7Iz/LroevFTEouKVSAO2g4NnRuS8DY9TC
+COEpAjQ5JRtUBuUbd8+OvLvYGXW1HA1XnEYPO2s1HWaudpi51cOsiaHlLHMSBe2YjrImqj1OMqd2hP3M
+LugEc+gE+/8PALfWLWZAZwIA
 `,
 	},
 
@@ -22726,82 +22743,85 @@ AAA=
 
 	"/js/config.ts": {
 		local:   "web/static/js/config.ts",
-		size:    14606,
+		size:    15669,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/+R7bXPbOJLwZ+lXdPSkIipWSDtVO/WUZGUqiTMXb2Vmt2LvTl1Z3gxEQhJiCuACoGSv
-rf9+1XghQUqyPXdzdVd1+RCLQHej0d3oN4JJksCppHMqKU8pFEQvJ73jNzOhSh5r1YPkXZdxTeWcpBTO
-Pwo+Z4uLVBQU6K2mPFNw/gGB7dh9t5MkoOmthlyQjPFFwsmaLYhmgnc7qUH/hvMjUFoyvhh3O4rmNNU0
-+0ZyKsMJpulKjeAervRdQf3Etf9xdT2GLeKnUuT5pRhBFMINgZNV9TSAyTtYC5aNux2S0i+CZDQbQUQz
-poUcAeF3IUgwPO521iRnGdF0BFEI5IaZ4F+pKvPGnsia7g6ajb63u4wauw3JSloQqdqLkZReLmm9ITv0
-s8haI5discjpZ7ZY5myx1C0q/yxZevPnclVcErmgIXPVTAsjExuOurSqb03iNg9P5EQpPx4IIUWJXYoz
-kT6iXKNatCZZ5mhrNC0RDUSBf1S3M5didWZ0UpHWojWAMJds1YRpDRjrXpNcjYCXqxmVuOdSGrUGQ3RF
-WB7SoasiJ5p+W0hRFg0l63NHsi0Tqs8qwuHM7ja7HVlyzvhiBDMhcko4siAlmmS10oZIC1IdB2RLtVWu
-qFbekI3NfVsypYW884OqnH2naWgLM5HdBY8Z0cQDazILZv61QQWvG1YcSuLSSenfrJAiJ6zWYfMHOJDb
-et/BUEuxsXMtAmifqNaccfpXwilSoFy7LQ5hB8O4KUXWjC+6nRVViixCm8jYfN563Gvl9LYgPDsz0LWe
-nOo+E7UMiLjRj0vCF+h5duDdzGeaF7toSGzHnyyo/hquFfK27XaNE/8ouEbpUqnitPod9e1+PmqZ94dw
-1X+p0H/3h9B/udS6MD9ykRpjNQ9SlNrOa7aiotTmt0pxbF5yc6YhsmRGjUAxBENyBHwRn3/Wurigcs1S
-HPcr2Lkv7qmeN4uaSfMrPv+Kf+p5x4pFv7QP9axKLe75xcdPbnSAEWpNJChKZLqESc1DbIeiwbjbsduI
-vYuBiYOvRx4eoN9vQuL6TUgz0oK0PqqGc887UE1q7rkFVbkumMCRg6zHHh7gTzWsd2kBaDX08AC8zPMa
-ODC6modw8BCGs+EdJD/+8ABzkitaIwYpAUyg/8VmDWCH4zgOttvME+o17GNLNsZf1zD2sS3lhg8PpN0c
-b0sdsxKYgAnS5/gQ2owms4AQmRlsaU6uCoj4aI57TpdSrGhzEuM6zpkzHG6r8jgwaYty14/ABHqXSwpu
-xkkVlkTBjFIOqQWN4XLJFKwo4QruRAlEUmAcMpyVIOYg1lRuJNNIRIkVFZwCzRXtK0dDxXApYM3oBvSS
-+kEQBeVmoH9B1hTOGMnFoqR9IDwzK21YnoOiFAiUnM0ZzQC9LXJEQfD8DjbkDrSABdUgWYbMID0TC6FA
-Xw9MIYBZigCnG2BcaYLprAO2sdVkdGbhVBR3uLqs+GRcC2A6hn93u1caGSuoXDGtaYYLYEoDG6aXotSQ
-CRSFXjI1hFmpcRluNrQqlYYZhTWVd5ASSedlDlxow6KTIsVotEeEPQxMlbEHaRJM0G91esbSeyPooUNV
-oySxebqQiySjc8aZyY3+nwF7E4z0hojtzfppAh7SIeZC3JTF02gW7o0ms9yjcqHZnFkP+zSBENoRWJFU
-iqcxDZjqdTvbrnXw9LaQwfG/LTCJqwLVrGR5ZhLxn6RYfbotZGRCQ4fNIXqB0AOQVJcS47Ohx+nGwP9C
-zKHtYaLV85Msu4UJnOBjkswZz6w5/7OkYJ0TViLdTmezZDn1UdI6kiun1OuY8Yze/mUehSsN4MUE3pyg
-E2kiVbp8FM/uqbOXdzhCtpHlDstuj47w19btx3vjKZ9yvxL04agphSPow/2U9+EIifR9HonGeh9/IUrH
-F5roUm23IxwxiDFibrcgOA6ZtDBeCqW324AQpp8wgd9Oi3e/mBKuhR2AnhbvLslCjcIhY3/vgpHO/b3E
-EwYvb4bwcg2jCdilG6Q6nVMt353q7N39/cub7fY00Zl/XPvHRMsWZcqzJkeJXf+3emyLcux7Y0HrokrZ
-WEy0mEXG3vw05rB44GuwWBU501FvynuDeEWKqM638gHcO0OFPNaSraLBGLaGmCF0Zf6Pc8oXeglv4OQa
-DSCVDNWENnAAqtvpNPi0AN8F48gHAEDPLGIs5WgCfWvnT9pIZU2TvbABJE4HLDQmt06ce3KIo4mx3mD2
-QLzu+OwxsmmzOSlJkhLe12ArEh8zSq5ZDmSuqQSXQ2PQKYuMaIyeZ3ROJYYPJOH9t6tpIne+h429Gga2
-A+uuKnWGHD7R9jDsornYvV/aA7srD29VEiWe/GOqXtu+w4NXxEPocx+sB38w3nQwVUfR1XQzfTONpy+v
-jwZT9Xp6nyxWnuaK6HRZucBn9GrQM2wRoen5YAKmdu3s+Lb2jAtEO+ONKLMza0NIPey8sGEfJiAxq6Jp
-VEty4PymcYV3BYrOAF+dGHwbD6wvteNv6/GcKdSEXRqR7ZSJKzjnSHccnOXIs2rgzWlTRnPGIRvQuCjV
-MuKV6XQ7HXfsDebYWpIptOIF1VE/IQVL7JZ+XBK1nOB5ilw4XLocvt8fDLqdTqzKNKVKRVFV6ddHYm+e
-jnDjYPbQGevsC7S1QPYn9q9ewf4Iaf2Tl+ChqmAv7tXxdS3QfSf/jzj6h8/+Xl6tILaDrvMFndhktYF7
-RzEPGmpotxzRi38yufCc6nRZJ/kj4929nswivkyw7UWsH6qF7CKPK3PrEisjmEaxYpupDYLfLJil62Q5
-gW8VclXP+Kl6xv6K5yItnRW5kQXVFzYaRINYUf03RX+VpMBSKdKypCGs4FFvlpeyN9zZpV/6JSmK/C7a
-mX7SprfOe6NMxq6dcMc1wRwQ+WhWec127B6p40mw+I6BA9s1+7QcVMv5CtB7A+8b2vw8StMsWhC9HEGf
-pDRZiYwm6C6a5ejQuLgRnBFNYy420aCSQrdt+TB5shfvA5h1SV/pgiK/WL59pYtPt0XU+8d0ql6jFRsf
-fAS96VQd4TPSGkJv0Qs1jml3FBAbWmHOSHqzITJTIysss4uNJMXIyMY8pkTRC8oV02xNG3BLkdNfhczC
-QWnYq9GtOaAWbayYgDv2mJs1Dr7xg86Jw9a57KbgfAsUBciyHYFlIi1XlGtU5Kec4s8Pd+dZ1EeP9qZv
-cvqBI3bOtfg7oxuX7bh1lmITVUxpdcWya2fI3RAG11dUN2OBotpP9t3rHdehqQyesxXRzkxRu6XErdho
-hGX4jz6FMyIyQYnyVGT0b1/PP4pVITjlOtrvLh3mq7kUq0OYyOIlc1HShsNCKB2VMh/uSY/QhB8Pf51C
-itT6WmX9sQ3YuJAbdvEwvkB5Hl/7Ye/dO5Vjj8yfgLZ3gsaBT2xTI0SbM07y/K4Rp7xstCicN+hkNKea
-GoUZ/QRuKtRrs12MGq7ImmhcGStZ008ci5jMeSTrXJxvqT2mZulN5VT3ph6J6zx9w4yjbzb1hLj3tCCr
-SGe6cJ0wgCMHQzj5E7yGk+PjYycPs51dOt6/23mjMiOIF1XOsBf4YIvT+1bzrxJRx2cZ1Z+9TdVq+fE+
-oHqR2sM7o3g0S3hUfC5RWFBt+ni7TcFlM2lwK24H6OkPGxJaYdi30qEPa7h6PLiYMwvjwkqdRu1GO5aL
-QeTxTfTKnWixF9810VvYtmleeecXSC1m6u+YQkUDzH9faFEPNGw97IZkrtuK6Pg70qImigMHMRsN+nbT
-3pOogU7h7V5ShoFkYkwcXsMPx14caCA/E72MyUxF5ocUJc8ii1AvP6j4zeAUfFso8z2rbe3Ag9cEmctv
-asWe1ZP/xxUbvk1pSa6StAepBd6ks+clziO6rMgl1gwS+OF4sBO56zQDdbR7wyDI8nfqJvPXhM32K7Gq
-jKnLliSB9xoLddPYNi3P34LezlyI34BxEDKjRrmKaigLMJcM4Hu5KmBG9YZSDhUW4Zld4HmJoQH1GaF5
-qFPCsI+2G/TDflqQu7SuRuCa9hVXZy4kROY8wwSOx8DgFMKO2RjY0ZHTscnma85jTZWObJONXXtTrCl+
-hwkwOIKTMXzfofq9omoE/jEXisJMkvSGaiAalCZSg5gbNPf6hnLzjsQIJK6joWn9TLfJKmToe81QaJx1
-5DJnG63QN4+8sqbq9WSqXjfaQ8nK9lEq2kEwNkTqtQ5KvOr9mEBksHzTxTO19WV8M4GuaO04p4JIHRhC
-a1FvDK6ficwaBN8MfTExTtm/DYDg5FZlvkG4Or4e2rWuTq6btK6Or01ZUDW2ghZHO8cNK9Q98rGHtVf7
-wib+eEcu7UsYKB7zWrMhI98LMzOxeYqS+yh+Pdgm1V7McJPDnTeoYZ9sW/UM0CS+2RakuaASTbOjQVLH
-Fn+/qqG7IHsPU8pvSKH/n0zmfeJn1NFrJ037mismhvTaeWeYkD+K7jG3Q3iLCao1YfPi7+nF60TMKsmn
-jVY/TqjhMSN8UeZExky9l5LcRasBWlq08sb8Dk6CE+97AkKLL4zTaOUNt3XWnlPC7OG+b9NNP8MXIxPD
-wyJn3DBVVGzTAprlUT+sNH32WqfhbsLdiqp6qzvRrO+TktqIwmsd6PQHhxEx82gi+psZjyDaNKZGqy99
-PIrUXKu+A/IIUpVN9IdwYeJ+1M4zBk+Q8JnGDgU/8RQBc9ei5ru6evHYXht+JNjzzk2MR4gENU9NISzp
-HsNyFdcOohsfPNLj+F+V/brj7XjSwmebBnRPVmyWRugGYCujfvVqb0ZdLdLkfvCcHLtRHlU5swGewLFP
-poLc2FYq1nMibJUr20T9FH449l0AuIckgRNYMV5q2ib0NiC0s8reKm37P9TMMkKbC7kiGmXuMLQ4BK/F
-LnS1kUNIgV9wKObAPrEbA1Oz1DimT6A2gf+4Rl3Q1Qw7cuNwsnH/1UOZeumzHRt3d/pDdZaw4zuW1tXU
-oOM6bB5oHP63NQTrgBi0jHabhe2g+68NSqt13/a+fr24jiUtcpLSKImuhvfbaHA9SBZD6L88mZZvj49n
-/XYRunMZF+kfuI3re4/rOBOc+st/6G7WsZNNM/VeV7LxxVkTNdg9nlhyg1LEteMbeudHRUH5ByyjsOq9
-qS7Q9O7r6jHFWmsfzLaGIcGdGnITq3KmtIyOhzX92sHXpXEFiZG15uQITobBqv/FBjqpLwI9w88Evntt
-u+d7vM6zjriH+uNO9TqurxXZM20fx27W3RV6qVIaa1kq/V591qvcHt8PIrt7zrFb/74T1za5qhFct9zr
-Ox0tL+D2FzZiDu7PATxviz5JM9UN/PniL7/E1sjY/M6CnhFNhubcDKEP0A/R/P4NoEnc1d6c2sz/ah9V
-05M0PxTZKcNnuZi5Ps6HXMyiq11zuB7CPdjXhj18ToqcMD5Ol0Qqqielnr/5/z13u0mRNX2vIqQ6hJ69
-FIikei3/Vt/df05xmSB437zms6ruWdzeaI/1Di3Ez/bzgRrEfU8QGNFz4pZLlIz+Hh6g94uAMzafuwIy
-SeArVVRXXXtzhYOZ+62SAlPAhcm17IdcPz471Lhlez8Rltt7rqhDsF9A9PbWa3Vvofr65/e/TGp1Qevv
-pZCZC/Nlxhj+mlOiKPxKmO49ojRE7rv3vU8qrGekWk3jRu34I3r8HWpsbsRJ54KsaTaGr9S9Ne2N90Ru
-92Ko5OZeK82eny401jxcX1ffZO1oaw+dA1po6NC1o94499ALKoj9FA+JYy9VJ+1e87qTm7RX0919Xzdl
-1xx3t9eDcbebJJDRNCeSZsA4/MRyiuvK+LvqugkwbWbjSOznTf8RAAD//8cAYg8OOQAA
+H4sIAAAAAAAC/+Q7f3PbNrJ/S59iq5cpqVgh7cxc540VpdMm6Utu2l4n9l3nje1LIRKSEFMADwCl+Gx9
+9zeLHyRISrL7fkzfzP1jC4vdxWKx2F0swDRN4ZWkCyopzyiURK9mo9MXc6Eqnmg1gvT1kHFN5YJkFD68
+EXzBlheZKCnQL5ryXMGH7xHZwu6HgzQFTb9oKATJGV+mnGzYkmgm+HCQGfJP2H8OSkvGl9PhYMEKqnz7
+6sZBAgRFC5rpHwwwDvvGMHsNG8HyGonmn0hBZcieabpW53APV/qurElvggFhh/SZFEVxKc4hDvEmwMl6
+74Akoz8KktP8HGKaMy3kORB+F6IE4OlwsCEFy4nGSYRIDswE/0hVVYSiK7KhfaCZ6Hd2lnFrtiFbSUsi
+VXcwktHLFV2H2iUZ/UnkHcilWC4L+p4tVwVbrnSHyz8qlt3+uVqXl0QuaShc3dOhyMWWo0VYA+p04jQP
+dxREKQ8PlJChxi7FW5EdWVyztGiTsirQYmlWIRmIEv+p4WAhxfqtWZOatRYdAOJcsnUbpwMwe2RDCnUO
+vFrPqcQ5V9IsawCia8KKkA9dlwXR9NNSiqpsLbL+4Fh2dUL125px2NOf5nAgK84ZX57DXIiCEo4iSClk
+MNKWSIsS7D9Nle4Pq7whG5v7tGJKC3nngaqaf6ZZaAtzkd8FzZxo4pE1mQc9/9ziAm9aVhxq4tJp6T+s
+kmKnrM5m8xs40Ntmr6NYia3t6zBA+8RlLRinvxBOkQPl2k1xAj0K4+wU2TC+HA7WVCmyDG0iZ4tFp7nX
+yumXkvD8rcFu1skt3XuiVgETB32zInxJ8z34ruc9Lco+GTLr+ZMl1R/DsULZdsOhCQVvBNeoXSpVktW/
+48jO542WRTSBq+iZwigQTSB6ttK6ND8KkRljNQ0pKm37NVtTUWnzW2UIW1Tc7GmILZvzVriZgGF5DnyZ
+fHivdXlB5YZlCPcj2L4fXavpN4OaTvMr+fAR/zX9ThRLfmkbTa/KLO2HizfvHHSMcW5DJChKZLaCWSND
+YkHxeDoc2Gkk3sXAzOE3kIcHiKI2Jo7fxjSQDqb1UQ2ea/ew2txcu4NVuy6YwYnDbGAPD/CnBte7tAC1
+Bj08AK+KokEOjK6RIQQeonA23CPy8IcHWJBC0YYwSCxgBtGPNvcAC06SJFQyK0IFs6KnkHYm0eDaZgfZ
+ePQGxza769Dy8g1yB95dF8xbYAYmjH/ARmhVmswDRmRuqKXZ2ypg4uM9aiVbSbGm7U6M/Nhndnk4rdon
+wayr7L6ngRmMLlcUXI/TO6yIgjmlHDKLmsDliilYU8IV3IkKiKTAOOTYK0EsQGyo3EqmkYkSayo4BVoo
+GinHQyVwKWDD6Bb0inogiJJyA4guyIbCW0YKsaxoBITnZqQtKwpQlAKBirMFozmgP0aJKAhe3MGW3IEW
+sKQaJMtRGORnoiWUGA2AKUQwQxHgdAuMK00wbXbINvqanM8MnInyDkeXtZyMawFMJ/CfbvZKo2AllWum
+Nc1xAEx6YMv0SlQacoGq0CumJjCvNA7DzYTWldIwp7Ch8g4yIumiKoALbUR0WqQYr/aocDQd1ovZSqRg
+hp5tMDKWPjqHEbpcdZ6m9jwg5DLN6YJxhiTq3wzaiwAymiC1N+vHGXhMR1gIcVuVj5NZvBeazAtPyoVm
+C2Z98OMMQmzHYE0yKR6nNGhqNBzshjYE0C+lDLb/lxLTvDqUzStW5CZV/0GK9bsvpYxN8BiwBcRfIfYY
+JNWVxAhu+HG6Nfg/E7NpR5oqPfKdLP8CMzjDZpouGM+tOf+jomCdE55VhoPBdoVuLQ4dyZVb1JuE8Zx+
++csiDkcaw1czeHGGTqRNVK/lUTo7p8Fe2eEExUaRByz/cnKCv3ZuPt5fX/Nr7keCCE7aWjiBCO6veQQn
+yCTymSYa633yI1E6udBEV2q3O0eIIUyQcrcDwRFkEsdkJZTe7QJGmKDCDH57Vb7+2RzyOtQB6qvy9SVZ
+qvMQZOzvdQAZ3N9L3GHw7HYCzzZwPgM7dIvVYPBKy9evdP76/v7Z7W73KtW5b258M9Wyw5nyvC1Rasf/
+rYHtUI+RNxa0LqqUjdZEi3ls7M13Y5aLG75BS1RZMB2PrvlonKxJGTcZWTGGe2eoUCRasnU8nsLOMDOM
+rszfpKB8qVfwAs5u0AAyyXCZ0AYOYA0Hg5acFuGzYBzlAAAYmUGMpZzMILJ2/qiN1NY024sbYGJ3IEKr
+c+fUuSfLOJkZ6w16D8Trgc8vY5tYm52SphnhkQZ7ZvExo+KaFUAWmkpwWTYGnarEqkGewFu6oBLDx3BQ
+j+pPPbHb35PWXI0Au7F1V/VyhhI+UhiBe2cudu6XdsP29eGtSqLG079fq+e2MvHgF+Ih9LkP1oM/GG86
+vlYn8dX19vrFdXL97OZkfK2eX9+ny7XnuSY6W/nGU6o56BmwpDNoez6YwdVNAG58W7fHBaIevBVler02
+hDRg54WN+DADiVkVzeJGk2PnN40rvCtRdQb56uxm6uGcrBv4ywZeMIUrYYdGYttl4gr2OdYDh2clGgwC
+fLPblFk545ANalJWahXz2nSGg4Hb9oZyai3JHMWSJdVxlJKSpXZKqSnkRePhYJCoKsuoUnHcKe41WyBI
+x3HXmP/NFIJeP5N2/m7wr05vGvH3C/XtiqjVDDd57GL0yh09omiMu/1r5GQQKM9ETv/68cMbsS4Fp1zH
+oRhjI0Uzs7qe0cxpsP88gpjTVv8hXzEYDPalDK4r1EznkPL117A/2ltf63U4OHjG2Uvt9es0vN+X/e+4
+s2Meba/MXim78XDo/uO/xGTsQehC5Y87y9MtuWKMeod0sKA6WzVHmHMTu5r1M4OYP8fG+b2jgNsk4Vhm
+Wq2J/2ANv18AxzF7BYgI0bCqgqY73YuBGyGamCP4AQyj6BaKraBIirWyeOzdgRXTVZxxc9ZasRo5bvU7
+l0kbq2mdTm19vcXwk0WzfO1vmMGnmthT111Nj/2VLERWue3mIEuqL2z4j8eJovqviv4qSYln41jLioa4
+gsejeVHJ0aQ3Sz/0M1KWxV3c63508+9cuEadTK1S1B3XBJN+lKN9rG9X6PdoHd2FpXcCHJiumaeVoB7O
+H/m9+/fBoCvPUZ5mULxSOoeIZDRdi5ym6Gnb9YeJiWnngCWshIttPK61MOw6BdwAj1zP+IzFGvFHuqQo
+L57XP9Lluy9lPPr79bV6jpsNOcEJjK6v1Qm2kdcERstRuOJ4zooDZhOrzDnJbrdE5urcKsvMYitJeW50
+Y5oZUfSCcsU029AW3koU9Fch8xAojXgNuTUHXEWbHMzAeURMxluuwYQLF7Vh19qU3ao4KpDlPYXlIqvW
+lGtcyHcFxZ/f333I4wgd/ovIHOLGjtkHrsXfGN269NaNsxJbHy4V1eqK5TfOkIchDo6vqG4HTkW174zc
+vaEr2tUGz9maaGemuLqVxKnYSI91l299zm5U9Eg870QSR/k1VlsPUaKIWD1tkJ+YNkyHPjUphdJxJYvJ
+nvx5PHw0tyilyGwgUTbY2JCEgjmwSzWSC9T/6Y0HO98ybKJjbP4FvL3TlNL6TPwfki0YJ0VxF8d9GqVF
+6TOUnBZUU4j9egZuLbSD9o0DWkTN1qQ4tXGTDX3H8ZSbOw9mnZHzRY2H1Sy7rZ3w/tzUlSY/maD3e1K5
+3tUJml007eRDKMEEzv4Ez+Hs9PQ0zNj6fJpsDPvNkiEYaynHkA9Wyb0vHgxCFQVp2+7QjLzF4O/p8Ogg
+TUT4XanWPvW5LGhJtSn09qvGqwN5Vzw+ZkhohU1fcIPasjB0HrjR8VAljMurdBZ372qwnhBEKn8PU7sf
+LfbSa7GPWoua1tg3ckuY+hvmh/EYzyJfadEAWrYelstyV45Hcvwda9EwRcBBytYdT/fex7NokF7By72s
+jADpzJg4PIdvTr060EB+InqVkLmKzQ8pKp7HlqAZflzLm8Mr8HXD3Bc1d43DD26acpcPNQv7tun8F1/Y
+8EKuo7la0x6lUXibz557wCNrWbNLrRmk8M3puBfpm7QE16j/SCU4FfSOoub/3gNJfSJsToBpCt9prOSY
+mw9TE/8tKP4thPgNGAchc2oWV1ENVQnmnQp8rtYlzKneUsqhpsKrGzPA0xJJg+ozSNNoUsiw0NoP+mHB
+Nch1Oq9rYOZvSQcLISE2+xlmcDoFBq8gLKlOgZ2cuDU22X8jeaKp0rGtwrIbb4oNx89YTIITOJvC5x7X
+zzVXo/A3hVAU5pJkt1QD0aA0kRrEwpC5+z3KzSWaUUjSRENTG7zepetQoM+NQKFxNpHL7G20Ql9d9It1
+rZ7PsNgY1g/TtS201byDYGyY9KohfY3XxUETiAyVr8p5oXa+8tROuGtePedUEqkDQ+gM6o3BFbxRWEPg
+FgGTg5dNFd6k+b2KiSG4Or2Z2LGuzm7avK5Ob2AWTG4U1I26OXF4ot2jH4NllLOXftrTS/cdD6rH3Hu3
+dOSLpaYnMa04vY+T5+NdWs/FgNsS9q7Yw0Lqrq4xoEl8sjVq88Ypvs5Pxul02CnZ0NbaBdl7mFJ+Qg7f
+PvUY8N9M+n2CaJZt9JQ6lok1o+nRet0Rck+5m8BLTGStqZsb5McHDwqdZjEdyK2jU364HQlfVgWRCVPf
+SUnu4vUYLTJee6N/DWeBZ/C1BqHFj4zTeO0NvLMnn3LU2SN9ZNNS34Nv4szCBoehdsELDaBtKe1jVBSe
+YH2W26TrrsM9wKuL9HtKeS55iSbQTWfcE5rxYULMUNqE/hHQEUKb7jRkzfuio0TtsZrnRkeI6qwjmsCF
+yQ/ibj4yfoSFz0h6HHzHYwzMo51G7voNz7G5tvxNMOfek54jTIKzUcMhAI6PUbmTWY/QwcdHaif/r7Jk
+t72dTFr4rNSg7smezdCI3UJs80Qnso+2HqQt/fgpuXjrGFXn1gZ5BqdugDCHtica6zkRt86pbUL/Cr45
+9dUCuIc0hTNYM15p2mX0MmDUG2XvaW73BxXJjNIWQq6Jjsc1hRaH8LXoY9cTOUQU+AVHYjbsI7MxOI1I
+rW36CGkb+Y8rAAbV1bDSNw07W0+zPZY5h723sOmwV3dqsopDV0QN6rQJswcKkv9nhcYmgAalqH4Rshuk
+/7lFbXWegt8399qbRNKyIBmN0/hqcr+LxzfjdInPks+uq5enp/Ooe7jtvROH2cGH4r6muUlywal/l4ru
+aZM43bRT+k2tG3/oa5MGs8cdTm5Rizh2ckvvPFSUlH+PxzM8Td/WL7dG982pNCuEovtwdg0OCR5zkdtE
+VXOlZXw6afjXqMGRu8bESNxIcgJnk2DU/2EhnzQv0J7glwJfv7FV/D1e6kkuwWP9cV5gkzTv36wPsM2p
+63WP2p6pjCZaVkp/p97rdWG3+/civ3vKNt38vh3aNdG6IN2U/pvHRx2v4eYXFoQOzs8hPG2KDtmenuDP
+F3/5ObFGyRZ3FvUt0cRebk8gAohCMj9/g2gOBmpvzm76f7VN1fY87W+eeuWAeSHmrp70fSHm8VXfHG4m
+cA/2unOE7bQsCOPTbEWkonpW6cWLfx+5Z3h4WfKdipFrk+2zgrq6QpRG46REL4lJ6ci+bsWhRh1/2Xym
+8pRDcIrokbm+tKYwwmcKo/NQgontsEybrmCeDuMn+wlNg+K+qQms7ykB0mVwZuFxrj8LwIfs7mSbpvCR
+KqrrawfzHoiZF9ySAlPAhUkC7SeR3z45prlhRz8QVtiX3Lj4YL8CGu09SNZKb76A+/23YZ0ybvPNIApz
+Yb5OmsIvBSWKwq+E6dGR1UTiyF1wH1rJRxdyZLRdd6MCJsNH1nf3xFoIbgaxAF8SieyOjjCiIqwJY79I
+UQpF89E4zM/3aykoW4TFx93wEIFTAeDHBvkUPlJ3aT2aDg/es1XcvCOn+dOzpNaYh8sQ9VeSPdvZw+eA
+TbQsylX3XjgvNwoOWvs5HlLHXq5ujY9yfWwd2xwZX4hR+7Wi67Fflrjn+q7LDjYd7rB2NExTyGlWEElz
+YBzQ5nEaMvmshq4DzCWAca/2+8X/GgBUVke/NT0AAA==
 `,
 	},
 
@@ -23688,6 +23708,65 @@ JrIDAAA=
 `,
 	},
 
+	"/js/dashboards.ts": {
+		local:   "web/static/js/dashboards.ts",
+		size:    2851,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/6xWTW/jNhM+S79i3sXiJYVo5Z7tags0e2iARVHsNr0YQcHItK1aJgmSEmrE/u/FkKJE
+y1HSQy+xOB/PcGYezmSxWMCPmm+55qLioJjdlx9++PQsTSsKaz7A4nOa1sJyvWUVh4cvzOyfJdMb872S
+igP/23KxMfDwM3p42UuabAazJTBxWj+tIpkTrdKkY03LzRJeYC3YkS/BWF2L3VP4WD/BxZnpN4ycyYbZ
+QbNKE1sf46Pmpm2sx1BM8CYCYeLkELjWUr9uEsVxVhFyI9mmFrslPEvZcCZWaaK4PrKmFofIzCE+fvu6
+BNqDM3HKYSv1kdlgl0H5eXRplc+JOnEn6w3mxXZmCXSnZascxpXPJU1d2+6lsFo2DdemqIZvSsbW3Vvd
+kBzW5KPBhpEcyMe9tcp9NLJitpaC5LBtRYWf1Nstb9qfg/NbgtgVD79Yq75z3dUVygOM133tT70+Q450
+TIPhTFd7KEf7woto5jsPcdOh7B0KlK7SxN+rwFKNOnc6n4GQ0QIZMVq408QCWQYlvFxGUc+bidQzZSLs
+iQAlWN1GNxvYcJUiezaPunEp1lug/8NsXEkSV85ixy0lC6bqxfiOSJYmSVKYtqq4MZRumGUjB9A3GcsR
+nKAEtFuh9uIB3PUpdT+3rk4MJbjf2G1bC9Y0J0pvfcbct6wxvPfCH81tq/FRXNIkcAkU0+xoqM8XO6yi
+916CGxXJnwVn1Z5GvcmBdsEwh8PVq3HXwUp2HjVJVKFas6ekY/oTgTvgopIb/vjt4V4elRRcWHrI4A5I
+OaPtsswnkoZkED/iWx8pBELRHFbs5aAu13BIxwkcit6Bc14DnC81qOIvWQtK/k8yX/bAw34CQfnuCApt
+aXUDJUxpuJi5lKfwHZCFg5+1c9riV7QORfV36AuAUe9KIAX6e80kRWcB5Cc0CFzCXMdH52cnpnqVkF9F
++HD7oYJ/4fJf0M2sB6YdkMTdv+KOCYMrUr1NDxMGWaQKHjcT1EzKgtsDypv1Ecrjl8vcS3RO7xYFMTyD
+D8Pj6jxHo4HgQhVGakuznq85kOG2syPwTe5l6TvTcToc49k4NB7/IekVxR/+dD77ST+Uolfqmj03vCfK
+q3MYebTuHNmfhuUzMiVozmfoii98y9rGDlspVOwq6G/uaeVA1TQi0kUVv58Uh7IEstNM7Ulg5ziHe1LG
+e2YyIKjKgRBXzat1o7mZxkwme3KthlQ1Nz5avz7m98715okxxhU01OLSUymdxZtbY97nlSU2v8Mw2OUp
+W6X/DAAit+iZIwsAAA==
+`,
+	},
+
+	"/js/dependencies.ts": {
+		local:   "web/static/js/dependencies.ts",
+		size:    3833,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/5RXX2/juBF/lj/F9LIA6a6iKOfN/rHrO9zuFb0Ae/fQbNECgR8YaWwToUmBpBK7OX/3
+YkhJlmWn1+bBIofz/zdDTq6uruAvFpdoURcIlfDr+Xf55YNxtc68+w6ufhiNpPZol6JAuP0ZK9Ql6kKi
+uytMhYBbj7p0cPuZZCLtZZSsrKjWUxB6NxslaK2xU3DeSr2ajRJlRCn1agoPxigUejZKtCnxq9SPU+C0
+DJJjmP/QCe1Ho+DVF6O9NUqhdVnRrTnre/bFW8VSuGdvHPnDUmBv1t5XLIVlrQsvjebxaHompBQC8xT0
+Krv9xfvqDu2TLHBMcUWxrIkA5uBtjbNREkSyFXrOrkQlr8qeVjYeJUnm6qJA5zgvhReH+F5GSdJqDUmD
+ORDHbJQk+yAYssd5+JyIBCrMIXwPMkuphVI7zk8EDp4vhXIYRWZdYC0OMB8CQUqehIWlVB7JJB1n33YV
+wnwOTCi0nsGPwLTY4JTBFNhauG9iNWVkxKKvrQZ29WNUMGfwFlAXpsR//P32i9lURqP2vFH/Nqr/TWyQ
+vNvPRvvFeNbUwE9VlZXSYuHlE3LmXYfh7m+Uwoi9MoUgqFkKvNtEVL82uw7ZGB+FVxhlrJvCC9w7L3zt
+2rpdtAvYw5y4k8JKLwuhpsAuyk83k3dLlo6S5FlYHaqbXSxzUb7DQK31ozbPmqg3D0VeRqo2dhMV3BQP
+H2+KhqiRSJ8+faL9fjZqs0dWm7qlZdtlbB7kEhUaqNnt01FL4Y2M0LsUUOGmWQrvrTtGOKrP3jwLX6w5
+WzXprKtS+IBEkrQt1BD5odXHUUUil8D/FMjw++8QV9lvpkTXcjQBBX3JPvySXxluKr/j0U6A41mWnloi
+nIZN/3SNcrX2MIdfhV9nG7Hl7/I8bXZS8+uctj37mUK98mv4M0zycU+R1CVuA+iy7AGu680D2gj4/sBN
+pelgfqR4IyrOdZNY2Yoe8pokwca9zm7LBcxBzvqJAKFXtRI2i9cpf9mnoBv/9j0/CU+yHJOe/bVcoaMc
+3y/G0YNBwx4MvIAztS1wGmO9x+wu7BcpeGFX6A8H38KeDnZUNU2T70+9cU90j5STzKHCwnMC6T5fjKPh
+TFTUlZy5pxXraN5bzgKQLI3oHh9FSFnaYNtYc0+rTl2JS8fG3XYj7CPagQFZ0qXfXcK7S2GteR4wPUl8
+/my2xJnD5Q1c53CdD3gsLv/FUrj+eEyORv/ZhPH+3OEvbSCDU2MlaqIzUXvDhsmiJ3jgQ4jl1zy9vPl6
+naf51zy9GXAspaIHj128f/+e9QBaGltghEiJnal9Fii8lQ6FHO5515JChfHw25Kc/Dfy+4BVC8uiz/6z
+dF7oAvnHvCUXa6ohfjnJO5Lzwno+qGWYUxE19fOTUpxlPdCIo4uUHsVjv1B7tPxQCUpqHCSmUMK5QSkc
+aY1szlvzSHMCL3v9A03rlJk/feUuJpMP4uFDeOdC2mewP1cGl6gDfrVV/GJYkOM+WATDf88HcRzn4wi6
+YT5W/0MyjlQ6v1PIWVFbZywxVibMfh2D0aRFFo/DVMXz9vEQVXU8e9Bf9wRnlIrISVDwsr2ID5dL0qWy
+EErxULRZacWqOQ+zQRuml14dYvC49fycb5RieirKMFTAW2BToBmkzO7CO08U4JFyqwtZovaRaCrUY9b6
+SI9bmd3VVWXROSw/d89ekiQe3s6BpeC6Y3jYtWZ6Il3AzbepNN+FfybOQtpCDQvcvl60p6PZdQ5T+HBS
+qM3tcVZNHIfu2yQt6LFpaIzmFLY4Udc1E/XI5GynXZbCrYW1Yve6+/10UcNN0u+p2XSt1AzOJoigH16d
+dLtfTwZEssqyyQ1uhqW/NNpfhvX/k1cWx7hwF0gvlCz6t8FpRR50USkeool1Tk3mmx7rVzA1SxPB9vp1
+9+Izn20PHrRx7/5Yancqtf3+dak4OZy19cdSu0PgDY5B0luh3dLYzWsKIoei0TM21pa6NI3rHa3H7KC6
++e6bibj9R+I/AwDMOolE+Q4AAA==
+`,
+	},
+
 	"/js/directives.ts": {
 		local:   "web/static/js/directives.ts",
 		size:    41439,
@@ -26344,70 +26423,71 @@ uT5fc23ceGeelShSoIDTTKK/3f7n2sB5XWGMiaBtW9ltFv92L7t9fbKrv0//8DsAAP//sxrzHmgCAAA=
 
 	"/partials/config.html": {
 		local:   "web/static/partials/config.html",
-		size:    13736,
+		size:    14008,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/8w7XXPjuJHP8q/oYe5GdmJK49mP1MmStm5nNplcxXlY+/YeUltTENkSEZMAA0CStRr9
-96sGQBKkaFsz663kZSzio7+70d3ATLXZ5QhmV+IsMvhgxonW0fxsMFKo+S8I+7PBoGBqxUW8kMbIYgJX
-35QP12eDwUKqFNUErsoH0DLnKeR8lZmVwh1NZ0hfE/jmzRu3fstTk03g6s2b/zwbHM4GZ4MRS/AjptxI
-ZREFK0II/ttt0ZhjYqR6r2SZyq2wG5e5ZGYCOS7NdUOw8gQ49P1cVHQYLsX3a2Ok0CFECyLYTRgqiBU9
-dm+8sJu9vB7iI+7lBtUyl9t4NwG2NtIBGP8eMmPKyXisDUvuq0WjRBbjf65RE2g9vvrj2zfffvvV1Xib
-7WKmMF5IabRRrIwNW+g45brM2Y6LFX3HJRMYp3yj4y03WcxFIpXCxMRWvjreZijiteZiBUv+gBoMLzDn
-AiFVbEvDzECKS7bODdg9sM04mQlbANeQ8TRFAb8fWwEQxkQKg8LAHEYVAVYSnrAJLHKZ3Ic6fdOViQPa
-Gn0IRg99uEhtG4epgtuI9mzwu0SKJV/dsg3eyJTlMCroT5xylstVaHHfBAZWypIoqDXpl3xbK3JtSFYT
-EFIgvOJFKZVhwlgAZ9Ox9aj52TRnC8whyZnWs6hrtRHYZbOoZarWJqP5/6yLEoycTMcWxvxsmvJNBUnJ
-LflnOJRWrnCMRaxihSUyM4vOyckvc67NBXAB3GBhPX0w9abroS2MgIURcal4wdTO/n7QUCGJjVytcox8
-zHB7I+DpLCKQt56ECFJmmF/ckGgRDvZ7uxsOB5jyCu+SwZLFCVNoYrd0OuaWvrFDMgf6WOddtuMCxRra
-fhiBkla8KNYO5zTnfqxUqFEYRsuj+ZSRWXOR4sMsiq/CjcRPBIapFZpZ9HGRM3FvRZopXM6i/d7jvJPv
-ZaL/Tiz9fDg4dCGP72WyLiqMRxzjg0ElWB7nXNw7pqdj5mge57wmvuKab3iKihYGkz2chbonTkjppH34
-BDZwf7+bDI28NYqL1fnF0ErimPdQMmIVJzlP7meRTpTM8zvpbIrWXlxH8/2efh0ORH5F3XS8zslcxynf
-dMy2MrQq0jhDs2h0Jrez6J9rntyTM9xZFdgZoqd/xpNWT51fkIxORQutI6BjvTZsVGPeHLrBJaQh5cvl
-Ozt9fmHH+XIWabbBHwRb5JhGc9r25cQ1mDYs5ykzSMz+5H+/BFzyq1yytOZi/t6PeOjVn05kapTnKeNS
-/Ih6nZtu0EpkHuer+Oqti0HBDMtRGbD/0slWcK35giKOJc8u6QKH2QyGlv8hfAdDt1evkwS1HsKkGkmZ
-WKEaBobUR2UTEdshriI8lxp7dFDDmA3JmXTJxPw1naz6ejq2X3Ugs37hlXSiOMl6vkiQodgIyDv6SQrd
-7xuYh0PIdgupXw+vZpUUt0wJLlbD7hHwmHwaNL+JZNRaEDnvMlJu2u9uX2R61lii+V2G4HGA8/m1cpE8
-YxoWiAIShxs0FwnCTq5BG6YMpkDJLRerkRUvIfLHfiuJuK5lV67z3OUCPtqUOUuQzo5Z5BLXKgpxQ2A+
-YF76EZ8W0bHUlgitORwiWOjYpzZOBe1TqEo2KWZ6lTg1fKk+PjCdeYN9MZV4yyPbPcLSNuHTLPMIyGw4
-vIYVmh+bCXuMvIjJViwbbWsrkgNIEbtCaxa5OsjXXXRuuK1DNzCs5bLmMUtwFu1Bir9KlsIEWIL0C9NL
-KGSKduBGpngJJsPCfd/Rr0tg6YaJBNMJ7GEphbnlv+AEhldflw/DSzvyJ1bwfDeB4Q2KXF7CjRQskZfw
-Tgotc6YJh5C6ZAkO4XCISOxiFRPinBRJHvKRSsng+D1JLv2msJSqqE1VqiLmgjzHB+pgn51cKbkufQbW
-zsDJPxSBpsFo/iclizq/tqu5KNcmqISjFmC/vc7bXVXwX5QkNcwvlSzeM+NtzLofpf7mL8Kg2rCctGo9
-OpN5imoW7Xa7XVwUcZpaMQ4GVMwxgyVP7lE5x6bvmGigRM5uuLmhDcGsI1rbVC56WXbueHE6Ox8+TG5u
-vGaq8PErdHQnX1xDRv576OdU8bwIy/9KHVZ49OOqFOtigSqCgotZ9JaYwXIWXXW4+iNx1ct8wyqvkD3B
-rdOjWFFqaY+iWfSq8lv49AleVSZipMwNL8EftX+zVIJcAm5YvrYZAB3/ZksZgD0aSiZGLye5W4MlvK9y
-jfPi4kQJXv0aCaYeX1eAFR2/QoCWnwo+FaMFF2uDjQxr7bWFaM9Xovf5HKTdU/GdPt9UefKQGZe/5Vnz
-Q8F4/rj2kKZPcey3b9qe7Ta+XLjFosxJiX+mHb8+9HbJNR7+R0fRkX2ggW3GkwyUq+mMhLVGWEpFaQxU
-22GxA43G2D6lAFmiMDpdgIUK5+yegWErja7NRTtdcCbXvZ9tLouZHAEl9UuutKmQERaHnXboEhO+5Jh6
-qFwDA71eaLRgWLWLayIxHcFfliCkH9XAFLbW94C0qZknL6SDa0gyqfFzI8mTPbww5zWoDeW0d6gN7Peu
-ZYjpR5thHw5hWjsIaoDTHLBbBHxZms/FUjq+fB4+Go2+oBBBpaR6hoJS4RO1X9MncLBq+864wdimwBMo
-FcZbxcpryndLhZ9Boa9nRjmKlclgDm8eJzds523Jsv1mMIol9+QU/2FbdScVUA1j20ez9KbRKtgGBNvY
-uwZLYM6DpsLedeEn9nZgNoOh94LhwbYUM4XL0PxoUbMmmrvCSwc9w2fAV3HgSfj1oiasfQYGfx3yNIZq
-UTS/8z8bDK7rGWohuLno6ri6MYlOpalSsC+rj5bA69dQn8ivX4M/kI+iiC++OqO1ydXXQhEYHdNHTF/z
-ICT1Bqd+sMRh7mNWaMsojNqRPdMPji5144IbsrsCYWYndqN73F0Dqz8pB/Ms9eABh813O6tlPfRkyFJy
-IvdlT6IwWCYyz1mp8XxoCR/CH7yXXdQwB66ZUjsUEV03Udprepo89TY2+sC1kWpXBYM/wBBwQ2fbsAuu
-EXsvTwuZ7pqeSya34u9d8n8OyH9ER/WC4xWhodoB1yPcjG4NM2t90dLvhnRbcxeAfVwb/RqwSywLJVMo
-zMixQoKKG84urXlcbi7cldR+f+K++t7G05Z93SbNmUa4ZOBDQ2uszxoI0wQIVSWhI/t41kh8T33kSjnv
-jq0BFGk9+INIfc0n7a3SLDJqfWyVzpZYi+9x9nWootDSTja20yT+c1vgPqA5Rl9tRqkUtqllswj/YzQa
-dSk6ijyhtOoMoH95OxcI1pyaFVQYTs0LBo8KtmfgxJj2jCt1OMu+6jXr2/XiH5iY6Tj76mkyH7GAOjlv
-CUc7qNH834zb72W6e0FW48wUOfFrfeF5Zjvf7c92bA8+gt89B3Anh/uC/CLIqs5O1MdzejjV2vrTiKet
-69i2mh+/Je0t2/l8wr2tdCyl+ZF9Pf/vDeO5vRz4iSlOP3QVlW1gqkWQMsOOao7P13yQrp89ksh1g6Yu
-4m8rORlLaoOwOSWnhkRa27JRjZGbzCbN07HJWoPvFDc8sd3Czsz/ucrleOJvVNz3bPiBInNreDquSaDh
-hrapIX0EhIb5C5XwXIBGo4OkyaSBA7s8YBb9LrRPNKOm79p+NVF3I+tcMjyFp+MA+NSkbZiVhODTJxgO
-W5e74ex3MPSX3TABe+v6JFQv3X6g1eR3MKyufk8C6jTTD9PPfQfD8Jb+WZBWqf0Q3dSTfLcNoNH5dGzt
-tj+i9iYYjxpHj6fU6QXllJST2kpqv+/mnWQtE9uRcZbjLjQpLSxYns+TyjWqNZWuD4fLqhdQz3mV0ZRw
-/lHNOMHThE1d6nErvsNhOnbYPOr+rlL4iES7Fl/GU9coHvl6vmX3mdyeazQXHf+4zeTWKpMWOIU2PeVq
-QdS6aQ0z1L7QE7xdCGg5KSD5rmcnjLiE/Ti6PJQKteZSHE05pMehTRbl2j3N+pyoFFqb8rbWkrErlX33
-cAbKT177ziWN/LlV0LX5TkHJLVUF9uqlVs8vW31uAVy0FImm6qZYmNWSY6/tBeqIrGqg/iKyteYShsM+
-6L7c8GvJEaLaP05a3MTu1on6Dy3FebBw9BPL13gR9jVDKO20/hTEVKglgSG0ZkehiTwauarzKQBN3t4+
-m3w86JJ0Ct8E7FG+HaZn+H6MRwv5JB5Pj87tnuXZ2fRVHMMt2yC4F79x3G7D2Zd8sGQpuv7A0SO+vreg
-7r1wt23XekvsPlha8PrpaepffR6lVW5t0Azsm6ZAgKrT3X/qVYu7bXbP5eoXi5RAuuudWfTOrvNvf+yE
-e1xdNQcef97Saoc48nynjERY7Kzo/upvLUn278JnUnXA7s+WHTyXEB81De09RyYV/0UKw/K+ft/RVcjx
-LZc9ht9C57rrfzUqwQp8+7Z11fVktkuza78vtndi8+m4M/B07fYytN+g1myFn0e4wQfDFDIbnGfR1Ztn
-b4MLh8b6qd/8+aWpJcvPtusXvlyGweQp+1hKaU5zhyeuvlrPcuc/4lKhzuA9Xy471n4K/Kqa7HW8uXW2
-L4DaptrlnK3XfFSykb82z1zbOXkvOU5N7ReZzftkf/96l3ENW57nQNPARAoKc8lSe0npAiXwZetLg338
-au8/2+NCGjcH51JV20jU9tVk+8HkhqP9XyfcXIRQLC0EZ4GWpNTSxCqq6umMlSUKTwPX4OXMNewwz+UW
-zr2ILpo71/aDzh6aeh5xgqz25gglWyHERFrCFC7XjhQjQW5QbRU3CNJkqIYVYD3ywZGY+BHdU+r+u9ae
-o+3/AwAA//8TpxS6qDUAAA==
+H4sIAAAAAAAC/8w7XXPjNpLP8q/o4d6N7F1TGk8+tk6WlLrMJDt7td6HjC/3sJWagsiWiDUJcAFIsuLo
+v181AJIgRcvyxKnNPMQiPvq7G90NZKrNLkcwuxJnkcF7M060juZng5FCzX9GeDgbDAqmVlzEC2mMLCZw
+9VV5f302GCykSlFN4Kq8By1znkLOV5lZKdzRdIb0NYGv3rxx67c8NdkErt68+c+zwf5scDYYsQQ/YcqN
+VPDQXhFC8N9ui8YcEyPVeyXLVG6F3bjMJTMTyHFprhuClSegvA8GO1xUdBguxbdrY6TQIUQLIthNGCYQ
+btVo98YLu9nL6z4+4F5uUC1zuY13E2BrIx2A8R8hM6acjMfasOSuWjRKZDH+1xo1gdbjqz+/ffP1119c
+jbfZLmYK44WURhvFytiwhY5Trsuc7bhY0XdcMoFxyjc63nKTxVwkUilMTGzlq+NthiJeay5WsOT3qMHw
+AnMuEFLFtjTMDKS4ZOvcgN0D24yTmbAFcA0ZT1MU8MexFQBhTKQwKAzMYVQRYCXhCZvAIpfJXajTN12Z
+OKCt0ftgdN+Hi9S2cZgquI1ozwZ/SKRY8tVHtsEbmbIcRgX9iVPOcrkKLe6rwMBKWRIFtSb9kq9rRa4N
+yWoCQgqEV7wopTJMGAvgbDq2HjU/m6Z8A0nOtJ5FSm4jEKtYZ3I7i5Y8Rz3KUaxMBnO4IncLVycyj/NV
+fPUWllIVMReEjhYNpjlbYA4WwyxqWbk152j+Pc9xMh3bdXaHc5cKtAVIAlQyBy7KtYl1YUkrZIq5o81+
+y9Ka3ixaEhWwBC7AEm5nk4yJFc4iB52QntPkRTSfjt0Y8TRO+WZ+Vv1xtHtCul4cHWPqf9ZFCUY2fHVl
+2xFg6oHCIRaxihWWyMwsOqegd5lzbS6IOW6w0E7K3pU9tIURsDAiLhUvmNrZ3/caKiSxkasVCc3FULc3
+Ap7OIgL50ZMQQcoM84sbEi3CwcOD3Q37PUx5rSsGSxYnTKGJ3dLpmFv6xg7JHOhjnXfZjgsUa2jHpQiU
+tOJFsXY4pzn3Y6VCjcIwWh7Np4zcnIsU72dRfBVuJH4iMEyt0MyiT4uciTsr0kzhchY9PHict/K9TPQ/
+iKWf9nuHLuTxvUzWRYXxgGO8N6gEy+OcizvH9HTMHM3jnNfEV1zzDU9RRfPWZA9noe6JE1I6aR9+AXuQ
+fbubDI38aBQXq/OLoZXEIe+hZMgRcp7czSKdKJnnt9LZFK29uI7mDw/0a78n8ivqpuN13vhGy2wrQ6si
+rzO0IHD8a82TO3KGW6sCO0P09M940uqpc+udp6KF1pHYsV4bRqsxbw7dYBvSkPLl8p2dPr+w43w5izTb
+4HeCLXJMozlt+3ziGkwblvOUGSRmf/S/XwIu+VUuWVpzMX/vRzpR7tGo7ynjUvyAep2bR6O+i0HBDMtR
+GbD/pZO+4FrzhQ/TfkkXOMxmMLT8D+EbGLq9ep0kqPUQJtVISlFcDQND6qOyiYjtEFcRnkuNPTqoYcyG
+5Ey6ZGL+mjINfT0d2686kFm/8Eo6UZxkPZ8lyFBsBOQd/SSFPjw0MPf7kO0WUr8eXs0qKW6ZElyshtGJ
+8mnQ/CaSUWtB5LyzR3Ta726fZXrWWKL5bYbgcYDz+bVykTxjGhaIAlx6kILmIkHYyTVow5TBFCjZ52I1
+Oht4RP7YbyVV17XsynWeu1zAR5syZwnS2TGLXCLvxw03BOYD5qUf8WkiHUttidCa/T6ChY59qudU0D6F
+quQ7mtcqcWr4XH18YDrzBvtiKvGWR7Z7gKVtwqdZ5gGQ2XB4DSs0PzQT9hh5EZOtWDba1pokB5Aith84
+i1xd6OtQOjfc1qEbGNZyWfOYJTiLHkCKv0mWwgRYgvQL00ugvNYO3MgUL8FkWLjvW/p1CSzdMJFgOoEH
+WEphPvKfcQLDqy/L++GlHfmeFTzfTWB4gyKXl3AjBUvkJbyTQsucacIhpC5ZgkPY7yMSe5NQOw/5RKV1
+cPyeJJd+U6AUvpXOB/VBa5+dXCm5Lt3coJ2B+yogtoPR/Hsli6BuGAymtjwIOgNRXxFR5+2uSvovbFcT
+ShbvmcFOzWD+KgyqDctJq9ajM5mnqGbRbrfbxUURp6kV42BAxS0zWPLkDpVzbPqOiQZm/IabG9oQzDqi
+tU3lopdl55YXp7Pz4cPk5sZrpgofv0JHt/LFNWTk70M/p4rnRVj+d+qwwqMfV6VYFwtUERRczKK3xAyW
+s+iqw9Wfiate5htWeYXsCLdOj2JFqaU9imbRq8pv4Zdf4FVlIkbK3PAS/FH7d0slyCXghuVrmwHQ8W+2
+iMJ2lOhYGL2c5D4aLOF9lWucFxcnSvDq10gw9fi6Aqzo+BUCtPxU8KkYLbhYG2xkWGuvLUR7vhK9T+cg
+7Z6K73z6psrRQ2Zc/pZnzXcF4/nj2kOaPsWx375pe7bb+HLhFosyJyX+hXb8+tDbJdd4+J8cRQf2gYba
+rkkGytV0RsJao23HmQyh2g6LHWg0xvZtBcgShdHpAixUOGd3DAxbaXRtLtrpgjO57t1sc1nM5AgoqV9y
+pU2FjLA47LRDl5jwJcfUQ+UaGOj1QqMFw6pdXBOJ6Qj+ugQh/agGprC1vgekTc08eSEdXEOSSY3PjSRH
+e3hhzmtQG8ppb1EboBZWjonB9JPNsKl306S1g6AGOM0Bu0XA56X5XCyl48vn4aPR6DMKEVRKqicoKBUe
+qf2aPoGDVdt3xg3GNgWeQKkw3ipWXlO+Wyp8BoW+nml6428eJzds523Jsv1mMIold+QU/2FbdScVUA1j
+20ez9KbRKtgGBNvYuxdLYM6DpsKDu5WY2NsS6sN4LxjubUuReqWh+dGiZk00d4WXDnqGT4Cv4sBR+PWi
+Jqw9A4O/HjqOoVoUzW/9zwaD63qGWghucro6rm6QolNpqhTsy+qDJfD6NdQn8uvX4A/kgyjii6/BoN/k
+6muyCIyO6YNa1DgPQlJvcOoHSxzmPmaFtozCqB3ZM/3g/r6FC27I7gqEmZ3Yje5wdw2s/qQczLPUgwcc
+Nt/trJb10JMhS8mJ3Jc9icJgmcg8Z6XG86ElfAh/8l52UcMcuGZK7VBEdN1Eaa/pafLU29joA9dGql0V
+DP4EQ8ANnW3DLrhG7L08LWS6a3oumdyKf3TJ/ykg/xEd1QsOV4SGagdcj3Az+miYWeuLln43pNuauwDs
+49ro14BdYlkomUJhRo4VElTccHZpzeNyc+GupB4eTtxX39u4f9PsyzZpzjTCJQMfGlpjfdZAmCZAqCoJ
+HdjHk0bie+ojV8p5d2wNoEjrwe9E6saFtLdKs8ioNfZira6a6s/sy1BFoaWdbGynSfyntsB9QHOMvtqM
+UilsU8tmEf7HaDTqUjQ4cgMxqjOA/uXtXKD5d3JWsBk9Ly8YPCrYwVG+jsW0J1ypw1n2Ra9Zf1wv/omJ
+mY6zL46T+YgF1Ml5SzjaQY3mvzNuv5Xp7gVZjTNT5MQvTZ/AbOe7/dn6Cj+C3z0HcCeH+4z8Isiqzk7U
+x1N6ONXa+tOI49Z1aFvNj9+S9pbtPJ9wbysdS2l+ZF/O/3vDeG4vB35kitMPXUVlG5hqEaTMsIOa4/ma
+D9L1s0cSuW7Q1EX8dSUnY0ltEDan5NSQSGtbNqoxcpPZpHk6Nllr8J3ihie2W9iZ+T9XuRxO/J2K+54N
+31Fkbg1PxzUJ03FI29SQPgJCw/xFo6EMRqPRQdJk0vlZJw+YRX8I7RPNqOm7tl9N1N3IOpcMT+HpOAA+
+NWkbZiUh6rQNh63L3XD2Gxj6y266/bYZ5DGoXrr9QKvJb2BYXf2eBNRpph+mn/sGhuEt/ZMgrVL7Ibqp
+o3y3DaDR+XRs7bY/ovYmGI8aR4+n1OkF5ZSUk9pK6uGhm3eStUxsR8ZZjrvQpLSwYHk+TyrXqNZUut7v
+L6teQD3nVUZTwvlHNeMETxM2danHrfio/eOwedT9XaXwEYl/Tpfx1DWKR76eb9l9Jrfn1JDr+MfHTG6t
+MmmBU2jTU64WRK2b1jBD7Qs9wduFgJaTApLvenbCiEvYD6PLPb210lyKgymH9DC0yaJcu6dZz4lKobUp
+b2stGbtS2XcPZ6D85LXvXNLIX1oFXZvvFJTcUlVgr15q9fy81ecWwEVLkWiqboqFWS059NpeoI7Iqgbq
+LyJbay5hOLzojwlUbvi15AhR7R8nLT5rpfo1gf/UUpwHC0c/UpfhIuxrhlDaaf0piKlQSwJDaM2OQhN5
+NHJV51MAmry9fTb5eNAl6RS+CdijfDtMT/D9GI8W8kk8nh6d2z3Ls7PpqzgGelwH7gV0HLfbcPYlHyxZ
+iq4/cPCIr+8tqHs/3W3btd5Wuw+WFrx+epr6V58HaZVbGzQD+6YpEKDqdPePvWpxt83uuVz9YpESSHe9
+M4ve2XX+7Y+dcI/Nq+bA489bWu0QR57vlJEIi50V3d/8rSXJ/l34TKoO2P3ZsoPnEuKDpqG958ik4j9L
+YVje1+87uAo5vOWyx/Bb6Fx3/a9GRe2Zt29bV11Hs12aXft9sb0Tm0/HnYHjtdvL0H6DWrMVPo9wurdj
+CpkNzrPo6s2Tt8GFQ2P91G9+fmk6mFY/uvULXy7DYHLMPpZSmtPc4cjVV+tZ7vwHXCrUGbzny2XH2k+B
+X1WTvY43t872GVDbVNup9ms+KtnIX5tnru2cvJec+so+eJFZC6K6f73NuIYtz3OgaWAiBYW5ZKm9pHSB
+Eviy9aXBPn6195/tcSGNm4NzqaptJGr7arL9YHLD0f5fONxchFAsLQRngZak1NLEKqrq6YyVJQpPA9fg
+5cw17DDP5RbOvYgumjvX9oPOHpp6HnGCrPbmCCVbIcREWsIULteOFCOBHlhuFTcI0mSohhVgPfLBkZj4
+Ad1T6v671p6j7f8HAMZOkdy4NgAA
 `,
 	},
 
@@ -26429,6 +26509,47 @@ gkzP5zk4Pek30J8x2aOG89w7qX8HAAD//zYcprRGBQAA
 `,
 	},
 
+	"/partials/dashboards.html": {
+		local:   "web/static/partials/dashboards.html",
+		size:    2931,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/6xWS2/cNhA+7/4Khgc/CktCjJxcSbm4SA6xa8R2CiMoCmo1K7GhSIKkdm3I+u8FSb2z
+RrdALxLFGc5883EeistLxIugpDkkOCe6zARROU6v+6WOo/IyXXd6uhT7mV7TDB/hAzUM0OsrGrduSQVt
+602s45zu0IYRrROsxB6PBkEpoXC6Xk1VNoIFrAjeX1rBKpYKeglhoAxyzyAnvADlbGWU570tpM0LgwTv
+S2og0JJs4ApJBcFeEfkrTuNIKrAOo5zu0nX/ehMhEySnvPgXjBPJFCPlW+EUVl+8mTAMrbp3ejSGgVZ9
+BIzh1LvxWMiAF6bEb2O8FWiijq5hSzkgU0KF9tSUoxBp2BgquEaUWzlSNQO0EXxLi1oRK7pAQqG73+8f
+/HkjUEQkjSb2ewJWsSEZG67Xf7hnsBE8B64hP8hDH5DjNjYlkNwtV7FRfmF3U5uFcWTKyZbL1cXevajV
+ZrIZR52VOBpNxyYT+cvgxaJSIIGYBOeWi/klea08jYmrMgXbBE8Y+MhJBUnT9IWC03EdRySNI5NPrFih
+A962ByQeftvGWhJu3dFtgvPwUYPCKTprGr9u2/M4sioT69NI+/DiyN3A4fw81ArWq3grVNXfol0HlDPK
+wd9dnVXUJLiWOTFwdv5TxbgDhRK1xFNWdzNWw29EUYurozdmJAOWNs1uoM3vOKEGBhszc7AR3CjBEOWy
+NoGunK9K5MASvCNKf/eG/nT7QroctxKGtkIh+6bcvmoYVTsSvbYXJhinv8SR3+qo9WjSSeW/Ff80tGti
+YBaUQ47Mi4QEG3g2+Oj4LPEYSUY2UAqWg0rw09PTU3BzE1xfX6ActqRmBnGxx/8V5QOt/i+UhlZLlJ8/
+X93cXN3fL2BltTGCd058fg1uMsNRZnggFa2IenFrXeH00aVfHPmzzgxZnumJ6M6Mpds0ElRFGOU/bLHe
+9R+2Vm2d2NgWrVn1ZHZl06e1nKf1HeHANF409KaR4R80N6Wdqu8vrU9HbvkhtaJh4Mpx0H7wGp032wJk
++PAiASUJOi0UkeVpn6+0Knp/tLLItBRc011Xr2rjArbAHr9+OZMX6FTvitPztsWIMOOEQ9/yFnVFGJs1
+u4UByTsDhqgCTIL/yhjhP3B6d/sJaU6kLoXxnc/bWq8mF37MTwDddr8A+rucVPLk32AiOPIn4fgBNeH7
+3cA3OjlBCnTNzOi6HyDjyJrMLLuffrI1NplQbvOb7S2TzaFxz2bUbEgtp5SyibeAE37133j0ZWeKIYU+
+U6FDcj6bOVbhQH45Sk7tFFOhg4peEa+rDNQxp+FZqsXhv7Xgs6OzgIcgx1nVJ2Fv/ifeZ5PYevxoH0nT
+ZEaQsyUvvz1Ldd62J7Zn2DlNDLTtie1QSdMY6nPfKoHWVPBF5h7+wftnAEMekC5zCwAA
+`,
+	},
+
+	"/partials/dependencies.html": {
+		local:   "web/static/partials/dependencies.html",
+		size:    772,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/4ySwW7bMAyGz8lTcDrPDpbj6vgw7Fj0HVSJlYSqlEAyNfL2g+XY8A5FeqFh/+TPDz89
+xPP4FyuSR3IJZTjF83g8Dj59gstW5GK4TAYodBLLdDHIXNiMx8O+xZXc5dD9Os/CYaiMq2IzskKrnbcU
+kJvXayK/eoHoLePFTDEpdlKtw99QGbuJbX0y43CqjPPCk0+f43F9fEmYi/WJwgPGnbJnTPRWWsPhebHp
++35uX5Z+myGwrfFRSm3Nn3xFQB9QwDIuFAIarYJvd4FCUDQi37WfEBhv9xG1QcCSB424vFyrKKP9gPI2
+f/yY6Q8vxd/9XcmF0cPrrY1MhUVB1OpV7hOJoVQkSOSSR1J5Am8logfaXORaK6PIYmTBcdLkbN62L5nV
+LektmB8tmb4B9RkpaDRf3+GlgN/9nP16hsVUpdvUW9eModU1f8iJ3i9m5n5O9G7Gdf7/M/4bAAlWvhQE
+AwAA
+`,
+	},
+
 	"/partials/errors.html": {
 		local:   "web/static/partials/errors.html",
 		size:    1698,
@@ -26496,67 +26617,71 @@ tM6/kdM8pM04FkmBDAwZCxvGNxi7uVtwftev1p/6nj9N1kDHwQu2qne/1pYF/nGeNL+6pyfPVwAAAP//
 
 	"/partials/graph.html": {
 		local:   "web/static/partials/graph.html",
-		size:    16933,
+		size:    17843,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/+w7a3MbR3KfpV/R3jgmcOYCoiW57mgsUjpROrsSxhWTTkpxuVyzu43dCWdnVjOzeByC
-/56ax74AkOBDoq2cvkiLefT09LubPROlVwxBr0qMAo1LPU6UCqZPn4wyScp8xMk81CRWsH765ElBZEZ5
-GAutRXEKJy/L5XftsBZlM7Z5+mSUSlGmYsHDAnll94s5yhkTi3B1CqTSwm1ehjnSLNen8PLZM797MrZ4
-TZ9OUjqHhBGlokCKhcGsO5QIFqoiPPnGTDyZzIQs6inzHVLOKEc72dtnJzMpqtLNPZlQXlbafj5piRG4
-ge6mRHAtBQN/8jd+Cc/CQqTIokBpIuuNJSMJ5oKlKKPgojOhhWCalv4H1Qyj4DXhECOQWAlWaQQhQSIj
-ms4RNC1wBD91fyqYCcbEAnSOYFAjGiakEBXX04lZABWnehqSTIzg0vz+mVOtgEiEQsHgnDJGFSaCp8Nj
-UDBovgsYFJRXGofHkMMgF5UcHkMKg5SshsewgMEC8Wp4DBwGheA6Hx7DCgYrJHI4glc1/hYHqoDyLoqr
-1Wo1Pj8fp2n4/fenRXGqFBzDIkeJQPgKxMyutpsHcaWBCw0p0TiEkkitIHFkEgXVGtNRS/8rXBlxiwL/
-MfgS58j1sCY5iSlPcRkFL/2Ik4pxSucfXj52xAJ5uk8o3jTDe0WikhK59qScQcwIvxrBZ0n5nSUlrrQW
-vBaWWHOINQ9LSQsiVwHwLEwYTa6i4D8qlKvBMIAuzKkdnYwdlJsgpjgjFdMBeOEALxcXC6qTHGLUC0Te
-CgLh6bYkuKWUZzCTomiXatFfCVJUPFUNSUfdazggRjTUzmU8Lmayf6Wumc4xuYrFcuciryotwLBAkaJk
-CJUymJJ5Zq9icFnQVOdGMkq6RKZqrqdUlYysMAXrp2AgMRFFgTzFdDiCH+coJU1RWUnpHCBKTQVXjhgG
-0HvDC4iROediEGckRua+vd57/9heo9Vr48dStUUTsPc6a451cMct4P1W51oy/YQziSoHnBtkZ4ZnTgfV
-vXCWDtw20v6UhyL770Z9Gf07ApIkB4WSojLy9iw8efbsn2sOFmQJc8IqrAfcwhFc5sYUKMiRlbOKGXMA
-c4oLIxmJkE5qBYcF1TkUlTma1bsNrJTOZmjNZoFa0uR+NOL1Lbap1FzvoXS6yMUCXnEutL2PGsH3gqWg
-cjrTxsxxiGWlrOo6GdcCEonE6jmQZqPTVJWLRRT4UXzDScwwvdfNDaQOWs4O5IRnGAUK9UV/escedK90
-SxKVFWOhNDFg5yqVZDX6WoWlKKsSKsncRP/Iybhe4TeQaw0pz8Jc4iwK1utKss3mq5Jn0ajkmQEpM9RR
-8Jv1sltH0IJkOBmT/i0mY+OrTEzqRur/bhW0/sXFrBWrxx2XOZlDHXX7wJVRg7fEEomOAm2soTVbv5Xe
-SNvta5IYa34KX1q0IYrAfmyC6YSAuXXHoivUP5jJgVu810PBeu1BfQ0nm425/WTMaI3THqiv0vSSxDsi
-8XV362RcsZZi+wjz/N7BfB2Vf7ujbO+ALKmCgnJaVMV3wJDMEbAo9coaGGPGRz11aZGyAV1oB4PpysDo
-irRFZZ9u8aqIUQawLzoEm95EgfVup2DsYlcFC8q3SOg1qdGeA1HrYUKQ5cMJQZYfnRBkeUtCPEgV6/yx
-M6NJbJFErnclzkyWhOPt1O9a5W3JZpbQWRTMKNMoL6qyFNKfu08TciHp3wXXpMlDX9SLb05j9jDVbYct
-7p5b19lj77Zfczv/3ELuc93mR/t53vJXj5yPDiBWoY/NoqCwklgYUtUuvOuE/ob6kmT/+teVQ7I1YYaI
-nOobVjBamPmTZ8/siQZTkiNJjVKHDHmm8yjYnmvl73lg9WMmkkrBTYlES7FxqxRbPz4En7a1+9IEVqhz
-kUKlMLUhgyhiyrGNlGy078MlyrUAAibq7s/4MCzGnMypkJCTskSuXFyiSkzojCYm8Rz8aejCOJcv+mTL
-MlCTTI2C6assk5gRLeRdxUkhw0QfliHSnGDHGzkiWQZEgfnPmrYsMyLVru6F7c9NHOFOvD/3bHxBU2zy
-goexs80gXNJDNHEsm+ECpf1dCsq1Mhn/+4pKE2jnRLvV7eYF5alY+HCbKiBMCSilmNPUJMbT9px7sqjL
-jbSvrXqkUJ/ZOPEwb1LV485ezv+xWXZZlyQ8zfv5rRYjeCsk4NL9PslhISpmsnZdSQ6k5akli0v4clFJ
-iFdQojSIm4xgi8Gziic2KRJzlE4EzCZfzql65Zzi45Rzgul/2Rs/gs9IlaHwdVLWM9cfx0h33f5RTlSI
-Ugp5dApfJISb3P+X2rH9Cl99BXqUoqRzV2yJIjgykna0eaCk/TCzFS+F2ghZRqoMj0Ebq+2ESQExEhM6
-9oI0iaOYgaNYUzuq7ccPLgmvTTlbkJVxDybhVAgDXCZYOpmUqNCIk4EpGDMSp4bHxt9AIiquUW5DSwiH
-zOmBW2dwGcErWBDJXVrPhDThEk2INsdXWsCCMgY+cXOOzAEPpper8oMYqoYpe0Spmds2XNKYLekoYUyW
-uctvfvbxLJbLkXcEy9Po6IGi9QpKoaiFSrnGDA3XS8N4rmvr4zMI71Ssv8+xw6TzrezgjqbgxqShy0VD
-/x8d+Uf+9HOyvIVyNxnDk1vG2H8+FGN3A/0rIxtKSI3pb5pkV+oXF4T+eoA13xxgzRtzQQPb65YJBmzS
-4MItMP5nRqXS9fCg8Q1GY+2uodMtkmjrgsGljPGqdSOhq90VRCe5W5wIiao0hsRFjE2UWJci6/NqSdAk
-cztr9HI0pqNiWvUrft8B5UqbQFvMIEOOklghI8Bx0ZQP7bUMIEzrMQs9xiags6HMen212cBgvdYkm6tf
-viyJRK5Hnvi/XP06cmH+ZjO8hXh+s8eEeEa8rWmlhUHChts2knHkNNS0+I5uIcNZ/NauVQY/I/99kzMz
-bJpZ6DPDege4F72+OGRYri+03N8P99B2SPUTOT134jC3f9q5hiE3Z2LdjI1nYUqVrWxGwRe1izXqpSyc
-Ljnu4fk/mABwwcO7CwH/26cpBX28P30xuM5H+PGdms0X8Llq8wes2nwuwXwaJZg/Xgr/ueqyy+w/Hpc+
-F1p+50LLR8iwDvrDz4WX37XwcuFc1idWf/mHKbm8dl/w/6/0civD8NDSy0GPQ7JasTpFDteOmZM5dpuR
-SrRqH6/gf2GR07qK0nifbgRo/Q9JchAcHTQbKcQIf/J7W7uyd18plKIx82r/wYsgD/UiTWL2++WE98r0
-+u0Fvl3lxu6C1kzIihsDu6/fgGX7+g0IQ6nB/htSPhMWlsljO7DuioS38vdDot7c4tGAuyse1knfD4vU
-+ATZQcLDuh0Kh4+kaRQkOZE6AK1C14FlIsMocLXKoNNo17TXUcG746o7oZoZDJF7gdxuywNDl7AHYafr
-zj2KiIIXLuvVROr6cQHEaEnB0wDcGaFtFYwCLSsMjJ74HqKCLH0TTdPP2G1tvDMn31fW9BwgbP7CNpBR
-VJNx/sIOaYNlp32G1X1cOhbpyqudljsneUMFUzhpCis6nb5ibDLWaWfEN6NFwRiXpfwX80+0XsdakIH5
-vsSlHm4267XtvTHB+mZzU8vft8H0zdK4X0UFd+1r1xyXCD6j2XUHHjjjp4phH/pkrGWPHLVXe193Dnn6
-e0xazXgfXI/kDk3ePxoxjsg8GxzB1/Aevoaj4dGDiDIZN/IyGVsxeqANyF/4ohmcoUokte6pkVpf5atZ
-MLg6hvnQF7iIF+D8pXO3k3H+0o6s1/ORAbbZ3Npv3Ixj2egNLrXvk51OVEEYm/5YIr+8OPsr/CdKwx1Y
-r+fua3RO/kfIzWbUGaHcjEzGbu9kXO5g9nTiMuNQiywzQU8hUsICP+a59k+t5Tp308aM2pUXuVigtc+k
-f82iXbizu+V+eBKY3AajIKWEiR3XZaGEfq5eKZKqqNv0dhdf28Tnpk1ggbJuxfPPMFx44340AU7ChEJP
-iZSqgnauRSQlLmqMgtd23fQr+/Tmu96bCCNv/eNtaLmXLP/mSrqtT6il8prmTwfPaEd9mRvi6saA3FwB
-vd1fKDtdxfYtyGAYTO1jtzOit/PEPeHlt53o7M7RZacNvjmyA69RnRxZGcZMJFfB9K19X3QK7969exee
-n4dnZ5f1A6P/hsFPb18/f/78L0ObSzcwbdb9hqfuW6J7inXcvkCiqi4IcLGwewVvnjbohTALOnvsJ8wo
-srT5O6bf34D0m4XOUY6csu4JWzvfH4uZ/t6Px0p/YPAYN56+lmhP/Vnhdnn8wBUrhZKTAkN71+lkvDXw
-GNj/LNnj8eXn5k3GR77V90Lpx7uWOa2fneY2ObXvznKhtOonoo9Cgh8X/K7y+BAa2OP6RBCWCMIQQZjZ
-O1LhA2kn0ZgJuXo8StQn9omRWGIkhhiJW2Di8McXi3NUyj5FOkSOk2cdehgaEInkDmTwB9mkwu++1QW3
-36IZWD+k8EUER0fB9E1KbTmzG9Y0ADo1mJtinJkQ+nYhW/P8q60ieOeWIkONLRaD4TWR3fTMrtwK4251
-aP3mbB/c6WvCE2T3ALvnlbGq4oLqw5eZXpA5+r9DqP5j3RvrXP8XAAD//7UQMnUlQgAA
+H4sIAAAAAAAC/+w7a5MbN3KfpV/Rnly85HmHlCzJdbcimdLpcXblNk6k9aUUl8sFzjRncIsBRgCGXB6P
+/z3VAObBxy73Ie1Zib5IQzwajX53b2Nk7FIg2GWJ48jihR0mxkSThw8GmWZlPpBsHls2NbB6+OBBwXTG
+ZTxV1qriBB4/Ky+et8NWlc3Y+uGDQapVmaqFjAuUlduv5qhnQi3i5Qmwyiq/+SLOkWe5PYFnjx6F3aOh
+w2vycJTyOSSCGTOOtFoQZt2hRInYFPHjb2niwWimdFFP0XfMpeAS3eTGPjeZaVWVfu7BiMuysu7zQUuM
+yA90NyVKWq0EhJO/DUtkFhcqRTGOjGW63lgKlmCuRIp6HL3rTFilhOVl+MGtwHH0kkmYIrCpUaKyCEqD
+RsEsnyNYXuAA3nZ/GpgpIdQCbI5AqDELI1aoStrJiBZAJbmdxCxTAzij3z9Jbg0wjVAY6J1yIbjBRMm0
+fwwGes13Ab2Cy8pi/xhy6OWq0v1jSKGXsmX/GBbQWyCe949BQq9Q0ub9Y1hCb4lM9wfwosbf4cANcNlF
+cblcLoenp8M0jb///qQoToyBY1jkqBGYXIKaudVuc29aWZDKQsos9qFk2hpIPJlUwa3FdNDS/xyXJG7j
+KHz0fodzlLZfk5xNuUzxYhw9CyNeKoYpn398+dgRC5TpPqF4LdOrRKLSGqUNpJzBVDB5PoAvkvJPlpRp
+Za2StbBMrYSplXGpecH0MgKZxYngyfk4+q8K9bLXj6ALc+JGR0MP5SqIKc5YJWwEQTggyMW7BbdJDlO0
+C0TZCgKT6bYk+KVcZjDTqmiXWrW5ErSqZGoakg661/BASDTMzmUCLjS5eaWumc4xOZ+qi52LvKisAmKB
+YUUpECpDmLJ55q5CuCx4anOSjJJfoDA111NuSsGWmILzU9DTmKiiQJli2h/Aj3PUmqdonKR0DlCl5Uoa
+TwwC9IF4AVMU3rkQ4oJNUfjvoPfBP7bXaPWa/FhqtmgC7l6vmmM93GELeL/VuZRMb3Gm0eSAc0J2Rjzz
+OmhuhbP24LaRDqfcFdn/IPUV/O8IyJIcDGqOhuTtUfz40aN/rTlYsAuYM1FhPeAXDuAsJ1NgIEdRzipB
+5gDmHBckGYnSXmqVhAW3ORQVHS3q3QQr5bMZOrNZoNU8uR2NZH2LbSo117srnd7lagEvpFTW3ccM4Hsl
+UjA5n1kycxKmujJOdb2MWwWJRub0HFiz0WuqydViHIVRfC3ZVGB6q5sTpA5abirJmcxwHBm07zand+xB
+90rXJFFZCRFrigE7V6m0qNG3Ji5VWZVQaeEnNo8cDesVYQO71JDKLM41zsbRalVpsV5/XcpsPChlRiB1
+hnYc/eq87NYRvGAZjoZs8xajIfmqycN6pP7vWkHrH33MWol63HNZsjnUUXcIXAUnvDWWyOw4smQNndn6
+tQxG2m1fsYSs+Qn8zqEN4zG4j3U0GTGgW3csukH7A032/OK9HgpWqwDqG3i8XtPtR0PBa5z2QH2Rpmds
+uiMS33S3joaVaCm2jzBPbh3M11H5dzvK9h7YBTdQcMmLqngOAtkcAYvSLp2BITM+2FCXFikX0MVuMJos
+CUZXpB0q+3RLVsUUdQT7okNw6c04ct7tBMgudlWw4HKLhEGTGu05ELUeJgS7uDsh2MUnJwS7uCYh7qSK
+df7YmbFs6pBEaXcljiZLJvF66nep8rZkoyV8No5mXFjU76qyVDqcu08TcqX535W0rMlDn9aLr05j9jDV
+b4ct7p4617nB3m2/5nf+oYW8yXWXH+3nectfO/A+OoKpiUNsNo4KJ4kFkap24V0n9Ge0Zyz79z8tPZKt
+CSMiSm6vWCF4QfOPHz1yJxKmLEeWklLHAmVm83G0PdfK35PI6cdMJZWBqxKJlmLDVim2fnwMPm1r9xkF
+VmhzlUJlMHUhgyqmXGIbKbloP4RLXFoFDCjq3pwJYdgUczbnSkPOyhKl8XGJKTHhM56A0tD7fd+HcT5f
+DMmWY6BlmRlEkxdZpjFjVumbipNBgYk9LEOsOcGNN3LEsgyYAfrPmbYsI5FqV2+E7U8ojvAn3p57Lr7g
+KTZ5wd3Y2WYQPulhlnmWzXCB2v0uFZfWUMb/oeIaKYFj1q9uNy+4TNUihNvcABNGQanVnKeUGE/ac27J
+oi430k1ttQOD9pWLEw/zJjUb3NnL+d82y87qkkSg+WZ+a9UA3igNeOF/P85hoSpBWbuttATW8tSRxSd8
+VFeB6RJK1IQ4ZQRbDJ5VMnFJEZVYvQjQplDOqTbKOcWnKedEk/92N74Hn5EaovBlUrZhrj+Nke66/aOc
+mRi1VvroBL5KmKTc/+fasf0CX38NdpCi5nNfbBmP4Ygk7Wh9R0n7YeYqXgYtCVnGqgyPwZLV9sJkgJHE
+xJ69oJl1qbanWFM7qu3HDz4Jr025WLAluQdKOA1CDy8SLL1MajRI4kQwlRAkcaZ/TP4GEqopot6GljAJ
+mdcDv45wGcALWDAtfVovlKZwiSfM0vGVVbDgQkBI3Lwj88Cjydmy/CiGqmHKHlFq5rYNlyazpT0lyGTR
+XX4Ns/dnsXyOvCNYgUZHdxStF1Aqwx1ULi1mSFwvifHS1tYnZBDBqTh/n2OHSadb2cENTcGVSUOXi0T/
+Hz35B+H0U3ZxDeVuMobrxth/iK7BmDrQPyfZMEpbTH+1LDs3P/sg9JcDrPn2AGte0wUJdtAtCgZc0uDD
+LSD/M+Pa2Hq41/gG0li3q+91iyXWuWBw2JODaZbGvnZXMCrtusWJ0mhKMiQ+YmyixLoUWZ9XS4Jlmd9Z
+o5cjmY5KWLNZ8XsOXBqLLKXhDCVq5oSMgcRFUz7MMQDCtB5z0KfYBHQulFmtztdr6K1WlmVz8/PvSqZR
+2kEg/s/nvwx8mL9e968hnt/uMSGBEW9qWllFSLhwm+4eyDldBnwH15DhbPrGrTWEH8n/psmZEZtmDvqM
+WO8Bb0SvTw8ZlssLLbf3wxtoe6Q2Ezk79+IwJ6wvY8jVmVg3Y5NZnHLjKpvj6KvaxZJ6GQenS45beP6P
+JgBSyfjmQiD//HlKwSben78YXOYjwvhOzeYr+FK1+Q1Wbb6UYD6PEsyXqsvnUHX5Umj5UmjZEqxPkGE9
+jb4UXn7LhZd33mV9ZvWX/zcll5f+C/7vlV6e3kfp5aDHYVmtWJ0ih2/HzNkcu81IJTq1ny7hH7DIeV1F
+abxPNwIkYK7coiR6aC5SmCL8Puxt7crefaUyhk9FUPuPXgS5qxdpErN/Xk54q0xvs73Af1zdXdCaCV1J
+MrD7+g1Etq/fgAnUFty/MZcz5WBRHtuBdVMkgpW/HRL15haPBtxN8XBO+nZYpOQTdAeJAOt6KBw+kqfU
+BMe0jcCa2HdgpcyyceRrlVGn0a5pr+NKdsdNd8I0MxijDAK53ZYHRJd4A8JO151/FDGOnvqs1zJt68cF
+MEVHCplG4M+IXavgOLK6woj0JPQQFewiNNE0/Yzd1sYbc5I6VziaA4TNn7oGMo5mNMyfuiFLWHbaZ0Td
+x2WnKl0GtbN656RgqGACj5vCik0nL4QYDW3aGQnNaONoiBel/jf6Z7xaTa1iPfo+wwvbX69XK4K7pGB9
+vb6q5e+7aPL6gtyv4Ur69rVLjkuUnPHssgMPnPG2ErgJfTS0eoMctVf7UHcOBfoHTFrN+BBNrk+TD/dG
+jCM2z3pH8A18gG/gqH90J6KMho28jIZOjO5oA/KnoWgGr9Akmjv31EhtqPLVLOidH8O8HwpcLAhw/sy7
+29Ewf+ZGVqv5gICt124+bcKMVHQim6guH84HL5llQmVNO5jdmRr8uJCoo4n7bzRMbb00vXTparU1RBim
+6RVnvK3kVKnzaBI+DpzTLB+xblftzoI97J7sXeZZfiWKVB7EpYkmFIrR1wEcm/Wr1c7g4G+Ky97RMZBE
+jkzJ5O7+dyQZNppAz7iv/mhICw+h+RYtRe1KRpPm8xA12y2r1Z7hg9x7RclCwiym0aT9PnBqd5MnQRBV
+Hx67f5tAYJJ2wG6SYTRMxU6T5GXBhEfhr1z5Pv4g9n9hxsK8HoTVqrNk4Ior/3CPe06OCkx5VRyt1ydb
+q/5Tq6nAoubsc8fZW0RxV1uMsh6n6Dd0rU9GpmBCTH4sUZ69e/Un+CtqE67hvwan7G9Kr9eDzgiXymml
+3zsaljuYPRz5OlVsVZYJHEeFSpmIwlhQqn9p44hTP83TsJJ69dFFS2zzmkW7cGd3a4vjxxFoReemvLZQ
+O1DiMFevVElV1E2zu4svban10xTmo64tYXgU5ZMN/6NJNxKhDAZKpNwUvHMtpjnzOdw4eunWTb52D+Ge
+b7xQIuu/ebxL9PaS5S/+DyxthFb7iEtasT088lX1Za7Icht3fvXfI67XL9Dp8Xcvs6hS556evmJ2u2qz
+J9n7rpMr3TjX6zxKaY7swGtUh572xFOhkvNo8sa99juB9+/fv49PT+NXr87q537/A723b14+efLkj31X
+2WpguhrYa5n6b43+YeRx+x6Qm7o8J9XC7VWyeWhkF4oWdPa4T5hxFGnTVRD2NyDDZmVz1AOvrHuSyM73
+p2JmuPf9sTIcGN3HjScvNbpTfzKob3bFyqCWrMDY3XUyGm4N3Af2P2lxf3z5qXkh9Ylv9b0y9v6uRadt
+1opyVypyr0BzZazZLAvdCwlC5H1fNHDHbRJBOSIoIoKi2RtS4SNpJ7OYKXq8fF+UqE/cJEbiiJEQMRK/
+gLLi+xeLUzTGPQw8RI7Hjzr0IBowjewGZAgHuRQ/7L7WBbdfhhKsH1L4agxHR9HkdcrdHxe6YU0DoFMR
+vSrGmSllrxeyNY8x25pecG4pCrTYYtHrXxLZTV65lVth3LUOrV+A7oM7eclkguIWYPe8+TfVtOD28GUm
+79gcw18FzcbRV+cr/zsAIrg18rNFAAA=
 `,
 	},
 
@@ -26762,47 +26887,47 @@ Pg0Exj9G3PR0Lhd5Fu79+Iz8EQAA//8VMhJI5ggAAA==
 
 	"/templates/index.html": {
 		local:   "web/static/templates/index.html",
-		size:    8290,
+		size:    8464,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/8xa73LbuBH/LD/Fhu5E9jUUbSe5NLKkGddxr5lJJmmczLRz7WRAYEUhAgEGACX7dHqN
-Pkg/92n6JB2ApERSsmO5Td0PsYDF7m//YLELkhk8evXu/ONf3l/AxKZitDdwPyCTkGTZMIiVyeVZlgWj
-vc5ggoSN9jqdgeVWoOOJuWTDoPt7xwWPU0bM5BS68FvwHMFoEPmBF0rREphYm4X4NeezYfDn8NNZeK7S
-jFgeCwyAKmlR2mHw+mKILMEAIi8puJyCRjEMzERpS3MLnCoZwETjeBhEYzJz8x6nKlirkiTFYTDjOM+U
-tjX0OWd2MmQ44xRDP3kCXHLLiQgNJQKHx72jYK251GIssZxG1JgoVsoaq0nWS7nsUWOC0jx7LdBMEO2t
-4l++5qivw5zfT3yspA3JHI1K8RsIfu5GnZ4ks5josMAJrcpg4eidlOiEyzBW1qq0D8cvs6tTt7B0f/aF
-SlTJmCnDLVeyDyQ2SuQWPV/HqqwPL3rPs6tTiH6Ag0IRTJAnEwsHz46AS5gRzUks0PQEGnMIIRj+C4Ia
-g9dwcPLccR2FPt961hweQgQn8EPUNCW0eGWbhgsc2z48/V1pdsdHx4H34WRFpEoo3Yf94/Hzk5c/FjSB
-1qIOTUYol0kfwuO65z3rzVXa8bjER31mKGwhh1xK1P1+jGOlsTSuTLY+dP/19390b4V9hffE/WeF64Ez
-IlF4US6TijvXxvmdKS4t6lOoCBMi2aZwcawX7TAeP18Fxv0VJEbRMyiQbt2K45Mq6iXZJ0h40ojunNtJ
-WGAga6Jolzd9qJR2YqVdNErycXYFRgnOYJ9SWnBkhDmn25KFuWaW1Fzqw/GRQyDShAY1H9dsIlfcQEZc
-OSjGgkt8Ar2xonkxqYC4EH2QSpYnwFitptiH/aOjo5IyIRmGGiVD7ZOLam6yC5agqSn8JmRRnfpw3FtF
-YwOZ5FbVMK/99jQ9PtnmcZGNIqx7XU1rhrUMqWeyCKXSKRENF/af0hc/PmVNPqq55ZSIQgVqrXRT6Ozl
-s2fPTppCc6LlOpVLxjHBuNz2FWMup1LNZZORvUQ2ftFkFJigZPUEn2ORMrES7HRrOTxqpWFZSWJB6LSx
-UEbopJF8riXJ0KRECNSbJ+uo9wLTGvvPMvlrnwpFpn97Aj/LJFyNGbEkrBOu6rNeNX4CvfUCLPYAGDeZ
-INdFbsEjnrpmSKQ93XNhGURVhxhEZXcfxIpdu97uSo1WzvBh4Bv8udUicCuuWQ4D9/dgsehdorVcJma5
-PCyaDuMzoIIYMwzKXlD2HoZjkgsLG60oAK0EenaeENdkPFIDyplDuERdLG1RU9bNcr0ziHNrlQR7neEw
-KCZBS8KqJHE3Dx/eYuI0CUEysyITnaAdBvulzGq51NMZmIzICtjoUElxHYw+ejRYuzSIHN9WIZ8lMdHu
-wvRdmAZR4X81Ja04xJpItrpPrT1zMeZsGLje6yAZn21d8305GPk0qXMNIlLu1pq4ZeOqkMI69A75hoAP
-clGTr7JJktnabsF9BhdMhFo+w4Mut5ia7mEwGpDKU08KRq/dj7N1EAneAjETNR8GKkNpDYsvpOvTLNgC
-n2iSTZrwnhSMfnI/N8C3QPAq000MRwlGF1eZRmN8Gt0Fhyo55kkTqaAFow+5QLhg3Cp9NzDDBUqKTbSS
-GIwui8Gt4SNSKkss3hK+koUr2T0s1xWZ1hSuGYLRZR6n3MLZitTSPohy8a1sqYb+1tBOHT4eBl9zjnaj
-YrgLKBGobciITFDXbP2TE4C3iiGUjm6NrWMtaasiVSqJrYTYynalrMgmLbVxOi0fhlAeFOGacIYlzT03
-BKNLN4Q3XE5bp7/TGXCZ5basjP7otuvBSiGMlU6rXgBezpvhzuda2XqnawZUfvsYlJPFAn7zyaB2D2fQ
-H0KwWJDcTnoV7eBwuQxgudzcDc9WhvXgEH79FayaojSrlCo9YFplTM3lthza3+Da3gHWGKN6de2OCYxJ
-mBvU3aq+wmKxcmi5hAGvNBS8lGi0YYHlHoHcNjGUw8Dq3D8f89GqRjaTdWVhijJfBfOWkNQPp1AJl5HK
-bQBl++p+NijG3dEblYDKbfu0NnAbgYXHj8Er+iMx71Gn3Fehg+5bIkmC8NHzNktDIR+MSpaz3E5Kvg21
-tYPauemwVNGvncBuZeqjVlo8fgyPmnlxlx2s5eRy2TajnVf/QTqN2unxNUfjyldIuaYCHUkkN2bK/yi7
-2izVteot4RJeKZqnKKuq28qgdWwm1mamH0XFA73SSZQbkuAqH4PPsSCuSnxyZFiBdNo+4pV1WyNC/zak
-fp++1eedTMNVdzWbBq5bL7whMskf3lyGY/++aqu5r9aLcOBa/bmS48MHtthcG4vp5+IGkuuii2+Yfum5
-4LzO9cCGk4xv2nn2/jVcSObfqpgHNlCjUbmmuCUT3tkJavjgGCgaeMON/f623lg9atY4c+/gZMLtJI97
-VKWFv2GqpLu0FrNNf292rkC60ZG1YPEi+71WX5BaUBJ+8pIPt8VGEDr1IdjFXy91B3cvHR+cT4iFD0ql
-D1wjnDGv5YzbLV3iv+Vrga8fwlNjCZ2qGeqxUHO/pVX3N5ElSYJs98T2mGEFepcoOAF4VwpAke8fSfL/
-ERBWv1wU0YisyjjdUt2+d1BeKWqASAYXVyTNBJrvG6IbLsJr8voNymq0Htz8nqy+pNV8y8szqkQokvD4
-xD8xzTjO76DQUM0zC0bT9bepL9WXLf9R6ovx76A837dFwpzfWSKXDLWhSmO4iyJ3dxNE72RcKRNqlVu8
-l6Qh7jL2yz2F/TfG+0nZTOymk6L7t7O2XWSa303vKpUqVxPuIRKy8hIZjpVOyW4Q7Glv9vQemVz/kreT
-+B+4wEsy21GKCp7Fimi2k5RM7idXdOs292LRey2pyBma5XKvM4hixa5He4Oo+B8F/w4AAP//YvzhYWIg
-AAA=
+H4sIAAAAAAAC/8xa73LbuBH/LD/FBu5E9jUUbSe5NLKkGdd2r5nJzaVxMtPOtZMBgRWFGAQYAJTt0/k1
++iD93Kfpk3QAkhJJyY7tTs79EAtY/PYvlrsgmNGTk5+OP/zt3SnMXCYnWyP/AyqNaJ6PSaJtoY7ynEy2
+eqMZUj7Z6vVGTjiJHpMIxcek/0ePgqcZp3Z2CH34PQQEmYziMAhMGToKM+fyCL8UYj4mf40+HkXHOsup
+E4lEAkwrh8qNyZvTMfIUCcSBUwp1DgblmNiZNo4VDgTTisDM4HRM4imd+/lAME1WqhTNcEzmAi9ybVxD
++oXgbjbmOBcMozB5BkIJJ6iMLKMSx/uDPbLSXGmxjjrBYmZtnGjtrDM0H2RCDZi1pDLPXUm0M0R3K/vn
+LwWaq6gQD2OfauUieoFWZ/gVCWHuR72BovOEmqiUEzmdw8LTexk1qVBRop3T2RD2X+eXh37h2v/ZljrV
+FTDXVjih1RBoYrUsHAZcz+l8CK8GL/PLQ4i/g51SEcxQpDMHOy/2QCiYUyNoItEOJFq7CxFY8QuCnkLQ
+sHPw0qP2opBvA2d3dyGGA/gubpsSObx0bcMlTt0Qnv+hMrsXouOFD+FgSWRaajOE7f3py4PX35c0ic6h
+iWxOmVDpEKL9pucDF8zVxmN84qM5sgw2kCOhFJrhMMGpNlgZVyXbEPr/+ee/+reKPcEHyv13LTcIzqlC
+GViFSmt0Yaz3O9dCOTSHUBNmVPF15vKxXnTDuP9yGRj/V9IE5cCiRLZxK/YPKnhNDgkSHbSieyHcLCpl
+IG9LMT5vhlAr7SXa+GhU5P38EqyWgsM2Y6xE5JR7p7ucpbl2njZcGsL+npdAlY0sGjFt2EQvhYWc+nJQ
+jqVQ+AwGU82KclILElIOQWlVPQHWGX2OQ9je29urKDOaY2RQcTQhuZgRNj/lKdqGwq+KLKvTEPYHy2is
+SaaF0w2ZV2F72h4fbPI4oJ2Mml7X04ZhHUOamSwjpU1GZcuF7efs1ffPeRvHjHCCUVmqQGO0aTMdvX7x
+4sVBm+mCGrVK5Qo4pZgw1gYW6lzpC9UG8tfIp6/aQIkpKt5M8AssUybRkh9uLId7nTSsKkkiKTtvLVQR
+Omgln29JKrIZlRLN+pO1N3iFWQP+s0r/PmRS0/N/PIOfVRotx5w6GjUJl83ZoB4/g8FqARZbAFzYXNKr
+Mrfgich8M6TKHW75sIziukOM4qq7jxLNr3xv96XGaG/4mIQGf+yMJH7FN8sx8X93FovBGTonVGqvr3fL
+psPFHJik1o5J1QvKn4jjlBbSwVorImC0xAAXKfVNJkhqifLmUKHQlEsb1FR1s1rvjZLCOa3AXeU4JuWE
+dDicTlN/8gjhLSdek5Q0t0syNSm6MdmueJbLlZ7eyOZU1YKtibSSV2TyIUiDlUuj2OM2MoUsSajxB6Zv
+AhrFpf/1lHbikBiq+PI8tfLMx1jwMfG914vkYr5xLfRlMglp0kSNYlqOGsQNG1eHFFahF3xtdbmxhWzw
+19mk6HxltxQhg0sQZU7McacvHGa2v0smI1p7Gkhk8sb/eFtHsRS3C/FH3ERTwzuSVnQyOVmOb5BpZ/pi
+THSOylmenCrf+znZoC01NJ+1FQUSmfzgf+5mMl7mpi3DU8jk9DI3aG1IzbvIYVpNRdqWVNLI5H0hEU65
+cNrcTZgVEhXDtrSKSCZn5eCOW4K5b4eKCexuSmOFTE4as1s3hiqlHXV4y8ZUEKFVf7da1/S8oXoFIJOz
+IsmEg6MlqaN9FBfya7ldD8MZp5voYjomXwqBbq2++eMylWhcxKlK0TRs/YtngB81R6gc3RhqD61oy5Ja
+KUmcgsSpbl2vyTartAl2Xr26odopwzUTHCuaf8shkzM/hLdCnXdqVa83EiovXFXHQ6HpVq+lQphqk9Wd
+CwJfMEPwprLVTjcMqP0OMagmiwX87qNF418lYTgGsljQws0GNW1n9/qawPX1+m4EWBXWnV349Vdw+hyV
+XaZU5QE3Ouf6Qm3Koe011OZ+tZIxafaC/pTClEaFRdOvuwEsFkuHrq9hJGoNJZZRgy4qZVEjqN8mjmpM
+nCnC27yYLCt6O1mXFmaoimUwbwlJ8zGVOhUq1oUjUDXb/ieLctqfvNUp6MJ1n9aW3FZg4elTCIr+TO07
+NJkI9W2n/yNVNEX4ELDtIlHyk0kFOSrcrMKtqW08qL2bHpY6+o0nsF+b+qSTFk+fwpN2XtxlBxs5eX3d
+NaObV/9DOk266fGlQOvLV8SEYRI9SaY3ZspvlF1dSH0I/JEKBSeaFRmquup2MmgVG38tZYdxXF4/aJPG
+haUpLvORfEok9VXioyfDUkiv6yNeOr81Mgp3N83T/60+38s0XPZtu27gqqnDW6rS4vHN5TgNt2sbzT1Z
+LcKOP0QcazXdfWSL7ZV1mH0qzzaFKbv4mulnAQXHTdQjG05zsW7n0bs3cKp4uAOyj2ygQasLw3BDJvzk
+ZmjgvQcwtPBWWPftbb2xejSs8ebewclUuFmRDJjOSn+jTCt/HC5n6/7e7Fwp6UZHVozltfs7oz/7qzit
+4IfA+XhbbP2tSAjBffwNXHdw98zj4HhGHbzXOnvkGuGNeaPmwuG387WUbx7DU+soO9dzNFOpL8KW1t3f
+xo6mKfL7J3aQGdVC7xIFzwA/VQxQ5vsHmv5/BIQ3DxdlNGKnc8Hsbx+UE80sUMXh9JJmuUT7bUN0w0F4
+RV7d9yxHq8HNt3rNJaMvNlz1MS0jmUb7B+GNyX/du4NCy4zIHVjDVl/SPtff4cIntM/We1rivs7iP93d
+laPwN/WWaYPRfRT5s5ukZvAAnsjowuGDOC1VwolfHsgcvog+jMvl8n46Gfp/99Z2H572V967cmXa14QH
+sES8OkRG/hKD3k8Efz6YP39AJje/O96L/U9C4hmd35OLSZGHm9F7can0YXxlt+6iF4vBG8VkwdH6m5tR
+7D94TLZGcfn/H/47AM5PWrYQIQAA
 `,
 	},
 
@@ -52,6 +52,16 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
         templateUrl: 'partials/errors.html',
         controller: 'ErrorCtrl',
     })
+    when('/dashboards', {
+        title: 'Dashboards',
+        templateUrl: 'partials/dashboards.html',
+        controller: 'DashboardsCtrl',
+    })
+    when('/dependencies', {
+        title: 'Dependencies',
+        templateUrl: 'partials/dependencies.html',
+        controller: 'DependenciesCtrl',
+    })
     when('/graph', {
         title: 'Graph',
         templateUrl: 'partials/graph.html',
@@ -217,6 +217,16 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
             templateUrl: 'partials/errors.html',
             controller: 'ErrorCtrl'
         });
+        when('/dashboards', {
+            title: 'Dashboards',
+            templateUrl: 'partials/dashboards.html',
+            controller: 'DashboardsCtrl'
+        });
+        when('/dependencies', {
+            title: 'Dependencies',
+            templateUrl: 'partials/dependencies.html',
+            controller: 'DependenciesCtrl'
+        });
         when('/graph', {
             title: 'Graph',
             templateUrl: 'partials/graph.html',
@@ -887,6 +897,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
         $scope.runningHash = search.runningHash || null;
         $scope.runningChanged = search.runningChanged || false;
         $scope.config_text = 'Loading config...';
+        $scope.file = search.file || '';
         $scope.selected_alert = search.alert || '';
         $scope.email = search.email || '';
         $scope.template_group = search.template_group || '';
@@ -961,22 +972,38 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             }
             return items;
         }
-        $http.get('/api/config?hash=' + (search.hash || ''))
-            .success(function (data) {
-            $scope.config_text = data;
-            $scope.items = parseItems();
-            buildAlertFromExpr();
-            if (!$scope.selected_alert && $scope.items["alert"].length) {
-                $scope.selected_alert = $scope.items["alert"][0];
+        $http.get('/api/config/files')
+            .success(function (files) {
+            $scope.files = files;
+            if (!$scope.file) {
+                $scope.file = files[0];
             }
-            $timeout(function () {
-                //can't scroll editor until after control is updated. Defer it.
-                $scope.scrollTo("alert", $scope.selected_alert);
+            $http.get('/api/config?hash=' + (search.hash || '') + '&file=' + encodeURIComponent($scope.file))
+                .success(function (data) {
+                $scope.config_text = data;
+                $scope.items = parseItems();
+                buildAlertFromExpr();
+                if (!$scope.selected_alert && $scope.items["alert"].length) {
+                    $scope.selected_alert = $scope.items["alert"][0];
+                }
+                $timeout(function () {
+                    //can't scroll editor until after control is updated. Defer it.
+                    $scope.scrollTo("alert", $scope.selected_alert);
+                });
+            })
+                .error(function (data) {
+                $scope.validationResult = "Error fetching config: " + data;
             });
         })
             .error(function (data) {
-            $scope.validationResult = "Error fetching config: " + data;
+            $scope.validationResult = "Error fetching config files: " + data;
         });
+        $scope.selectFile = function (file) {
+            $location.search('file', file);
+            $location.search('hash', null);
+            $location.search('alert', null);
+            $route.reload();
+        };
         $scope.reparse = function () {
             $scope.items = parseItems();
         };
@@ -1027,7 +1054,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             $scope.animate();
             var url = '/api/rule?' +
                 'alert=' + encodeURIComponent($scope.selected_alert) +
-                '&from=' + encodeURIComponent(set.Time);
+                '&from=' + encodeURIComponent(set.Time) +
+                '&file=' + encodeURIComponent($scope.file);
             $http.post(url, $scope.config_text)
                 .success(function (data) {
                 procResults(data);
@@ -1141,7 +1169,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
         };
         var line_re = /test:(\d+)/;
         $scope.validate = function () {
-            $http.post('/api/config_test', $scope.config_text)
+            $http.post('/api/config_test?file=' + encodeURIComponent($scope.file), $scope.config_text)
                 .success(function (data) {
                 if (data == "") {
                     $scope.validationResult = "Valid";
@@ -1204,7 +1232,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
                 '&to=' + encodeURIComponent(to.format()) +
                 '&intervals=' + encodeURIComponent(intervals) +
                 '&email=' + encodeURIComponent($scope.email) +
-                '&template_group=' + encodeURIComponent($scope.template_group);
+                '&template_group=' + encodeURIComponent($scope.template_group) +
+                '&file=' + encodeURIComponent($scope.file);
             $http.post(url, $scope.config_text)
                 .success(function (data) {
                 $scope.sets = data.Sets;
@@ -1238,7 +1267,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             var url = '/api/rule?' +
                 'alert=' + encodeURIComponent(alertName) +
                 '&from=' + encodeURIComponent(moment.utc(v.Time).format()) +
-                '&template_group=' + encodeURIComponent(template);
+                '&template_group=' + encodeURIComponent(template) +
+                '&file=' + encodeURIComponent($scope.file);
             $http.post(url, $scope.config_text)
                 .success(function (data) {
                 v.subject = data.Subject;
@@ -1259,10 +1289,11 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
         }
         $scope.downloadConfig = function () {
             var blob = new Blob([$scope.config_text], { type: "text/plain;charset=utf-8" });
-            saveAs(blob, "bosun.conf");
+            saveAs(blob, $scope.file.split('/').pop() || "bosun.conf");
         };
         $scope.diffConfig = function () {
             $http.post('/api/config/diff', {
+                "File": $scope.file,
                 "Config": $scope.config_text,
                 "Message": $scope.message
             })
@@ -1280,11 +1311,16 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             }
             $scope.saveResult = "Saving; Please Wait";
             $http.post('/api/config/save', {
+                "File": $scope.file,
                 "Config": $scope.config_text,
                 "Diff": $scope.diff,
                 "Message": $scope.message
             })
                 .success(function (data) {
+                if (typeof data == 'string' && data.indexOf("Proposed") == 0) {
+                    $scope.saveResult = data;
+                    return;
+                }
                 $scope.saveResult = "Config Saved; Reloading";
                 $scope.runningHash = undefined;
             })
@@ -1299,6 +1335,9 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             if ($scope.saveResult == "Config Saved; Reloading") {
                 return "alert-success";
             }
+            if ($scope.saveResult.indexOf("Proposed") == 0) {
+                return "alert-info";
+            }
             return "alert-danger";
         };
         return $scope;
@@ -1330,6 +1369,216 @@ bosunControllers.controller('DashboardCtrl', ['$scope', '$http', '$location', fu
         };
     }]);
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('DashboardsCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        var name = search.name;
+        $scope.date = search.date || '';
+        $scope.time = search.time || '';
+        $scope.vars = {};
+        $scope.results = {};
+        $scope.errors = {};
+        $scope.loading = true;
+        $scope.permalink = $location.absUrl();
+        if (!name) {
+            $http.get('/api/dashboards')
+                .success(function (data) {
+                $scope.dashboards = data;
+            })
+                .error(function (error) {
+                $scope.error = error;
+            })
+                .finally(function () {
+                $scope.loading = false;
+            });
+            return;
+        }
+        function params() {
+            var p = [];
+            _.each($scope.vars, function (v, k) {
+                if (v) {
+                    p.push('var-' + encodeURIComponent(k) + '=' + encodeURIComponent(v));
+                }
+            });
+            if ($scope.date) {
+                p.push('date=' + encodeURIComponent($scope.date));
+            }
+            if ($scope.time) {
+                p.push('time=' + encodeURIComponent($scope.time));
+            }
+            return p.join('&');
+        }
+        $scope.panelURL = function (panel, format) {
+            var url = '/api/dashboards/' + encodeURIComponent(name) + '/panels/' + encodeURIComponent(panel.Name);
+            if (format) {
+                url += '.' + format;
+            }
+            return url + '?' + params();
+        };
+        $scope.update = function () {
+            var s = { name: name };
+            _.each($scope.vars, function (v, k) {
+                if (v) {
+                    s['var-' + k] = v;
+                }
+            });
+            if ($scope.date) {
+                s.date = $scope.date;
+            }
+            if ($scope.time) {
+                s.time = $scope.time;
+            }
+            $location.search(s);
+        };
+        $scope.tags = function (group) {
+            var tags = [];
+            _.each(group, function (v, k) {
+                tags.push(k + '=' + v);
+            });
+            return tags.sort().join(', ');
+        };
+        $http.get('/api/dashboards/' + encodeURIComponent(name))
+            .success(function (data) {
+            $scope.dashboard = data;
+            $scope.values = data.Values || {};
+            _.each(data.Variables, function (v) {
+                $scope.vars[v.Name] = search['var-' + v.Name] || v.Default || '';
+            });
+            _.each(data.Panels, function (p) {
+                if (p.Type == 'graph') {
+                    return;
+                }
+                $http.get($scope.panelURL(p, ''))
+                    .success(function (res) {
+                    $scope.results[p.Name] = res;
+                })
+                    .error(function (error) {
+                    $scope.errors[p.Name] = error;
+                });
+            });
+        })
+            .error(function (error) {
+            $scope.error = error;
+        })
+            .finally(function () {
+            $scope.loading = false;
+        });
+    }]);
+/// <reference path="0-bosun.ts" />
+bosunControllers.controller('DependenciesCtrl', ['$scope', '$http', function ($scope, $http) {
+        $scope.loading = true;
+        $http.get('/api/dependencies')
+            .success(function (data) {
+            $scope.graph = data;
+        })
+            .error(function (error) {
+            $scope.error = error;
+        })
+            .finally(function () {
+            $scope.loading = false;
+        });
+        $scope.nodeLink = function (node) {
+            var filter = node.Type == 'alert' ? 'name:' : 'hasTag:';
+            return '/?filter=' + encodeURIComponent(filter + node.Name);
+        };
+    }]);
+bosunApp.directive('tsDependencyGraph', ['$location', function ($location) {
+        var colors = {
+            critical: '#d9534f',
+            warning: '#f0ad4e',
+            unknown: '#5bc0de',
+            normal: '#5cb85c',
+            none: '#999'
+        };
+        return {
+            scope: {
+                graph: '=',
+                link: '='
+            },
+            link: function (scope, elem, attrs) {
+                scope.$watch('graph', update);
+                function update(graph) {
+                    if (!graph || !graph.Nodes) {
+                        return;
+                    }
+                    elem.empty();
+                    var width = elem.width();
+                    var height = Math.max(400, Math.min(1000, graph.Nodes.length * 30));
+                    var index = {};
+                    var nodes = graph.Nodes.map(function (n, i) {
+                        index[n.Id] = i;
+                        return angular.extend({}, n);
+                    });
+                    var links = (graph.Edges || []).map(function (e) {
+                        return { source: index[e.Source], target: index[e.Target], type: e.Type };
+                    });
+                    var svg = d3.select(elem[0])
+                        .append('svg')
+                        .attr('width', width)
+                        .attr('height', height);
+                    svg.append('defs').append('marker')
+                        .attr('id', 'dependency-arrow')
+                        .attr('viewBox', '0 -5 10 10')
+                        .attr('refX', 18)
+                        .attr('markerWidth', 6)
+                        .attr('markerHeight', 6)
+                        .attr('orient', 'auto')
+                        .append('path')
+                        .attr('d', 'M0,-5L10,0L0,5')
+                        .attr('fill', '#666');
+                    var force = d3.layout.force()
+                        .nodes(nodes)
+                        .links(links)
+                        .size([width, height])
+                        .linkDistance(80)
+                        .charge(-300)
+                        .start();
+                    var link = svg.selectAll('.dependency-link')
+                        .data(links)
+                        .enter().append('line')
+                        .attr('class', 'dependency-link')
+                        .attr('stroke', function (d) { return d.type == 'alert' ? '#337ab7' : '#666'; })
+                        .attr('marker-end', 'url(#dependency-arrow)');
+                    var node = svg.selectAll('.dependency-node')
+                        .data(nodes)
+                        .enter().append('g')
+                        .attr('class', 'dependency-node')
+                        .style('cursor', 'pointer')
+                        .on('click', function (d) {
+                        scope.$apply(function () {
+                            $location.url(scope.link(d));
+                        });
+                    })
+                        .call(force.drag);
+                    node.append('title')
+                        .text(function (d) {
+                        var t = d.Name + ': ' + d.Status + ' (' + d.Incidents + ' open)';
+                        if (d.SuppressedBy) {
+                            t += ', suppressed by ' + d.SuppressedBy;
+                        }
+                        return t;
+                    });
+                    node.append('circle')
+                        .attr('r', function (d) { return d.Type == 'alert' ? 10 : 7; })
+                        .attr('fill', function (d) { return colors[d.Status] || colors['none']; })
+                        .attr('stroke', '#333')
+                        .attr('stroke-dasharray', function (d) { return d.SuppressedBy ? '3,2' : null; });
+                    node.append('text')
+                        .attr('dx', 13)
+                        .attr('dy', '.35em')
+                        .style('font-style', function (d) { return d.Type == 'alert' ? 'normal' : 'italic'; })
+                        .text(function (d) { return d.Name; });
+                    force.on('tick', function () {
+                        link.attr('x1', function (d) { return d.source.x; })
+                            .attr('y1', function (d) { return d.source.y; })
+                            .attr('x2', function (d) { return d.target.x; })
+                            .attr('y2', function (d) { return d.target.y; });
+                        node.attr('transform', function (d) { return 'translate(' + d.x + ',' + d.y + ')'; });
+                    });
+                }
+            }
+        };
+    }]);
+/// <reference path="0-bosun.ts" />
 bosunApp.directive('tsResults', function () {
     return {
         templateUrl: '/partials/results.html',
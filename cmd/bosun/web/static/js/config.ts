@@ -2,6 +2,9 @@
 interface IConfigScope extends IBosunScope {
 	// text loading/navigation
 	config_text: string;
+	files: string[];
+	file: string;
+	selectFile: (file: string) => void;
 	selected_alert: string;
 	items: { [type: string]: string[]; };
 	scrollTo: (type: string, name: string) => void;
@@ -74,6 +77,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 	$scope.runningHash = search.runningHash || null;
 	$scope.runningChanged = search.runningChanged || false;
 	$scope.config_text = 'Loading config...';
+	$scope.file = search.file || '';
 	$scope.selected_alert = search.alert || '';
 	$scope.email = search.email || '';
 	$scope.template_group = search.template_group || '';
@@ -151,24 +155,41 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		return items;
 	}
 
-	$http.get('/api/config?hash=' + (search.hash || ''))
-		.success((data: any) => {
-			$scope.config_text = data;
-			$scope.items = parseItems();
-			buildAlertFromExpr();
-			if (!$scope.selected_alert && $scope.items["alert"].length) {
-				$scope.selected_alert = $scope.items["alert"][0];
+	$http.get('/api/config/files')
+		.success((files: string[]) => {
+			$scope.files = files;
+			if (!$scope.file) {
+				$scope.file = files[0];
 			}
-			$timeout(() => {
-				//can't scroll editor until after control is updated. Defer it.
-				$scope.scrollTo("alert", $scope.selected_alert);
-			})
+			$http.get('/api/config?hash=' + (search.hash || '') + '&file=' + encodeURIComponent($scope.file))
+				.success((data: any) => {
+					$scope.config_text = data;
+					$scope.items = parseItems();
+					buildAlertFromExpr();
+					if (!$scope.selected_alert && $scope.items["alert"].length) {
+						$scope.selected_alert = $scope.items["alert"][0];
+					}
+					$timeout(() => {
+						//can't scroll editor until after control is updated. Defer it.
+						$scope.scrollTo("alert", $scope.selected_alert);
+					})
 
+				})
+				.error(function (data) {
+					$scope.validationResult = "Error fetching config: " + data;
+				})
 		})
 		.error(function (data) {
-			$scope.validationResult = "Error fetching config: " + data;
+			$scope.validationResult = "Error fetching config files: " + data;
 		})
 
+	$scope.selectFile = (file: string) => {
+		$location.search('file', file);
+		$location.search('hash', null);
+		$location.search('alert', null);
+		$route.reload();
+	}
+
 	$scope.reparse = function () {
 		$scope.items = parseItems();
 	}
@@ -219,7 +240,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		$scope.animate();
 		var url = '/api/rule?' +
 			'alert=' + encodeURIComponent($scope.selected_alert) +
-			'&from=' + encodeURIComponent(set.Time);
+			'&from=' + encodeURIComponent(set.Time) +
+			'&file=' + encodeURIComponent($scope.file);
 		$http.post(url, $scope.config_text)
 			.success((data: any) => {
 				procResults(data);
@@ -339,7 +361,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 	}
 	var line_re = /test:(\d+)/;
 	$scope.validate = () => {
-		$http.post('/api/config_test', $scope.config_text)
+		$http.post('/api/config_test?file=' + encodeURIComponent($scope.file), $scope.config_text)
 			.success((data: any) => {
 				if (data == "") {
 					$scope.validationResult = "Valid";
@@ -400,7 +422,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 			'&to=' + encodeURIComponent(to.format()) +
 			'&intervals=' + encodeURIComponent(intervals) +
 			'&email=' + encodeURIComponent($scope.email) +
-			'&template_group=' + encodeURIComponent($scope.template_group);
+			'&template_group=' + encodeURIComponent($scope.template_group) +
+			'&file=' + encodeURIComponent($scope.file);
 		$http.post(url, $scope.config_text)
 			.success((data: any) => {
 				$scope.sets = data.Sets;
@@ -435,7 +458,8 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		var url = '/api/rule?' +
 			'alert=' + encodeURIComponent(alertName) +
 			'&from=' + encodeURIComponent(moment.utc(v.Time).format()) +
-			'&template_group=' + encodeURIComponent(template);
+			'&template_group=' + encodeURIComponent(template) +
+			'&file=' + encodeURIComponent($scope.file);
 		$http.post(url, $scope.config_text)
 			.success((data: any) => {
 				v.subject = data.Subject;
@@ -459,12 +483,13 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 
 	$scope.downloadConfig = () => {
 		var blob = new Blob([$scope.config_text], { type: "text/plain;charset=utf-8" });
-		saveAs(blob, "bosun.conf");
+		saveAs(blob, $scope.file.split('/').pop() || "bosun.conf");
 	}
 
 	$scope.diffConfig = () => {
 		$http.post('/api/config/diff',
 			{
+				"File": $scope.file,
 				"Config": $scope.config_text,
 				"Message": $scope.message
 			})
@@ -484,11 +509,16 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		}
 		$scope.saveResult = "Saving; Please Wait"
 		$http.post('/api/config/save', {
+			"File": $scope.file,
 			"Config": $scope.config_text,
 			"Diff": $scope.diff,
 			"Message": $scope.message
 		})
 			.success((data: any) => {
+				if (typeof data == 'string' && data.indexOf("Proposed") == 0) {
+					$scope.saveResult = data;
+					return;
+				}
 				$scope.saveResult = "Config Saved; Reloading";
 				$scope.runningHash = undefined;
 			})
@@ -504,6 +534,9 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		if ($scope.saveResult == "Config Saved; Reloading") {
 			return "alert-success"
 		}
+		if ($scope.saveResult.indexOf("Proposed") == 0) {
+			return "alert-info"
+		}
 		return "alert-danger"
 	}
 
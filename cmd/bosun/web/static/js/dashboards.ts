@@ -0,0 +1,113 @@
+/// <reference path="0-bosun.ts" />
+
+interface IDashboardsScope extends IBosunScope {
+	dashboards: any[];
+	dashboard: any;
+	values: { [name: string]: string[] };
+	vars: { [name: string]: string };
+	date: string;
+	time: string;
+	results: { [panel: string]: any };
+	errors: { [panel: string]: string };
+	error: string;
+	loading: boolean;
+	permalink: string;
+	panelURL: (panel: any, format: string) => string;
+	update: () => void;
+	tags: (group: any) => string;
+}
+
+bosunControllers.controller('DashboardsCtrl', ['$scope', '$http', '$location', function($scope: IDashboardsScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	var name: string = search.name;
+	$scope.date = search.date || '';
+	$scope.time = search.time || '';
+	$scope.vars = {};
+	$scope.results = {};
+	$scope.errors = {};
+	$scope.loading = true;
+	$scope.permalink = $location.absUrl();
+	if (!name) {
+		$http.get('/api/dashboards')
+			.success((data: any) => {
+				$scope.dashboards = data;
+			})
+			.error((error) => {
+				$scope.error = error;
+			})
+			.finally(() => {
+				$scope.loading = false;
+			});
+		return;
+	}
+	function params() {
+		var p: string[] = [];
+		_.each($scope.vars, (v: string, k: string) => {
+			if (v) {
+				p.push('var-' + encodeURIComponent(k) + '=' + encodeURIComponent(v));
+			}
+		});
+		if ($scope.date) {
+			p.push('date=' + encodeURIComponent($scope.date));
+		}
+		if ($scope.time) {
+			p.push('time=' + encodeURIComponent($scope.time));
+		}
+		return p.join('&');
+	}
+	$scope.panelURL = (panel: any, format: string) => {
+		var url = '/api/dashboards/' + encodeURIComponent(name) + '/panels/' + encodeURIComponent(panel.Name);
+		if (format) {
+			url += '.' + format;
+		}
+		return url + '?' + params();
+	};
+	$scope.update = () => {
+		var s: any = { name: name };
+		_.each($scope.vars, (v: string, k: string) => {
+			if (v) {
+				s['var-' + k] = v;
+			}
+		});
+		if ($scope.date) {
+			s.date = $scope.date;
+		}
+		if ($scope.time) {
+			s.time = $scope.time;
+		}
+		$location.search(s);
+	};
+	$scope.tags = (group: any) => {
+		var tags: string[] = [];
+		_.each(group, (v: string, k: string) => {
+			tags.push(k + '=' + v);
+		});
+		return tags.sort().join(', ');
+	};
+	$http.get('/api/dashboards/' + encodeURIComponent(name))
+		.success((data: any) => {
+			$scope.dashboard = data;
+			$scope.values = data.Values || {};
+			_.each(data.Variables, (v: any) => {
+				$scope.vars[v.Name] = search['var-' + v.Name] || v.Default || '';
+			});
+			_.each(data.Panels, (p: any) => {
+				if (p.Type == 'graph') {
+					return;
+				}
+				$http.get($scope.panelURL(p, ''))
+					.success((res: any) => {
+						$scope.results[p.Name] = res;
+					})
+					.error((error) => {
+						$scope.errors[p.Name] = error;
+					});
+			});
+		})
+		.error((error) => {
+			$scope.error = error;
+		})
+		.finally(() => {
+			$scope.loading = false;
+		});
+}]);
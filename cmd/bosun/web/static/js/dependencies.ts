@@ -0,0 +1,124 @@
+/// <reference path="0-bosun.ts" />
+
+interface IDependenciesScope extends IBosunScope {
+	graph: any;
+	error: string;
+	loading: boolean;
+	nodeLink: (node: any) => string;
+}
+
+bosunControllers.controller('DependenciesCtrl', ['$scope', '$http', function($scope: IDependenciesScope, $http: ng.IHttpService) {
+	$scope.loading = true;
+	$http.get('/api/dependencies')
+		.success((data: any) => {
+			$scope.graph = data;
+		})
+		.error((error) => {
+			$scope.error = error;
+		})
+		.finally(() => {
+			$scope.loading = false;
+		});
+	$scope.nodeLink = (node: any) => {
+		var filter = node.Type == 'alert' ? 'name:' : 'hasTag:';
+		return '/?filter=' + encodeURIComponent(filter + node.Name);
+	};
+}]);
+
+bosunApp.directive('tsDependencyGraph', ['$location', ($location: ng.ILocationService) => {
+	var colors: { [status: string]: string } = {
+		critical: '#d9534f',
+		warning: '#f0ad4e',
+		unknown: '#5bc0de',
+		normal: '#5cb85c',
+		none: '#999',
+	};
+	return {
+		scope: {
+			graph: '=',
+			link: '=',
+		},
+		link: (scope: any, elem: any, attrs: any) => {
+			scope.$watch('graph', update);
+			function update(graph: any) {
+				if (!graph || !graph.Nodes) {
+					return;
+				}
+				elem.empty();
+				var width = elem.width();
+				var height = Math.max(400, Math.min(1000, graph.Nodes.length * 30));
+				var index: { [id: string]: number } = {};
+				var nodes = graph.Nodes.map((n: any, i: number) => {
+					index[n.Id] = i;
+					return angular.extend({}, n);
+				});
+				var links = (graph.Edges || []).map((e: any) => {
+					return { source: index[e.Source], target: index[e.Target], type: e.Type };
+				});
+				var svg = d3.select(elem[0])
+					.append('svg')
+					.attr('width', width)
+					.attr('height', height);
+				svg.append('defs').append('marker')
+					.attr('id', 'dependency-arrow')
+					.attr('viewBox', '0 -5 10 10')
+					.attr('refX', 18)
+					.attr('markerWidth', 6)
+					.attr('markerHeight', 6)
+					.attr('orient', 'auto')
+					.append('path')
+					.attr('d', 'M0,-5L10,0L0,5')
+					.attr('fill', '#666');
+				var force = d3.layout.force()
+					.nodes(nodes)
+					.links(links)
+					.size([width, height])
+					.linkDistance(80)
+					.charge(-300)
+					.start();
+				var link = svg.selectAll('.dependency-link')
+					.data(links)
+					.enter().append('line')
+					.attr('class', 'dependency-link')
+					.attr('stroke', (d: any) => { return d.type == 'alert' ? '#337ab7' : '#666'; })
+					.attr('marker-end', 'url(#dependency-arrow)');
+				var node = svg.selectAll('.dependency-node')
+					.data(nodes)
+					.enter().append('g')
+					.attr('class', 'dependency-node')
+					.style('cursor', 'pointer')
+					.on('click', (d: any) => {
+						scope.$apply(() => {
+							$location.url(scope.link(d));
+						});
+					})
+					.call(force.drag);
+				node.append('title')
+					.text((d: any) => {
+						var t = d.Name + ': ' + d.Status + ' (' + d.Incidents + ' open)';
+						if (d.SuppressedBy) {
+							t += ', suppressed by ' + d.SuppressedBy;
+						}
+						return t;
+					});
+				node.append('circle')
+					.attr('r', (d: any) => { return d.Type == 'alert' ? 10 : 7; })
+					.attr('fill', (d: any) => { return colors[d.Status] || colors['none']; })
+					.attr('stroke', '#333')
+					.attr('stroke-dasharray', (d: any) => { return d.SuppressedBy ? '3,2' : null; });
+				node.append('text')
+					.attr('dx', 13)
+					.attr('dy', '.35em')
+					.style('font-style', (d: any) => { return d.Type == 'alert' ? 'normal' : 'italic'; })
+					.text((d: any) => { return d.Name; });
+				force.on('tick', () => {
+					link.attr('x1', (d: any) => { return d.source.x; })
+						.attr('y1', (d: any) => { return d.source.y; })
+						.attr('x2', (d: any) => { return d.target.x; })
+						.attr('y2', (d: any) => { return d.target.y; });
+					node.attr('transform', (d: any) => { return 'translate(' + d.x + ',' + d.y + ')'; });
+				});
+			}
+		},
+	};
+}]);
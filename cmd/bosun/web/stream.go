@@ -0,0 +1,245 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kylebrandt/boolq"
+	"github.com/leapar/bosun/cmd/bosun/sched"
+	"github.com/leapar/bosun/models"
+	"github.com/leapar/bosun/opentsdb"
+	"github.com/leapar/bosun/slog"
+	"github.com/ryanuber/go-glob"
+)
+
+// streamHeartbeat is how often an idle stream sends something, so that
+// proxies do not time it out.
+const streamHeartbeat = 30 * time.Second
+
+// streamFilter selects the stream events a client receives.
+type streamFilter struct {
+	alert string          // glob of alert names
+	tags  opentsdb.TagSet // tag value globs that alert keys must match
+	expr  *boolq.Tree     // incident filter, as on the dashboard
+	types map[string]bool // event types, or all if empty
+}
+
+func parseStreamFilter(r *http.Request) (*streamFilter, error) {
+	f := &streamFilter{alert: r.FormValue("alert")}
+	if s := r.FormValue("tags"); s != "" {
+		tags, err := opentsdb.ParseTags(s)
+		if err != nil {
+			return nil, fmt.Errorf("bad tags: %v", err)
+		}
+		f.tags = tags
+	}
+	if s := r.FormValue("filter"); s != "" {
+		expr, err := boolq.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("bad filter: %v", err)
+		}
+		f.expr = expr
+	}
+	if s := r.FormValue("types"); s != "" {
+		f.types = make(map[string]bool)
+		for _, t := range strings.Split(s, ",") {
+			switch t {
+			case sched.StreamIncident, sched.StreamAction, sched.StreamSilence:
+				f.types[t] = true
+			default:
+				return nil, fmt.Errorf("unknown event type: %s", t)
+			}
+		}
+	}
+	return f, nil
+}
+
+// streamMessage is a stream event as sent to clients. Incident and action
+// events carry the incident as it is on the dashboard.
+type streamMessage struct {
+	*sched.StreamEvent
+	Incident *sched.IncidentSummaryView `json:",omitempty"`
+}
+
+// match returns the message for e if the filter selects it, or nil.
+func (f *streamFilter) match(e *sched.StreamEvent, silenced sched.SilenceTester) (*streamMessage, error) {
+	if len(f.types) > 0 && !f.types[e.Type] {
+		return nil, nil
+	}
+	m := &streamMessage{StreamEvent: e}
+	if e.Type == sched.StreamSilence {
+		// Silences match if they could silence matching alert keys.
+		si := e.Silence
+		if si == nil {
+			return m, nil
+		}
+		if f.alert != "" && si.Alert != "" && !glob.Glob(f.alert, si.Alert) {
+			return nil, nil
+		}
+		for k, v := range f.tags {
+			if sv, ok := si.Tags[k]; ok && !glob.Glob(v, sv) && !glob.Glob(sv, v) {
+				return nil, nil
+			}
+		}
+		return m, nil
+	}
+	if f.alert != "" && !glob.Glob(f.alert, e.AlertKey.Name()) {
+		return nil, nil
+	}
+	group := e.AlertKey.Group()
+	for k, v := range f.tags {
+		if gv, ok := group[k]; !ok || !glob.Glob(v, gv) {
+			return nil, nil
+		}
+	}
+	if st := e.State(); st != nil {
+		m.Incident = sched.MakeHistoricIncidentSummary(schedule.RuleConf, silenced, st)
+		if f.expr != nil {
+			ok, err := boolq.AskParsedExpr(f.expr, m.Incident)
+			if err != nil || !ok {
+				return nil, err
+			}
+		}
+	}
+	return m, nil
+}
+
+func noneSilenced(models.AlertKey) *models.Silence { return nil }
+
+// streamWriter sends messages to a client.
+type streamWriter interface {
+	// send sends a message of an event type, with the cursor to resume
+	// after it.
+	send(event, cursor string, data interface{}) error
+	heartbeat() error
+}
+
+// Stream pushes incident, action and silence events to clients, as
+// server-sent events or over a WebSocket if the request is an upgrade.
+// Clients resume after the cursor of the last event they received with the
+// cursor parameter or the Last-Event-ID header; if events since are no
+// longer kept, they receive a reset event and should reload their state.
+func Stream(w http.ResponseWriter, r *http.Request) {
+	f, err := parseStreamFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	stream := sched.DefaultStream
+	cursor := r.FormValue("cursor")
+	if cursor == "" {
+		cursor = r.Header.Get("Last-Event-ID")
+	}
+	// Subscribe before reading, so no events are missed in between.
+	wake, cancel := stream.Subscribe()
+	defer cancel()
+	var sw streamWriter
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		ws, err := upgradeWebSocket(w, r)
+		if err == errWebSocketOrigin {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer ws.Close()
+		sw = ws
+	} else {
+		sse, err := newSSEWriter(w)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sw = sse
+	}
+	closed := r.Context().Done()
+	if ws, ok := sw.(*webSocket); ok {
+		closed = ws.closed
+	}
+	if cursor == "" {
+		cursor = stream.Cursor()
+	}
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		events, next, ok := stream.Since(cursor)
+		if !ok {
+			if err := sw.send("reset", next, map[string]string{"Type": "reset", "Cursor": next}); err != nil {
+				return
+			}
+		}
+		var silenced sched.SilenceTester = noneSilenced
+		if len(events) > 0 {
+			if st := schedule.Silenced(); st != nil {
+				silenced = st
+			}
+		}
+		for _, e := range events {
+			m, err := f.match(e, silenced)
+			if err != nil {
+				slog.Errorf("stream filter: %v", err)
+				continue
+			}
+			if m == nil {
+				continue
+			}
+			if err := sw.send(e.Type, e.Cursor, m); err != nil {
+				return
+			}
+		}
+		cursor = next
+		select {
+		case <-closed:
+			return
+		case <-wake:
+		case <-heartbeat.C:
+			if err := sw.heartbeat(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseWriter writes server-sent events.
+type sseWriter struct {
+	w http.ResponseWriter
+	f http.Flusher
+}
+
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("streaming is not supported")
+	}
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+	return &sseWriter{w, f}, nil
+}
+
+func (s *sseWriter) send(event, cursor string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "id: %s\nevent: %s\ndata: %s\n\n", cursor, event, b); err != nil {
+		return err
+	}
+	s.f.Flush()
+	return nil
+}
+
+func (s *sseWriter) heartbeat() error {
+	if _, err := fmt.Fprint(s.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	s.f.Flush()
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/leapar/bosun/_version"
+	"github.com/leapar/bosun/cmd/bosun/cache"
 	"github.com/leapar/bosun/cmd/bosun/conf"
 	"github.com/leapar/bosun/cmd/bosun/conf/rule"
 	"github.com/leapar/bosun/cmd/bosun/database"
@@ -48,7 +49,9 @@ var (
 	//currently only google's shortener.
 	InternetProxy   *url.URL
 	AnnotateBackend backend.Backend
-	reload          func() error
+	// RuleRepo is the git repository of the rule file, if GitConf is enabled.
+	RuleRepo *rule.GitRepo
+	reload   func() error
 
 	tokensEnabled bool
 	authEnabled   bool
@@ -113,6 +116,12 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	if err != nil {
 		slog.Fatal(err)
 	}
+	if err := initInbound(schedule.SystemConf.GetInboundConf()); err != nil {
+		slog.Fatal(err)
+	}
+	if err := initAudit(schedule.SystemConf.GetAuditFile()); err != nil {
+		slog.Fatal(err)
+	}
 
 	//helpers to add routes with middleware
 	handle := func(route string, h http.Handler, perms easyauth.Role) *mux.Route {
@@ -133,59 +142,100 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	}
 	router.PathPrefix("/auth/").Handler(auth.LoginHandler())
 	handleFunc("/api/", APIRedirect, fullyOpen).Name("api_redir")
-	handle("/api/action", JSON(Action), canPerformActions).Name("action").Methods(POST)
-	handle("/api/alerts", JSON(Alerts), canViewDash).Name("alerts").Methods(GET)
+	handle("/api/action", audited(JSON(Action)), scoped(canPerformActions)).Name("action").Methods(POST)
+	handle("/api/alerts", JSON(Alerts), scoped(canViewDash)).Name("alerts").Methods(GET)
+	handle("/api/audit", JSON(Audit), canViewConfig).Name("audit").Methods(GET)
 	handle("/api/config", JSON(Config), canViewConfig).Name("get_config").Methods(GET)
 
 	handle("/api/config_test", JSON(ConfigTest), canViewConfig).Name("config_test").Methods(POST)
+	handle("/api/config/lint", JSON(ConfigLint), canViewConfig).Name("config_lint").Methods(POST)
+	handle("/api/config/files", JSON(ConfigFiles), canViewConfig).Name("config_files").Methods(GET)
+	handle("/api/config/history", JSON(ConfigHistory), canViewConfig).Name("config_history").Methods(GET)
+	handle("/api/config/proposals", JSON(ConfigProposals), canViewConfig).Name("config_proposals").Methods(GET)
 	handle("/api/save_enabled", JSON(SaveEnabled), fullyOpen).Name("seve_enabled").Methods(GET)
 
 	if schedule.SystemConf.ReloadEnabled() {
-		handle("/api/reload", JSON(Reload), canSaveConfig).Name("can_save").Methods(POST)
+		handle("/api/reload", audited(JSON(Reload)), canSaveConfig).Name("can_save").Methods(POST)
 	}
 
 	if schedule.SystemConf.SaveEnabled() {
-		handle("/api/config/bulkedit", JSON(BulkEdit), canSaveConfig).Name("bulk_edit").Methods(POST)
-		handle("/api/config/save", JSON(SaveConfig), canSaveConfig).Name("config_save").Methods(POST)
-		handle("/api/config/diff", JSON(DiffConfig), canSaveConfig).Name("config_diff").Methods(POST)
+		handle("/api/config/bulkedit", audited(JSON(BulkEdit)), scoped(canSaveConfig)).Name("bulk_edit").Methods(POST)
+		handle("/api/config/save", audited(JSON(SaveConfig)), scoped(canSaveConfig)).Name("config_save").Methods(POST)
+		handle("/api/config/diff", JSON(DiffConfig), scoped(canSaveConfig)).Name("config_diff").Methods(POST)
+		handle("/api/config/revert/{rev}", audited(JSON(RevertConfig)), canSaveConfig).Name("config_revert").Methods(POST)
+		handle("/api/config/proposals/{id}/approve", audited(JSON(ApproveConfig)), canSaveConfig).Name("config_approve").Methods(POST)
+		handle("/api/config/proposals/{id}/reject", audited(JSON(RejectConfig)), canSaveConfig).Name("config_reject").Methods(POST)
 		handle("/api/config/running_hash", JSON(ConfigRunningHash), canViewConfig).Name("config_hash").Methods(GET)
 	}
 
 	handle("/api/egraph/{bs}.{format:svg|png}", JSON(ExprGraph), canRunTests).Name("expr_graph")
-	handle("/api/errors", JSON(ErrorHistory), canViewDash).Name("errors").Methods(GET, POST)
+	handle("/api/dashboards", JSON(Dashboards), canViewDash).Name("dashboards").Methods(GET)
+	handle("/api/dashboards", audited(JSON(PutDashboard)), canSaveConfig).Name("dashboard_put").Methods(POST)
+	handle("/api/dashboards/{name}", JSON(Dashboard), canViewDash).Name("dashboard").Methods(GET)
+	handle("/api/dashboards/{name}", audited(JSON(DeleteDashboard)), canSaveConfig).Name("dashboard_delete").Methods(http.MethodDelete)
+	handle("/api/dashboards/{name}/panels/{panel}.{format:svg|png}", JSON(DashboardPanelGraph), canViewDash).Name("dashboard_panel_graph").Methods(GET)
+	handle("/api/dashboards/{name}/panels/{panel}", JSON(DashboardPanel), canViewDash).Name("dashboard_panel").Methods(GET)
+	handle("/api/dependencies", JSON(Dependencies), canViewDash).Name("dependencies").Methods(GET)
+	handle("/api/errors", audited(JSON(ErrorHistory)), canViewDash).Name("errors").Methods(GET, POST)
 	handle("/api/expr", JSON(Expr), canRunTests).Name("expr").Methods(POST)
 	handle("/api/graph", JSON(Graph), canViewDash).Name("graph").Methods(GET)
 
 	handle("/api/health", JSON(HealthCheck), fullyOpen).Name("health_check").Methods(GET)
+	handle("/api/cache", JSON(QueryCacheStats), canViewDash).Name("cache_stats").Methods(GET)
+	handle("/api/cache/flush", audited(JSON(QueryCacheFlush)), canSaveConfig).Name("cache_flush").Methods(POST)
 	handle("/api/host", JSON(Host), canViewDash).Name("host").Methods(GET)
+	handle("/api/inventory/changes", JSON(InventoryChanges), canViewDash).Name("inventory_changes").Methods(GET)
+	handle("/api/inventory/{host}/history", JSON(InventoryHistory), canViewDash).Name("inventory_history").Methods(GET)
+	handle("/api/stale", JSON(Stale), canViewDash).Name("stale").Methods(GET)
 	handle("/api/last", JSON(Last), canViewDash).Name("last").Methods(GET)
 	handle("/api/quiet", JSON(Quiet), canViewDash).Name("quiet").Methods(GET)
 	handle("/api/incidents/open", JSON(ListOpenIncidents), canViewDash).Name("open_incidents").Methods(GET)
+	handle("/api/incidents/search", JSON(SearchIncidents), canViewDash).Name("search_incidents").Methods(GET)
+	handle("/api/reports/digest", audited(JSON(ReportDigest)), canSaveConfig).Name("report_digest").Methods(POST)
+	handle("/api/reports/{kind}", JSON(Reports), canViewDash).Name("reports").Methods(GET)
+	handle("/api/report/{name}/run", JSON(RunReport), canRunTests).Name("report_preview").Methods(GET)
+	handle("/api/report/{name}/run", audited(JSON(RunReport)), canSaveConfig).Name("report_run").Methods(POST)
+	handle("/api/slo", JSON(SLOs), canViewDash).Name("slos").Methods(GET)
+	handle("/api/slo/{name}", JSON(SLO), canViewDash).Name("slo").Methods(GET)
 	handle("/api/incidents/events", JSON(IncidentEvents), canViewDash).Name("incident_events").Methods(GET)
+	// Streams skip the base chain: they must not be compressed or buffered.
+	router.Handle("/api/stream", auth.Wrap(http.HandlerFunc(Stream), canViewDash)).Name("stream").Methods(GET)
+	if len(inboundProviders) > 0 {
+		// Providers authenticate with their own token or signature.
+		handle("/api/inbound/audit", JSON(InboundAudit), canViewConfig).Name("inbound_audit").Methods(GET)
+		handle("/api/inbound/{provider}", audited(JSON(Inbound)), fullyOpen).Name("inbound").Methods(POST)
+	}
 	handle("/api/metadata/get", JSON(GetMetadata), canViewDash).Name("meta_get").Methods(GET)
 	handle("/api/metadata/metrics", JSON(MetadataMetrics), canViewDash).Name("meta_metrics").Methods(GET)
-	handle("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
-	handle("/api/metadata/delete", JSON(DeleteMetadata), canPutData).Name("meta_delete").Methods(http.MethodDelete)
+	handle("/api/metadata/put", audited(JSON(PutMetadata)), canPutData).Name("meta_put").Methods(POST)
+	handle("/api/metadata/delete", audited(JSON(DeleteMetadata)), canPutData).Name("meta_delete").Methods(http.MethodDelete)
+	handle("/api/metadata/catalog", JSON(MetricCatalog), canViewDash).Name("meta_catalog").Methods(GET)
+	handle("/api/metadata/catalog", audited(JSON(PutMetricCatalog)), canPutData).Name("meta_catalog_put").Methods(POST)
+	handle("/api/metadata/catalog/history", JSON(MetricCatalogHistory), canViewDash).Name("meta_catalog_history").Methods(GET)
 	handle("/api/metric", JSON(UniqueMetrics), canViewDash).Name("meta_uniqe_metrics").Methods(GET)
 	handle("/api/metric/{tagk}", JSON(MetricsByTagKey), canViewDash).Name("meta_metrics_by_tag").Methods(GET)
 	handle("/api/metric/{tagk}/{tagv}", JSON(MetricsByTagPair), canViewDash).Name("meta_metric_by_tag_pair").Methods(GET)
 	handle("/api/rule", JSON(Rule), canRunTests).Name("rule_test").Methods(POST)
 	handle("/api/shorten", JSON(Shorten), canViewDash).Name("shorten")
-	handle("/api/silence/clear", JSON(SilenceClear), canSilence).Name("silence_clear")
+	handle("/api/silence/clear", audited(JSON(SilenceClear)), scoped(canSilence)).Name("silence_clear")
 	handle("/api/silence/get", JSON(SilenceGet), canViewDash).Name("silence_get").Methods(GET)
-	handle("/api/silence/set", JSON(SilenceSet), canSilence).Name("silence_set")
+	handle("/api/silence/set", audited(JSON(SilenceSet)), scoped(canSilence)).Name("silence_set")
 	handle("/api/status", JSON(Status), canViewDash).Name("status").Methods(GET)
 	handle("/api/tagk/{metric}", JSON(TagKeysByMetric), canViewDash).Name("search_tkeys_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}", JSON(TagValuesByTagKey), canViewDash).Name("search_tvals_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}/{metric}", JSON(TagValuesByMetricTagKey), canViewDash).Name("search_tvals_by_metrictagkey").Methods(GET)
 	handle("/api/tagsets/{metric}", JSON(FilteredTagsetsByMetric), canViewDash).Name("search_tagsets_by_metric").Methods(GET)
+	handle("/api/search/prune", JSON(SearchPruneStatus), canViewConfig).Name("search_prune_status").Methods(GET)
+	handle("/api/search/prune", audited(JSON(SearchPrune)), canSaveConfig).Name("search_prune").Methods(POST)
+	handle("/api/search/export", JSON(SearchExport), canViewConfig).Name("search_export").Methods(GET)
+	handle("/api/search/import", audited(JSON(SearchImport)), canSaveConfig).Name("search_import").Methods(POST)
 	handle("/api/opentsdb/version", JSON(OpenTSDBVersion), fullyOpen).Name("otsdb_version").Methods(GET)
 	handle("/api/annotate", JSON(AnnotateEnabled), fullyOpen).Name("annotate_enabled").Methods(GET)
 
 	handle("/api/v1/metrics", JSON(MetricsTagvTagk), canViewDash).Name("metric_tagk_tagv").Methods(GET)
 	handle("/api/v1/tagsets", JSON(AllTagSets), canViewDash).Name("all_tagk_tagv").Methods(GET)
 
-	handle("/api/host/tag", JSON(HostTag), canViewDash).Name("add_tag_host").Methods(POST)
+	handle("/api/host/tag", audited(JSON(HostTag)), canViewDash).Name("add_tag_host").Methods(POST)
 
 	// Annotations
 	if schedule.SystemConf.AnnotateEnabled() {
@@ -199,7 +249,7 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 		router.PathPrefix("/login").Handler(http.StripPrefix("/login", auth.LoginHandler())).Name("auth")
 	}
 	if tokens != nil {
-		handle("/api/tokens", tokens.AdminHandler(), canManageTokens).Name("tokens")
+		handle("/api/tokens", audited(tokens.AdminHandler()), canManageTokens).Name("tokens")
 	}
 
 	router.Handle("/api/version", baseChain.ThenFunc(Version)).Name("version").Methods(GET)
@@ -305,6 +355,9 @@ func indexTSDB(r *http.Request, body []byte) {
 		collect.Add("search.puts_relayed", tags, 1)
 		collect.Add("search.datapoints_relayed", tags, int64(len(mdp)))
 		schedule.Search.Index(mdp)
+		if schedule.Catalog != nil {
+			schedule.Catalog.Validate(mdp)
+		}
 	}
 }
 
@@ -364,7 +417,7 @@ func Index(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interf
 	}
 	if u != nil {
 		as.Username = u.Username
-		as.Permissions = u.Access
+		as.Permissions = u.Access | userScopes(r)
 	}
 	settings, err := json.Marshal(as)
 	if err != nil {
@@ -480,6 +533,12 @@ func Quiet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interf
 	return schedule.GetQuiet(), nil
 }
 
+// Dependencies returns the graph of alert dependencies and of tags and their
+// upstream tags, with the status of their open incidents.
+func Dependencies(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Dependencies()
+}
+
 func HealthCheck(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var h Health
 	h.RuleCheck = schedule.LastCheck.After(time.Now().Add(-schedule.SystemConf.GetCheckFrequency()))
@@ -489,6 +548,19 @@ func HealthCheck(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 	return h, nil
 }
 
+// QueryCacheStats returns the size and hit counts of the query cache shared by
+// alert checks and the web UI, and of the web UI's own cache.
+func QueryCacheStats(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return []cache.Stats{schedule.QueryCache.Stats(), cacheObj.Stats()}, nil
+}
+
+// QueryCacheFlush empties the query caches, so that the next query to each
+// backend is fetched again.
+func QueryCacheFlush(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := schedule.QueryCache.Flush() + cacheObj.Flush()
+	return fmt.Sprintf("flushed %d entries", n), nil
+}
+
 func OpenTSDBVersion(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	if schedule.SystemConf.GetTSDBContext() != nil {
 		return schedule.SystemConf.GetTSDBContext().Version(), nil
@@ -506,6 +578,7 @@ func PutMetadata(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 	if err := d.Decode(&ms); err != nil {
 		return nil, err
 	}
+	auditTarget(w, "%d metadata", len(ms))
 	for _, m := range ms {
 		err := schedule.PutMetadata(metadata.Metakey{
 			Metric: m.Metric,
@@ -529,6 +602,7 @@ func DeleteMetadata(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request
 	if err := d.Decode(&ms); err != nil {
 		return nil, err
 	}
+	auditTarget(w, "%d metadata", len(ms))
 	for _, m := range ms {
 		err := schedule.DeleteMetadata(m.Tags, m.Name)
 		if err != nil {
@@ -553,29 +627,20 @@ func GetMetadata(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 
 type MetricMetaTagKeys struct {
 	*database.MetricMetadata
-	TagKeys []string
+	TagKeys   []string
+	Catalog   *database.MetricCatalog `json:",omitempty"`
+	Violation *sched.CatalogViolation `json:",omitempty"`
 }
 
 func MetadataMetrics(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	metric := r.FormValue("metric")
 	uid := r.FormValue("uid")
 	if metric != "" {
-		m, err := schedule.MetadataMetrics(metric)
-		if err != nil {
-			return nil, err
-		}
-		keymap, err := schedule.DataAccess.Search().GetTagKeysForMetric(metric, uid)
-		if err != nil {
-			return nil, err
-		}
-		var keys []string
-		for k := range keymap {
-			keys = append(keys, k)
-		}
-		return &MetricMetaTagKeys{
-			MetricMetadata: m,
-			TagKeys:        keys,
-		}, nil
+		return metricMetaTagKeys(metric, uid, nil)
+	}
+	catalogs, err := schedule.DataAccess.Metadata().GetMetricCatalogs()
+	if err != nil {
+		return nil, err
 	}
 	all := make(map[string]*MetricMetaTagKeys)
 	metrics, err := schedule.DataAccess.Search().GetAllMetrics(uid)
@@ -586,28 +651,127 @@ func MetadataMetrics(t miniprofiler.Timer, w http.ResponseWriter, r *http.Reques
 		if strings.HasPrefix(metric, "__") {
 			continue
 		}
-		m, err := schedule.MetadataMetrics(metric)
+		m, err := metricMetaTagKeys(metric, uid, catalogs)
 		if err != nil {
 			return nil, err
 		}
-		keymap, err := schedule.DataAccess.Search().GetTagKeysForMetric(metric, uid)
-		if err != nil {
+		all[metric] = m
+	}
+	return all, nil
+}
+
+// metricMetaTagKeys returns the metadata, tag keys and catalogue entry of
+// metric. The entry is looked up in catalogs if it is not nil.
+func metricMetaTagKeys(metric, uid string, catalogs map[string]*database.MetricCatalog) (*MetricMetaTagKeys, error) {
+	m, err := schedule.MetadataMetrics(metric)
+	if err != nil {
+		return nil, err
+	}
+	keymap, err := schedule.DataAccess.Search().GetTagKeysForMetric(metric, uid)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for k := range keymap {
+		keys = append(keys, k)
+	}
+	mm := &MetricMetaTagKeys{
+		MetricMetadata: m,
+		TagKeys:        keys,
+	}
+	if catalogs != nil {
+		mm.Catalog = catalogs[metric]
+	} else if mm.Catalog, err = schedule.DataAccess.Metadata().GetMetricCatalog(metric); err != nil {
+		return nil, err
+	}
+	if schedule.Catalog != nil {
+		mm.Violation = schedule.Catalog.Violation(metric)
+	}
+	return mm, nil
+}
+
+// MetricCatalog returns the catalogue entry of the metric parameter and its
+// latest violation, or the entries of all metrics if it is empty.
+func MetricCatalog(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	metric := r.FormValue("metric")
+	if metric == "" {
+		return schedule.DataAccess.Metadata().GetMetricCatalogs()
+	}
+	c, err := schedule.DataAccess.Metadata().GetMetricCatalog(metric)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("no catalogue entry for metric %s", metric)
+	}
+	var v *sched.CatalogViolation
+	if schedule.Catalog != nil {
+		v = schedule.Catalog.Violation(metric)
+	}
+	return struct {
+		*database.MetricCatalog
+		Violation *sched.CatalogViolation `json:",omitempty"`
+	}{c, v}, nil
+}
+
+// PutMetricCatalog sets the catalogue entry of a metric.
+func PutMetricCatalog(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var c database.MetricCatalog
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		return nil, err
+	}
+	auditTarget(w, "%s", c.Metric)
+	c.User = getUsername(r)
+	c.Time = time.Now().UTC()
+	if err := schedule.Catalog.Put(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// MetricCatalogHistory returns the versions of the catalogue entry of the
+// metric parameter, newest first.
+func MetricCatalogHistory(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	metric := r.FormValue("metric")
+	if metric == "" {
+		return nil, fmt.Errorf("missing metric")
+	}
+	limit := 100
+	if v := r.FormValue("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil {
 			return nil, err
 		}
-		var keys []string
-		for k := range keymap {
-			keys = append(keys, k)
-		}
-		all[metric] = &MetricMetaTagKeys{
-			MetricMetadata: m,
-			TagKeys:        keys,
-		}
 	}
-	return all, nil
+	return schedule.DataAccess.Metadata().GetMetricCatalogHistory(metric, limit)
 }
 
 func Alerts(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
-	return schedule.MarshalGroups(t, r.FormValue("filter"))
+	groups, err := schedule.MarshalGroups(t, r.FormValue("filter"))
+	if err != nil || userHas(r, canViewDash) {
+		return groups, err
+	}
+	groups.Groups.NeedAck = scopeGroups(r, groups.Groups.NeedAck)
+	groups.Groups.Acknowledged = scopeGroups(r, groups.Groups.Acknowledged)
+	return groups, nil
+}
+
+// scopeGroups returns the groups with only the alert keys the user of r may
+// view through scopes.
+func scopeGroups(r *http.Request, groups []*sched.StateGroup) []*sched.StateGroup {
+	var scoped []*sched.StateGroup
+	for _, g := range groups {
+		if len(g.Children) > 0 {
+			g.Children = scopeGroups(r, g.Children)
+			if len(g.Children) == 0 {
+				continue
+			}
+		} else if !userCan(r, canViewDash, g.AlertKey.Name(), g.AlertKey.Group()) {
+			continue
+		}
+		scoped = append(scoped, g)
+	}
+	return scoped
 }
 
 type ExtStatus struct {
@@ -708,6 +872,9 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		Notify  bool
 		User    string
 		Time    *time.Time
+		// Assignee and Team are the new owners of assign actions.
+		Assignee string
+		Team     string
 	}
 	j := json.NewDecoder(r.Body)
 	if err := j.Decode(&data); err != nil {
@@ -730,6 +897,12 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		at = models.ActionPurge
 	case "note":
 		at = models.ActionNote
+	case "assign":
+		at = models.ActionAssign
+	case "unassign":
+		at = models.ActionUnassign
+	case "escalate":
+		at = models.ActionEscalate
 	}
 	errs := make(MultiError)
 	r.ParseForm()
@@ -741,13 +914,42 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 	} else if data.User == "" {
 		data.User = getUsername(r)
 	}
+	auditTarget(w, "%s by %s of keys %v ids %v", data.Type, data.User, data.Keys, data.Ids)
+	if !userHas(r, canPerformActions) {
+		// Check every incident first so that none are acted on unless all are in scope.
+		var aks []models.AlertKey
+		for _, key := range data.Keys {
+			ak, err := models.ParseAlertKey(key)
+			if err != nil {
+				return nil, err
+			}
+			aks = append(aks, ak)
+		}
+		for _, id := range data.Ids {
+			state, err := schedule.DataAccess.State().GetIncidentState(id)
+			if err != nil {
+				return nil, err
+			}
+			aks = append(aks, state.AlertKey)
+		}
+		for _, ak := range aks {
+			if !userCan(r, canPerformActions, ak.Name(), ak.Group()) {
+				http.Error(w, fmt.Sprintf("Not authorized to act on %s", ak), http.StatusForbidden)
+				return nil, nil
+			}
+		}
+	}
 
 	for _, key := range data.Keys {
 		ak, err := models.ParseAlertKey(key)
 		if err != nil {
 			return nil, err
 		}
-		err = schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
+		if at == models.ActionAssign {
+			err = schedule.AssignByAlertKey(data.User, data.Message, data.Assignee, data.Team, ak)
+		} else {
+			err = schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
+		}
 		if err != nil {
 			errs[key] = err
 		} else {
@@ -755,7 +957,13 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		}
 	}
 	for _, id := range data.Ids {
-		ak, err := schedule.ActionByIncidentId(data.User, data.Message, at, data.Time, id)
+		var ak models.AlertKey
+		var err error
+		if at == models.ActionAssign {
+			ak, err = schedule.AssignByIncidentId(data.User, data.Message, data.Assignee, data.Team, id)
+		} else {
+			ak, err = schedule.ActionByIncidentId(data.User, data.Message, at, data.Time, id)
+		}
 		if err != nil {
 			errs[fmt.Sprintf("%v", id)] = err
 		} else {
@@ -846,14 +1054,51 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if ok {
 		username = data["user"]
 	}
-	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"])
+	if !userHas(r, canSilence) {
+		var tags opentsdb.TagSet
+		if data["tags"] != "" {
+			if tags, err = opentsdb.ParseTags(data["tags"]); err != nil && tags == nil {
+				return nil, err
+			}
+		}
+		if !userCanSilence(r, data["alert"], tags) {
+			http.Error(w, "Not authorized to silence alerts outside of your scopes", http.StatusForbidden)
+			return nil, nil
+		}
+		if data["edit"] != "" && !silenceInScope(r, data["edit"]) {
+			http.Error(w, "Not authorized to change the silence", http.StatusForbidden)
+			return nil, nil
+		}
+	}
+	confirm := len(data["confirm"]) > 0
+	if confirm {
+		auditTarget(w, "alert %q tags %q by %s from %s to %s, replacing %q", data["alert"], data["tags"], username, start, end, data["edit"])
+	} else {
+		auditSkip(w)
+	}
+	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", confirm, data["edit"], username, data["message"])
 }
 
 func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	id := r.FormValue("id")
+	auditTarget(w, "%s", id)
+	if !userHas(r, canSilence) && !silenceInScope(r, id) {
+		http.Error(w, "Not authorized to clear the silence", http.StatusForbidden)
+		return nil, nil
+	}
 	return nil, schedule.ClearSilence(id)
 }
 
+// silenceInScope returns true if the user of r may change the silence id.
+func silenceInScope(r *http.Request, id string) bool {
+	silences, err := schedule.DataAccess.Silence().ListSilences(0)
+	if err != nil {
+		return false
+	}
+	si, ok := silences[id]
+	return ok && userCanSilence(r, si.Alert, si.Tags)
+}
+
 func ConfigTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	b, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -862,13 +1107,56 @@ func ConfigTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if len(b) == 0 {
 		return nil, fmt.Errorf("empty config")
 	}
-	_, err = rule.NewConf("test", schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), string(b))
+	_, err = parseRuleText("test", r.FormValue("file"), string(b))
 	if err != nil {
 		fmt.Fprintf(w, err.Error())
 	}
 	return nil, nil
 }
 
+// parseRuleText loads text as a rule config named name, or, if file is set,
+// as that file of the rule config with its other files.
+func parseRuleText(name, file, text string) (*rule.Conf, error) {
+	if file != "" {
+		return rule.ParseFiles(schedule.SystemConf.GetRuleFilePath(), schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), map[string]string{file: text})
+	}
+	return rule.NewConf(name, schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), text)
+}
+
+// ConfigLint returns the errors and warnings found in the rule config in the
+// request body, or in the running rule config if the body is empty. If file
+// is set, the body is that file of the rule config.
+func ConfigLint(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	uid := r.FormValue("uid")
+	if uid == "" {
+		uid = schedule.SystemConf.GetUid()
+	}
+	metrics, err := schedule.Search.UniqueMetrics(uid, 0)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(metrics))
+	for _, m := range metrics {
+		seen[m] = true
+	}
+	metricSeen := func(metric string) bool {
+		return seen[metric]
+	}
+	backends, vars := schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars()
+	if file := r.FormValue("file"); len(b) == 0 || file != "" {
+		var override map[string]string
+		if len(b) != 0 {
+			override = map[string]string{file: string(b)}
+		}
+		return rule.LintFiles(schedule.SystemConf.GetRuleFilePath(), backends, vars, override, metricSeen), nil
+	}
+	return rule.Lint("lint", backends, vars, string(b), metricSeen), nil
+}
+
 func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var text string
 	var err error
@@ -878,12 +1166,20 @@ func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 			return nil, err
 		}
 	} else {
-		text = schedule.RuleConf.GetRawText()
+		text, err = schedule.RuleConf.GetRawText(r.FormValue("file"))
+		if err != nil {
+			return nil, err
+		}
 	}
 	fmt.Fprint(w, text)
 	return nil, nil
 }
 
+// ConfigFiles returns the names of the files of the rule config.
+func ConfigFiles(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.RuleConf.GetFiles(), nil
+}
+
 func APIRedirect(w http.ResponseWriter, req *http.Request) {
 	http.Redirect(w, req, "http://github.com/leapar/bosun/api.html", 302)
 }
@@ -893,6 +1189,68 @@ func Host(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	return schedule.Host(uid, r.FormValue("filter"))
 }
 
+// InventoryHistory returns the latest inventory snapshot of a host and its
+// changes, newest first.
+func InventoryHistory(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	host := mux.Vars(r)["host"]
+	limit := 100
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit < 0 {
+			return nil, fmt.Errorf("bad limit: %s", s)
+		}
+	}
+	inv, err := schedule.DataAccess.Inventory().GetInventory(host)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil {
+		return nil, fmt.Errorf("no inventory of host %s", host)
+	}
+	changes, err := schedule.DataAccess.Inventory().GetInventoryHistory(host, limit)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Inventory *models.Inventory
+		Changes   []*models.InventoryChange
+	}{inv, changes}, nil
+}
+
+// InventoryChanges returns the inventory changes of all hosts since the since
+// parameter, which defaults to a day ago, oldest first.
+func InventoryChanges(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	now := time.Now().UTC()
+	since, err := parseSearchTime(r.FormValue("since"), now)
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() {
+		since = now.Add(-24 * time.Hour)
+	}
+	return schedule.DataAccess.Inventory().GetInventoryChanges(since)
+}
+
+// Stale returns the groups of heartbeats whose metric has stopped reporting,
+// limited to the heartbeat named by the heartbeat parameter if it is set.
+func Stale(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	stale, err := schedule.StaleGroups(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	name := r.FormValue("heartbeat")
+	if name == "" {
+		return stale, nil
+	}
+	filtered := []*sched.Stale{}
+	for _, st := range stale {
+		if st.Heartbeat == name {
+			filtered = append(filtered, st)
+		}
+	}
+	return filtered, nil
+}
+
 // Last returns the most recent datapoint for a metric+tagset. The metric+tagset
 // string should be formated like os.cpu{host=foo}. The tag porition expects the
 // that the keys will be in alphabetical order.
@@ -0,0 +1,208 @@
+package web
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// webSocket is the server side of a WebSocket (RFC 6455) that only pushes
+// text messages. Messages from the client are read and discarded, except to
+// answer pings and closes.
+type webSocket struct {
+	conn   net.Conn
+	rw     *bufio.ReadWriter
+	mu     sync.Mutex // serializes writes
+	closed chan struct{}
+}
+
+const webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	wsText  = 0x1
+	wsClose = 0x8
+	wsPing  = 0x9
+	wsPong  = 0xA
+)
+
+// wsWriteTimeout bounds writes to clients that stopped reading.
+const wsWriteTimeout = 10 * time.Second
+
+// errWebSocketOrigin is returned when a browser opens a WebSocket from a
+// page of another site.
+var errWebSocketOrigin = fmt.Errorf("websocket: origin not allowed")
+
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (*webSocket, error) {
+	if r.Method != http.MethodGet {
+		return nil, fmt.Errorf("websocket: method must be GET")
+	}
+	if !sameOrigin(r) {
+		return nil, errWebSocketOrigin
+	}
+	if !headerContains(r.Header, "Connection", "upgrade") {
+		return nil, fmt.Errorf("websocket: missing Connection: upgrade")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		return nil, fmt.Errorf("websocket: unsupported version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, fmt.Errorf("websocket: missing Sec-WebSocket-Key")
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, fmt.Errorf("websocket: connection cannot be hijacked")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	h := sha1.New()
+	io.WriteString(h, key+webSocketGUID)
+	accept := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", accept)
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	ws := &webSocket{conn: conn, rw: rw, closed: make(chan struct{})}
+	go ws.readLoop()
+	return ws, nil
+}
+
+// sameOrigin returns whether the Origin header of r, if any, is of the host
+// r was sent to. Browsers always send it, and cookies with the upgrade, so
+// other sites could otherwise read the stream as the user.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h[name] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readLoop reads frames from the client until it closes the connection.
+func (ws *webSocket) readLoop() {
+	defer close(ws.closed)
+	var header [2]byte
+	for {
+		if _, err := io.ReadFull(ws.rw, header[:]); err != nil {
+			return
+		}
+		opcode := header[0] & 0x0f
+		masked := header[1]&0x80 != 0
+		n := uint64(header[1] & 0x7f)
+		switch n {
+		case 126:
+			var ext [2]byte
+			if _, err := io.ReadFull(ws.rw, ext[:]); err != nil {
+				return
+			}
+			n = uint64(binary.BigEndian.Uint16(ext[:]))
+		case 127:
+			var ext [8]byte
+			if _, err := io.ReadFull(ws.rw, ext[:]); err != nil {
+				return
+			}
+			n = binary.BigEndian.Uint64(ext[:])
+		}
+		var mask [4]byte
+		if masked {
+			if _, err := io.ReadFull(ws.rw, mask[:]); err != nil {
+				return
+			}
+		}
+		switch opcode {
+		case wsPing, wsClose:
+			// Control frames are at most 125 bytes.
+			if n > 125 {
+				return
+			}
+			payload := make([]byte, n)
+			if _, err := io.ReadFull(ws.rw, payload); err != nil {
+				return
+			}
+			for i := range payload {
+				payload[i] ^= mask[i%4]
+			}
+			if opcode == wsClose {
+				ws.writeFrame(wsClose, payload)
+				return
+			}
+			ws.writeFrame(wsPong, payload)
+		default:
+			if _, err := io.CopyN(ioutil.Discard, ws.rw, int64(n)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (ws *webSocket) writeFrame(opcode byte, payload []byte) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	header := []byte{0x80 | opcode}
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n <= 0xffff:
+		header = append(header, 126, byte(n>>8), byte(n))
+	default:
+		header = append(header, 127)
+		var ext [8]byte
+		binary.BigEndian.PutUint64(ext[:], uint64(n))
+		header = append(header, ext[:]...)
+	}
+	if _, err := ws.rw.Write(header); err != nil {
+		return err
+	}
+	if _, err := ws.rw.Write(payload); err != nil {
+		return err
+	}
+	return ws.rw.Flush()
+}
+
+// send sends data as a JSON text message. The event type and cursor are
+// fields of the data.
+func (ws *webSocket) send(event, cursor string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return ws.writeFrame(wsText, b)
+}
+
+func (ws *webSocket) heartbeat() error {
+	return ws.writeFrame(wsPing, nil)
+}
+
+func (ws *webSocket) Close() error {
+	return ws.conn.Close()
+}
@@ -0,0 +1,27 @@
+package web
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSameOrigin(t *testing.T) {
+	tests := map[string]bool{
+		"":                          true,
+		"http://bosun:8070":         true,
+		"https://BOSUN:8070":        true,
+		"http://bosun":              false,
+		"http://evil.example.com":   false,
+		"http://bosun:8070.evil.io": false,
+		"null":                      false,
+	}
+	for origin, want := range tests {
+		r := httptest.NewRequest("GET", "http://bosun:8070/api/stream", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if got := sameOrigin(r); got != want {
+			t.Errorf("%q: got %v, want %v", origin, got, want)
+		}
+	}
+}
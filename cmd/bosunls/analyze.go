@@ -0,0 +1,243 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
+	eparse "github.com/leapar/bosun/cmd/bosun/expr/parse"
+)
+
+// refKeys maps keys whose values name sections to the type of section.
+var refKeys = map[string]string{
+	"template":         "template",
+	"unknownTemplate":  "template",
+	"macro":            "macro",
+	"critNotification": "notification",
+	"warnNotification": "notification",
+	"next":             "notification",
+	"escalation":       "escalation",
+	"holidays":         "holidays",
+}
+
+// refFuncs maps functions whose first argument names a section to the type
+// of section.
+var refFuncs = map[string]string{
+	"lookup":          "lookup",
+	"lookupSeries":    "lookup",
+	"alert":           "alert",
+	"isHoliday":       "holidays",
+	"thresholdByTime": "schedule",
+}
+
+// cursor describes what is being edited at a position in a rule file.
+type cursor struct {
+	key      string // key of the pair being edited, if any
+	value    string // value of the pair up to the position
+	inString bool   // whether the position is in a string in the value
+	str      string // the string up to the position
+	fn       string // the innermost function call around the position
+	arg      int    // the argument of fn that the position is in
+	word     string // the identifier up to the position
+}
+
+var pairRE = regexp.MustCompile(`^\s*(\$?[\w.]+)\s*=\s*(.*)$`)
+
+// cursorAt returns the cursor at the byte offset in text.
+func cursorAt(text string, offset int) cursor {
+	line := text[strings.LastIndex(text[:offset], "\n")+1 : offset]
+	var c cursor
+	m := pairRE.FindStringSubmatch(line)
+	if m == nil {
+		return c
+	}
+	c.key, c.value = m[1], m[2]
+	type call struct {
+		fn  string
+		arg int
+	}
+	var calls []call
+	strStart := 0
+	for i := 0; i < len(c.value); i++ {
+		ch := c.value[i]
+		if c.inString {
+			switch ch {
+			case '\\':
+				i++
+			case '"':
+				c.inString = false
+			}
+			continue
+		}
+		switch ch {
+		case '"':
+			c.inString = true
+			strStart = i + 1
+		case '(':
+			calls = append(calls, call{fn: trailingWord(strings.TrimRight(c.value[:i], " \t"), isFuncChar)})
+		case ')':
+			if len(calls) > 0 {
+				calls = calls[:len(calls)-1]
+			}
+		case ',':
+			if len(calls) > 0 {
+				calls[len(calls)-1].arg++
+			}
+		}
+	}
+	if len(calls) > 0 {
+		c.fn, c.arg = calls[len(calls)-1].fn, calls[len(calls)-1].arg
+	}
+	if c.inString {
+		c.str = c.value[strStart:]
+	} else {
+		c.word = trailingWord(c.value, isNameChar)
+	}
+	return c
+}
+
+func isFuncChar(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// isNameChar reports whether c can be part of a variable or section name.
+func isNameChar(c byte) bool {
+	return isFuncChar(c) || c == '$' || c == '.' || c == '-'
+}
+
+func trailingWord(s string, isWordChar func(byte) bool) string {
+	i := len(s)
+	for i > 0 && isWordChar(s[i-1]) {
+		i--
+	}
+	return s[i:]
+}
+
+// wordAt returns the start and end offsets of the word around offset.
+func wordAt(text string, offset int, isWordChar func(byte) bool) (start, end int) {
+	start, end = offset, offset
+	for start > 0 && isWordChar(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isWordChar(text[end]) {
+		end++
+	}
+	return
+}
+
+// reference is a name of a section of the given type.
+type reference struct {
+	sectionType string
+	name        string
+	start, end  int // offsets of the name in the text
+}
+
+// referenceAt returns the section referred to at offset, if any. Global
+// variables are returned with a sectionType of "$".
+func referenceAt(text string, offset int) (ref reference, ok bool) {
+	start, end := wordAt(text, offset, isNameChar)
+	if start == end {
+		return
+	}
+	c := cursorAt(text, end)
+	switch {
+	case strings.HasPrefix(text[start:end], "$"):
+		// Variables are expanded in strings too.
+		return reference{"$", text[start:end], start, end}, true
+	case c.inString:
+		if t, found := refFuncs[c.fn]; found && c.arg == 0 {
+			start, end = wordAt(text, offset, func(b byte) bool { return b != '"' && b != '\n' })
+			return reference{t, text[start:end], start, end}, true
+		}
+	case refKeys[c.key] != "" && c.fn == "":
+		return reference{refKeys[c.key], text[start:end], start, end}, true
+	}
+	return
+}
+
+// findDefinition returns the node that defines ref in tree.
+func findDefinition(tree *parse.Tree, ref reference) parse.Node {
+	for _, n := range tree.Root.Nodes {
+		switch n := n.(type) {
+		case *parse.SectionNode:
+			if n.SectionType.Text == ref.sectionType && n.Name.Text == ref.name {
+				return n
+			}
+		case *parse.PairNode:
+			if ref.sectionType == "$" && n.Key.Text == ref.name {
+				return n
+			}
+		}
+	}
+	return nil
+}
+
+// names returns the names of the sections of the given type in tree, or of
+// the global variables if sectionType is "$".
+func names(tree *parse.Tree, sectionType string) []string {
+	var names []string
+	for _, n := range tree.Root.Nodes {
+		switch n := n.(type) {
+		case *parse.SectionNode:
+			if n.SectionType.Text == sectionType {
+				names = append(names, n.Name.Text)
+			}
+		case *parse.PairNode:
+			if sectionType == "$" && strings.HasPrefix(n.Key.Text, "$") {
+				names = append(names, n.Key.Text)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// signature returns the signature of function name, such as
+// "q(string, string, string) series".
+func signature(name string, f eparse.Func) string {
+	args := make([]string, len(f.Args))
+	for i, a := range f.Args {
+		args[i] = a.String()
+	}
+	if f.VArgs && f.VArgsPos < len(args) {
+		args[f.VArgsPos] += "..."
+	}
+	ret := f.Return.String()
+	if f.VariantReturn {
+		// The return type is that of the variantSet argument.
+		ret = "variantSet"
+	}
+	return fmt.Sprintf("%s(%s) %s", name, strings.Join(args, ", "), ret)
+}
+
+// queryPosition describes the part of an OpenTSDB query being edited.
+type queryPosition struct {
+	metric string // the metric, if a tag is being edited
+	tagk   string // the tag key, if a tag value is being edited
+	prefix string // the part of the metric, tag key or value typed so far
+}
+
+// parseQueryPosition returns what is being edited at the end of the partial
+// OpenTSDB query q. ok is false within the arguments of rate.
+func parseQueryPosition(q string) (p queryPosition, ok bool) {
+	brace := strings.LastIndex(q, "{")
+	if brace < 0 || strings.Contains(q[brace:], "}") {
+		p.prefix = q[strings.LastIndex(q, ":")+1:]
+		return p, true
+	}
+	p.metric = q[strings.LastIndex(q[:brace], ":")+1 : brace]
+	if p.metric == "rate" {
+		return p, false
+	}
+	tag := q[strings.LastIndexAny(q, "{,")+1:]
+	if eq := strings.Index(tag, "="); eq >= 0 {
+		p.tagk = tag[:eq]
+		tag = tag[eq+1:]
+		p.prefix = tag[strings.LastIndex(tag, "|")+1:]
+	} else {
+		p.prefix = tag
+	}
+	return p, true
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
+)
+
+const testConf = `$host = ny-*
+
+lookup cpu {
+	entry host=* {
+		high = 90
+	}
+}
+
+notification ops {
+	email = ops@example.com
+}
+
+alert cpu {
+	crit = avg(q("avg:os.cpu{host=$host}", "5m", "")) > lookup("cpu", "high")
+	critNotification = ops
+}
+`
+
+// at returns the offset just after the first occurrence of marker in text.
+func at(marker string) int {
+	return strings.Index(testConf, marker) + len(marker)
+}
+
+func TestCursorAt(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   cursor
+	}{
+		{at(`crit = av`), cursor{key: "crit", value: "av", word: "av"}},
+		{at(`q("avg:os.c`), cursor{key: "crit", value: `avg(q("avg:os.c`, inString: true, str: "avg:os.c", fn: "q"}},
+		{at(`"5m", "`), cursor{key: "crit", value: `avg(q("avg:os.cpu{host=$host}", "5m", "`, inString: true, fn: "q", arg: 2}},
+		{at(`lookup("c`), cursor{key: "crit", value: `avg(q("avg:os.cpu{host=$host}", "5m", "")) > lookup("c`, inString: true, str: "c", fn: "lookup"}},
+		{at(`critNotification = o`), cursor{key: "critNotification", value: "o", word: "o"}},
+		{at(`entry host`), cursor{}},
+	}
+	for _, test := range tests {
+		if got := cursorAt(testConf, test.offset); got != test.want {
+			t.Errorf("at %d: got %+v, want %+v", test.offset, got, test.want)
+		}
+	}
+}
+
+func TestParseQueryPosition(t *testing.T) {
+	tests := []struct {
+		q    string
+		want queryPosition
+		ok   bool
+	}{
+		{"avg:os.c", queryPosition{prefix: "os.c"}, true},
+		{"avg:5m-avg:os.cpu{ho", queryPosition{metric: "os.cpu", prefix: "ho"}, true},
+		{"avg:os.cpu{host=ny-*,dc=n", queryPosition{metric: "os.cpu", tagk: "dc", prefix: "n"}, true},
+		{"avg:os.cpu{host=a|b", queryPosition{metric: "os.cpu", tagk: "host", prefix: "b"}, true},
+		{"avg:rate{counter", queryPosition{metric: "rate"}, false},
+	}
+	for _, test := range tests {
+		got, ok := parseQueryPosition(test.q)
+		if got != test.want || ok != test.ok {
+			t.Errorf("%s: got %+v %v, want %+v %v", test.q, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	tree, err := parse.Parse("test", testConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		offset int
+		want   string // text at the definition
+	}{
+		{at(`lookup("cp`), "lookup cpu"},
+		{at(`critNotification = op`), "notification ops"},
+		{at(`{host=$ho`), "$host = ny-*"},
+	}
+	for _, test := range tests {
+		ref, ok := referenceAt(testConf, test.offset)
+		if !ok {
+			t.Errorf("at %d: no reference", test.offset)
+			continue
+		}
+		n := findDefinition(tree, ref)
+		if n == nil {
+			t.Errorf("at %d: no definition of %+v", test.offset, ref)
+			continue
+		}
+		if got := testConf[n.Position():]; !strings.HasPrefix(got, test.want) {
+			t.Errorf("at %d: got definition at %.20q, want %q", test.offset, got, test.want)
+		}
+	}
+	if ref, ok := referenceAt(testConf, at(`crit = av`)); ok {
+		t.Errorf("unexpected reference %+v", ref)
+	}
+}
+
+func TestOffsets(t *testing.T) {
+	text := "a = 1\nb = \"€𝄞x\"\n"
+	for offset := range text {
+		if !strings.ContainsRune("€𝄞", rune(text[offset])) || offset == 0 {
+			p := positionOf(text, offset)
+			if got := offsetOf(text, p); got != offset && text[offset] < 0x80 {
+				t.Errorf("offset %d: position %+v gives offset %d", offset, p, got)
+			}
+		}
+	}
+	if p := positionOf(text, strings.Index(text, "x")); p != (Position{1, 8}) {
+		t.Errorf("got %+v, want {1 8}", p)
+	}
+}
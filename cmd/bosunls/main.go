@@ -0,0 +1,77 @@
+/*
+
+Bosunls is a language server for bosun rule files. It speaks the Language
+Server Protocol over stdin and stdout, so any editor with an LSP client can
+use it.
+
+It provides:
+
+	diagnostics: parse errors and the warnings of bosun -lint
+	completion: expression functions with their argument types, section
+		names in template, notification, escalation, macro and lookup
+		references, global variables, and metrics, tag keys and tag values
+		in OpenTSDB queries
+	go to definition: of macros, templates, notifications, lookups, alerts,
+		holidays, schedules and global variables
+	hover: signatures and documentation of expression functions
+
+Usage:
+	bosunls [flag]
+
+The flags are:
+
+	-h=""
+		Bosun host whose search API is used to complete metrics and tags,
+		such as "bosun:8070". Metric and tag completion is disabled if empty.
+	-c=""
+		Bosun system configuration file. Its enabled backends and rule
+		variables are used when checking rule files. If empty, all backends
+		are enabled.
+
+*/
+package main // import "github.com/leapar/bosun/cmd/bosunls"
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+var (
+	flagHost = flag.String("h", "", "Bosun host for metric and tag completion.")
+	flagConf = flag.String("c", "", "Bosun system configuration file.")
+)
+
+func main() {
+	flag.Parse()
+	// stdout carries the protocol, so all logging goes to stderr.
+	log.SetOutput(os.Stderr)
+	log.SetPrefix("bosunls: ")
+	backends := conf.EnabledBackends{
+		OpenTSDB: true,
+		Graphite: true,
+		Influx:   true,
+		Elastic:  true,
+		Logstash: true,
+		Annotate: true,
+	}
+	var sysVars map[string]string
+	if *flagConf != "" {
+		sc, err := conf.LoadSystemConfigFile(*flagConf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		backends = sc.EnabledBackends()
+		sysVars = sc.GetRuleVars()
+	}
+	var search *searchClient
+	if *flagHost != "" {
+		search = newSearchClient(*flagHost)
+	}
+	s := newServer(newConn(os.Stdin, os.Stdout), backends, sysVars, search)
+	if err := s.serve(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// searchClient looks up metrics and tags with the search API of a running
+// bosun. Responses are cached for a minute, as completion asks for the same
+// lists on every keystroke, and failures for a few seconds, so that an
+// unreachable bosun does not slow down every edit.
+type searchClient struct {
+	base   string
+	client *http.Client
+
+	sync.Mutex
+	cache map[string]cachedList
+}
+
+type cachedList struct {
+	values  []string
+	err     error
+	fetched time.Time
+}
+
+const (
+	searchCacheTime = time.Minute
+	searchRetryTime = 10 * time.Second
+	searchTimeout   = 5 * time.Second
+)
+
+func newSearchClient(host string) *searchClient {
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	return &searchClient{
+		base:   strings.TrimSuffix(host, "/"),
+		client: &http.Client{Timeout: searchTimeout},
+		cache:  make(map[string]cachedList),
+	}
+}
+
+// Metrics returns all metric names.
+func (s *searchClient) Metrics() ([]string, error) {
+	return s.get("/api/metric")
+}
+
+// TagKeys returns the tag keys of metric.
+func (s *searchClient) TagKeys(metric string) ([]string, error) {
+	return s.get("/api/tagk/" + url.QueryEscape(metric))
+}
+
+// TagValues returns the values of tag key tagk for metric.
+func (s *searchClient) TagValues(metric, tagk string) ([]string, error) {
+	return s.get("/api/tagv/" + url.QueryEscape(tagk) + "/" + url.QueryEscape(metric))
+}
+
+func (s *searchClient) get(path string) ([]string, error) {
+	s.Lock()
+	c, ok := s.cache[path]
+	s.Unlock()
+	if ok && c.err == nil && time.Since(c.fetched) < searchCacheTime {
+		return c.values, nil
+	}
+	if ok && c.err != nil && time.Since(c.fetched) < searchRetryTime {
+		return nil, c.err
+	}
+	values, err := s.fetch(path)
+	s.Lock()
+	s.cache[path] = cachedList{values: values, err: err, fetched: time.Now()}
+	s.Unlock()
+	return values, err
+}
+
+func (s *searchClient) fetch(path string) ([]string, error) {
+	resp, err := s.client.Get(s.base + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", path, resp.Status)
+	}
+	var values []string
+	if err := json.NewDecoder(resp.Body).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,406 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule"
+	"github.com/leapar/bosun/cmd/bosun/conf/rule/parse"
+	"github.com/leapar/bosun/cmd/bosun/expr"
+	eparse "github.com/leapar/bosun/cmd/bosun/expr/parse"
+)
+
+type server struct {
+	conn     *conn
+	backends conf.EnabledBackends
+	sysVars  map[string]string
+	search   *searchClient // nil if no bosun host was given
+	funcs    map[string]eparse.Func
+
+	mu   sync.Mutex
+	docs map[string]*document
+}
+
+type document struct {
+	text string
+	tree *parse.Tree // of the last text that parsed, or nil
+
+	// version counts changes, so that diagnostics are only published for
+	// the latest text, once changes pause for diagnoseDelay.
+	version int
+	timer   *time.Timer
+}
+
+// diagnoseDelay is how long after a change diagnostics are published, if the
+// document has not changed again. Linting may look up metrics in search.
+const diagnoseDelay = 300 * time.Millisecond
+
+func newServer(c *conn, backends conf.EnabledBackends, sysVars map[string]string, search *searchClient) *server {
+	funcs := make(map[string]eparse.Func)
+	for k, v := range expr.Builtins() {
+		funcs[k] = v
+	}
+	for k, v := range (&rule.Conf{}).GetFuncs(backends) {
+		funcs[k] = v
+	}
+	return &server{
+		conn:     c,
+		backends: backends,
+		sysVars:  sysVars,
+		search:   search,
+		funcs:    funcs,
+		docs:     make(map[string]*document),
+	}
+}
+
+// serve handles requests until the client exits or the connection fails.
+func (s *server) serve() error {
+	for {
+		req, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+		result, rerr := s.handle(req)
+		if req.ID == nil {
+			if rerr != nil {
+				log.Printf("%s: %s", req.Method, rerr.Message)
+			}
+			continue
+		}
+		if err := s.conn.reply(req.ID, result, rerr); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) handle(req *request) (interface{}, *responseError) {
+	var (
+		pos    TextDocumentPositionParams
+		result interface{}
+		err    error
+	)
+	unmarshal := func(v interface{}) *responseError {
+		if err := json.Unmarshal(req.Params, v); err != nil {
+			return &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return nil
+	}
+	switch req.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": 1, // full text on every change
+				"completionProvider": map[string]interface{}{
+					"triggerCharacters": []string{"(", `"`, ":", "{", "=", ",", "$", "|"},
+				},
+				"hoverProvider":      true,
+				"definitionProvider": true,
+			},
+		}, nil
+	case "initialized", "shutdown", "$/cancelRequest":
+		return nil, nil
+	case "textDocument/didOpen":
+		var p DidOpenTextDocumentParams
+		if rerr := unmarshal(&p); rerr != nil {
+			return nil, rerr
+		}
+		err = s.update(p.TextDocument.URI, p.TextDocument.Text)
+	case "textDocument/didChange":
+		var p DidChangeTextDocumentParams
+		if rerr := unmarshal(&p); rerr != nil {
+			return nil, rerr
+		}
+		if n := len(p.ContentChanges); n > 0 {
+			err = s.update(p.TextDocument.URI, p.ContentChanges[n-1].Text)
+		}
+	case "textDocument/didClose":
+		var p DidCloseTextDocumentParams
+		if rerr := unmarshal(&p); rerr != nil {
+			return nil, rerr
+		}
+		s.mu.Lock()
+		if d := s.docs[p.TextDocument.URI]; d != nil && d.timer != nil {
+			d.timer.Stop()
+		}
+		delete(s.docs, p.TextDocument.URI)
+		s.mu.Unlock()
+		err = s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/completion":
+		if rerr := unmarshal(&pos); rerr != nil {
+			return nil, rerr
+		}
+		result = s.complete(s.document(pos.TextDocument.URI), pos.Position)
+	case "textDocument/hover":
+		if rerr := unmarshal(&pos); rerr != nil {
+			return nil, rerr
+		}
+		result = s.hover(s.document(pos.TextDocument.URI), pos.Position)
+	case "textDocument/definition":
+		if rerr := unmarshal(&pos); rerr != nil {
+			return nil, rerr
+		}
+		result = s.definition(pos.TextDocument.URI, s.document(pos.TextDocument.URI), pos.Position)
+	default:
+		return nil, &responseError{Code: codeMethodNotFound, Message: "unknown method: " + req.Method}
+	}
+	if err != nil {
+		return nil, &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+	return result, nil
+}
+
+// document returns a copy of the open document with uri.
+func (s *server) document(uri string) document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d := s.docs[uri]; d != nil {
+		return document{text: d.text, tree: d.tree, version: d.version}
+	}
+	return document{}
+}
+
+// update stores the new text of a document and publishes its diagnostics
+// after diagnoseDelay, unless it changes again before.
+func (s *server) update(uri, text string) error {
+	tree, err := parse.Parse(uri, text)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.docs[uri]
+	if d == nil {
+		d = new(document)
+		s.docs[uri] = d
+	}
+	d.text = text
+	if err == nil {
+		d.tree = tree
+	}
+	d.version++
+	version := d.version
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.timer = time.AfterFunc(diagnoseDelay, func() { s.publishDiagnostics(uri, version) })
+	return nil
+}
+
+// publishDiagnostics publishes the diagnostics of version of the document
+// with uri, if it is still open and at that version.
+func (s *server) publishDiagnostics(uri string, version int) {
+	s.mu.Lock()
+	d := s.docs[uri]
+	if d == nil || d.version != version {
+		s.mu.Unlock()
+		return
+	}
+	text := d.text
+	s.mu.Unlock()
+	diagnostics := s.diagnose(text)
+	if s.document(uri).version != version {
+		return
+	}
+	err := s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+	if err != nil {
+		log.Printf("publishing diagnostics of %s: %v", uri, err)
+	}
+}
+
+func (s *server) diagnose(text string) []Diagnostic {
+	var metricSeen func(string) bool
+	if s.search != nil {
+		if metrics, err := s.search.Metrics(); err == nil {
+			seen := make(map[string]bool, len(metrics))
+			for _, m := range metrics {
+				seen[m] = true
+			}
+			metricSeen = func(m string) bool { return seen[m] }
+		}
+	}
+	lines := strings.SplitAfter(text, "\n")
+	diagnostics := []Diagnostic{}
+	for _, d := range rule.Lint("", s.backends, s.sysVars, text, metricSeen) {
+		var r Range
+		if d.Line > 0 && d.Line <= len(lines) {
+			start := len(strings.Join(lines[:d.Line-1], ""))
+			line := strings.TrimRight(lines[d.Line-1], "\r\n")
+			col := d.Column
+			if col > len(line) {
+				col = len(line)
+			}
+			r.Start = positionOf(text, start+col)
+			r.End = positionOf(text, start+len(line))
+		}
+		severity := severityWarning
+		if d.Severity == rule.SeverityError {
+			severity = severityError
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    r,
+			Severity: severity,
+			Code:     d.Check,
+			Source:   "bosun",
+			Message:  d.Message,
+		})
+	}
+	return diagnostics
+}
+
+func (s *server) complete(d document, p Position) []CompletionItem {
+	items := []CompletionItem{}
+	offset := offsetOf(d.text, p)
+	c := cursorAt(d.text, offset)
+	if c.key == "" {
+		return items
+	}
+	if c.inString {
+		if t, ok := refFuncs[c.fn]; ok && c.arg == 0 && d.tree != nil {
+			for _, name := range names(d.tree, t) {
+				items = append(items, CompletionItem{Label: name, Kind: kindReference})
+			}
+			return items
+		}
+		if _, ok := expr.TSDB[c.fn]; ok && c.arg == 0 {
+			return s.completeQuery(c.str)
+		}
+		return items
+	}
+	if t := refKeys[c.key]; t != "" && c.fn == "" {
+		if d.tree != nil {
+			for _, name := range names(d.tree, t) {
+				if strings.HasPrefix(name, c.word) {
+					items = append(items, CompletionItem{Label: name, Kind: kindReference})
+				}
+			}
+		}
+		return items
+	}
+	if strings.HasPrefix(c.word, "$") {
+		if d.tree != nil {
+			for _, name := range names(d.tree, "$") {
+				if strings.HasPrefix(name, c.word) {
+					items = append(items, CompletionItem{Label: name, Kind: kindVariable})
+				}
+			}
+		}
+		return items
+	}
+	for _, name := range sortedFuncs(s.funcs) {
+		if !strings.HasPrefix(name, c.word) {
+			continue
+		}
+		f := s.funcs[name]
+		item := CompletionItem{
+			Label:  name,
+			Kind:   kindFunction,
+			Detail: signature(name, f),
+		}
+		if f.Doc != "" {
+			item.Documentation = &MarkupContent{Kind: "markdown", Value: f.Doc}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// completeQuery completes the metric, tag key or tag value at the end of the
+// partial OpenTSDB query q.
+func (s *server) completeQuery(q string) []CompletionItem {
+	items := []CompletionItem{}
+	p, ok := parseQueryPosition(q)
+	if !ok || s.search == nil {
+		return items
+	}
+	var (
+		values []string
+		err    error
+		kind   = kindValue
+	)
+	switch {
+	case p.metric == "":
+		values, err = s.search.Metrics()
+	case p.tagk == "":
+		values, err = s.search.TagKeys(p.metric)
+		kind = kindField
+	default:
+		values, err = s.search.TagValues(p.metric, p.tagk)
+	}
+	if err != nil {
+		log.Printf("search: %v", err)
+		return items
+	}
+	for _, v := range values {
+		if strings.HasPrefix(v, p.prefix) {
+			items = append(items, CompletionItem{Label: v, Kind: kind})
+		}
+	}
+	return items
+}
+
+func (s *server) hover(d document, p Position) *Hover {
+	offset := offsetOf(d.text, p)
+	start, end := wordAt(d.text, offset, isFuncChar)
+	if start == end || !strings.HasPrefix(strings.TrimLeft(d.text[end:], " \t"), "(") {
+		return nil
+	}
+	name := d.text[start:end]
+	f, ok := s.funcs[name]
+	if !ok {
+		return nil
+	}
+	value := fmt.Sprintf("```\n%s\n```", signature(name, f))
+	if f.Doc != "" {
+		value += "\n\n" + f.Doc
+	}
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: value},
+		Range:    &Range{Start: positionOf(d.text, start), End: positionOf(d.text, end)},
+	}
+}
+
+func (s *server) definition(uri string, d document, p Position) []Location {
+	locations := []Location{}
+	if d.tree == nil {
+		return locations
+	}
+	ref, ok := referenceAt(d.text, offsetOf(d.text, p))
+	if !ok {
+		return locations
+	}
+	n := findDefinition(d.tree, ref)
+	if n == nil {
+		return locations
+	}
+	// The tree is of the last text that parsed, which may be longer than
+	// the current text; positionOf clamps to the end.
+	pos := positionOf(d.text, int(n.Position()))
+	return append(locations, Location{
+		URI:   uri,
+		Range: Range{Start: pos, End: pos},
+	})
+}
+
+func sortedFuncs(funcs map[string]eparse.Func) []string {
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/leapar/bosun/cmd/bosun/conf"
+)
+
+type lockedBuffer struct {
+	sync.Mutex
+	bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.Buffer.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.Lock()
+	defer b.Unlock()
+	return b.Buffer.String()
+}
+
+func TestDiagnosticsDebounced(t *testing.T) {
+	var out lockedBuffer
+	s := newServer(newConn(strings.NewReader(""), &out), conf.EnabledBackends{OpenTSDB: true}, nil, nil)
+	for _, text := range []string{"alert a {", "alert a {\n\tcrit = 1", "alert a {\n\tcrit = 1\n}\nalert a {\n\tcrit = 2\n}\n"} {
+		if err := s.update("file:///rules.conf", text); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(3 * diagnoseDelay)
+	got := out.String()
+	if n := strings.Count(got, "textDocument/publishDiagnostics"); n != 1 {
+		t.Fatalf("expected diagnostics to be published once, got %d: %s", n, got)
+	}
+	if !strings.Contains(got, "duplicate alert name: a") {
+		t.Errorf("expected the diagnostics of the last change, got %s", got)
+	}
+}
+
+func TestSearchClientFailures(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/api/metric" {
+			time.Sleep(time.Second)
+		}
+		w.Write([]byte(`["os.cpu"]`))
+	}))
+	defer ts.Close()
+	s := newSearchClient(ts.URL)
+	s.client.Timeout = 50 * time.Millisecond
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		if _, err := s.Metrics(); err == nil {
+			t.Error("expected a timeout")
+		}
+		if d := time.Since(start); d > 500*time.Millisecond {
+			t.Errorf("lookup took %v", d)
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected the failure to be cached, got %d requests", n)
+	}
+	keys, err := s.TagKeys("os.cpu")
+	if err != nil || len(keys) != 1 {
+		t.Errorf("got %v, %v", keys, err)
+	}
+}
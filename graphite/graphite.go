@@ -30,6 +30,25 @@ type Series struct {
 
 type DataPoint []json.Number
 
+// Copy returns a deep copy of r, so that cached responses are not changed by
+// their users.
+func (r Response) Copy() Response {
+	if r == nil {
+		return nil
+	}
+	c := make(Response, len(r))
+	for i, s := range r {
+		c[i].Target = s.Target
+		if s.Datapoints != nil {
+			c[i].Datapoints = make([]DataPoint, len(s.Datapoints))
+			for j, dp := range s.Datapoints {
+				c[i].Datapoints[j] = append(DataPoint(nil), dp...)
+			}
+		}
+	}
+	return c
+}
+
 func (r *Request) CacheKey() string {
 	targets, _ := json.Marshal(r.Targets)
 	return fmt.Sprintf("graphite-%d-%d-%s", r.Start.Unix(), r.End.Unix(), targets)
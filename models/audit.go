@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// AuditEntry records a request that changed bosun's state, such as an
+// action, a silence or a config save.
+type AuditEntry struct {
+	Id   int64
+	Time time.Time
+	// User is who made the request, and AuthMethod how they authenticated,
+	// such as token or ldap.
+	User       string
+	AuthMethod string
+	RemoteAddr string
+	// ForwardedFor is the X-Forwarded-For header, if the request came
+	// through a proxy.
+	ForwardedFor string `json:",omitempty"`
+	// Route is the name of the API route, such as action or silence_set.
+	Route  string
+	Method string
+	Path   string
+	// Target is what the request acted on, if the handler noted it.
+	Target string `json:",omitempty"`
+	// Digest is the hex SHA-256 of the request body.
+	Digest string
+	// Status is the HTTP status code of the response.
+	Status int
+}
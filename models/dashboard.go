@@ -0,0 +1,139 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Dashboard is a page of panels, defined by a dashboard section of the rule
+// configuration or stored through the API. Panel expressions refer to the
+// variables of the dashboard as $name, whose values are picked when the
+// dashboard is viewed.
+type Dashboard struct {
+	Name      string
+	Title     string               `json:",omitempty"`
+	Variables []*DashboardVariable `json:",omitempty"`
+	Panels    []*DashboardPanel
+	// Source is "config" for dashboards of the rule configuration and "api"
+	// for those stored through the API.
+	Source string
+	// User and Time record who last stored the dashboard, and when.
+	User string `json:",omitempty"`
+	Time time.Time
+}
+
+// DashboardVariable is a variable of a dashboard. Its values are those of
+// TagKey in the search index, restricted to Metric if it is set. A variable
+// with no value or Default is "*".
+type DashboardVariable struct {
+	Name    string
+	TagKey  string
+	Metric  string `json:",omitempty"`
+	Default string `json:",omitempty"`
+}
+
+// DashboardPanel is an expression of a dashboard. A "graph" panel plots the
+// series of its expression, a "table" panel lists the value of each group
+// and an "expr" panel shows the results as the expression page does. Width is
+// the number of columns out of 12 taken by the panel.
+type DashboardPanel struct {
+	Name  string
+	Title string `json:",omitempty"`
+	Type  string
+	Expr  string
+	Unit  string `json:",omitempty"`
+	Width int    `json:",omitempty"`
+}
+
+const (
+	DashboardConfig = "config"
+	DashboardAPI    = "api"
+)
+
+var dashboardNameRE = regexp.MustCompile(`^[\w.-]+$`)
+
+// Validate returns an error if a name of d, its variables or its panels is
+// invalid or repeated, or if a panel has no expression or an unknown type.
+func (d *Dashboard) Validate() error {
+	if !dashboardNameRE.MatchString(d.Name) {
+		return fmt.Errorf("invalid dashboard name: %q", d.Name)
+	}
+	vars := make(map[string]bool)
+	for _, v := range d.Variables {
+		if !dashboardNameRE.MatchString(v.Name) {
+			return fmt.Errorf("invalid variable name: %q", v.Name)
+		}
+		if vars[v.Name] {
+			return fmt.Errorf("duplicate variable: %s", v.Name)
+		}
+		vars[v.Name] = true
+		if v.TagKey == "" {
+			return fmt.Errorf("variable %s needs a tag key", v.Name)
+		}
+	}
+	if len(d.Panels) == 0 {
+		return fmt.Errorf("dashboard %s has no panels", d.Name)
+	}
+	panels := make(map[string]bool)
+	for _, p := range d.Panels {
+		if !dashboardNameRE.MatchString(p.Name) {
+			return fmt.Errorf("invalid panel name: %q", p.Name)
+		}
+		if panels[p.Name] {
+			return fmt.Errorf("duplicate panel: %s", p.Name)
+		}
+		panels[p.Name] = true
+		switch p.Type {
+		case "graph", "table", "expr":
+		default:
+			return fmt.Errorf("panel %s: unknown type %q", p.Name, p.Type)
+		}
+		if p.Expr == "" {
+			return fmt.Errorf("panel %s has no expression", p.Name)
+		}
+		if p.Width < 0 || p.Width > 12 {
+			return fmt.Errorf("panel %s: width must be between 1 and 12", p.Name)
+		}
+	}
+	return nil
+}
+
+// CheckReturn returns an error if an expression returning t cannot be shown
+// by p: graphs need series and tables numbers.
+func (p *DashboardPanel) CheckReturn(t FuncType) error {
+	switch {
+	case p.Type == "graph" && t != TypeSeriesSet:
+		return fmt.Errorf("panel %s: graph panels need an expression that returns a series", p.Name)
+	case p.Type == "table" && t != TypeNumberSet && t != TypeScalar:
+		return fmt.Errorf("panel %s: table panels need an expression that returns a number", p.Name)
+	}
+	return nil
+}
+
+// Panel returns the panel of d with the given name, or nil if there is none.
+func (d *Dashboard) Panel(name string) *DashboardPanel {
+	for _, p := range d.Panels {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Vars returns the variables of d keyed by $name, set to their value in
+// values or else to their default.
+func (d *Dashboard) Vars(values map[string]string) map[string]string {
+	vars := make(map[string]string, len(d.Variables))
+	for _, v := range d.Variables {
+		val := values[v.Name]
+		if val == "" {
+			val = v.Default
+		}
+		if val == "" {
+			val = "*"
+		}
+		vars["$"+v.Name] = val
+	}
+	return vars
+}
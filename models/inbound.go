@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// InboundAudit records an inbound webhook request and what was done with it.
+type InboundAudit struct {
+	Time       time.Time
+	Provider   string
+	RemoteAddr string
+	Verified   bool
+	Error      string          `json:",omitempty"`
+	Events     []*InboundEvent `json:",omitempty"`
+}
+
+// InboundEvent is an event of an inbound webhook request and its outcome.
+type InboundEvent struct {
+	ExternalId string
+	IncidentId int64
+	Action     ActionType
+	User       string
+	Message    string `json:",omitempty"`
+	Error      string `json:",omitempty"`
+}
@@ -31,6 +31,13 @@ type IncidentState struct {
 	NeedAck bool
 	Open    bool
 
+	// Assignee is the user and Team the team responsible for the incident.
+	Assignee string `json:",omitempty"`
+	Team     string `json:",omitempty"`
+	// EscalationLevel is the level of the alert's escalation policy that
+	// was last notified, or 0 if none has been.
+	EscalationLevel int `json:",omitempty"`
+
 	Unevaluated bool
 
 	CurrentStatus Status
@@ -215,6 +222,9 @@ type Action struct {
 	Deadline   *time.Time `json:",omitempty"`
 	Fullfilled bool
 	Cancelled  bool
+	// Assignee and Team are set by ActionAssign.
+	Assignee string `json:",omitempty"`
+	Team     string `json:",omitempty"`
 }
 
 type ActionType int // Available to users in templates, document changes in Bosun docs
@@ -229,6 +239,9 @@ const (
 	ActionNote
 	ActionDelayedClose
 	ActionCancelClose
+	ActionAssign
+	ActionUnassign
+	ActionEscalate
 )
 
 func (a ActionType) String() string {
@@ -249,6 +262,12 @@ func (a ActionType) String() string {
 		return "DelayedClose"
 	case ActionCancelClose:
 		return "CancelClose"
+	case ActionAssign:
+		return "Assigned"
+	case ActionUnassign:
+		return "Unassigned"
+	case ActionEscalate:
+		return "Escalated"
 	default:
 		return "none"
 	}
@@ -276,6 +295,12 @@ func (a *ActionType) UnmarshalJSON(b []byte) error {
 		*a = ActionDelayedClose
 	case `"CancelClose"`:
 		*a = ActionCancelClose
+	case `"Assigned"`:
+		*a = ActionAssign
+	case `"Unassigned"`:
+		*a = ActionUnassign
+	case `"Escalated"`:
+		*a = ActionEscalate
 	default:
 		*a = ActionNone
 	}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// Inventory is a snapshot of the hardware and software of a host. Fields
+// are named by path, such as "os.version" or "interface.eth0.mac".
+type Inventory struct {
+	Host   string
+	Time   time.Time
+	Fields map[string]string
+}
+
+// InventoryChange is a field of a host's inventory that differs between two
+// snapshots. Old is empty if the field was added, and New if it was removed.
+type InventoryChange struct {
+	Host  string
+	Time  time.Time
+	Field string
+	Old   string `json:",omitempty"`
+	New   string `json:",omitempty"`
+}
+
+// Diff returns the changes from old to i, ordered by field. Every field is
+// added if old is nil.
+func (i *Inventory) Diff(old *Inventory) []*InventoryChange {
+	var prev map[string]string
+	if old != nil {
+		prev = old.Fields
+	}
+	var changes []*InventoryChange
+	for f, v := range i.Fields {
+		if o, ok := prev[f]; !ok || o != v {
+			changes = append(changes, &InventoryChange{Host: i.Host, Time: i.Time, Field: f, Old: o, New: v})
+		}
+	}
+	for f, o := range prev {
+		if _, ok := i.Fields[f]; !ok {
+			changes = append(changes, &InventoryChange{Host: i.Host, Time: i.Time, Field: f, Old: o})
+		}
+	}
+	sort.Slice(changes, func(a, b int) bool { return changes[a].Field < changes[b].Field })
+	return changes
+}